@@ -0,0 +1,75 @@
+package autoswap
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type Action string
+
+const (
+	// ActionSwapOut and ActionSwapIn are decisions to start a swap.
+	ActionSwapOut Action = "swap_out"
+	ActionSwapIn  Action = "swap_in"
+	// ActionNone is the decision that the channel is within its target.
+	ActionNone Action = "none"
+	// ActionSkip is the decision that the channel can not be handled right
+	// now.
+	ActionSkip Action = "skip"
+)
+
+// Decision is a single decision of the manager about a channel and the reason
+// for it.
+type Decision struct {
+	Time          time.Time `json:"time"`
+	ChannelId     string    `json:"channel_id"`
+	PeerId        string    `json:"peer_id"`
+	Rule          string    `json:"rule"`
+	LocalRatio    float64   `json:"local_ratio"`
+	Action        Action    `json:"action"`
+	Asset         string    `json:"asset,omitempty"`
+	AmountSat     uint64    `json:"amount_sat,omitempty"`
+	OnchainFeeSat uint64    `json:"onchain_fee_sat,omitempty"`
+	MaxPremiumSat uint64    `json:"max_premium_sat,omitempty"`
+	DryRun        bool      `json:"dry_run"`
+	SwapId        string    `json:"swap_id,omitempty"`
+	Reason        string    `json:"reason"`
+	Error         string    `json:"error,omitempty"`
+}
+
+type AuditLog interface {
+	Record(d *Decision) error
+}
+
+// FileAuditLog appends every decision as a JSON line to a file.
+type FileAuditLog struct {
+	sync.Mutex
+	file *os.File
+}
+
+func NewFileAuditLog(path string) (*FileAuditLog, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditLog{file: f}, nil
+}
+
+func (a *FileAuditLog) Record(d *Decision) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	a.Lock()
+	defer a.Unlock()
+	_, err = a.file.Write(append(data, '\n'))
+	return err
+}
+
+func (a *FileAuditLog) Close() error {
+	a.Lock()
+	defer a.Unlock()
+	return a.file.Close()
+}
@@ -0,0 +1,357 @@
+package autoswap
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elementsproject/peerswap/lightning"
+	"github.com/elementsproject/peerswap/log"
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+)
+
+const (
+	// DefaultInterval is the default time between two runs of the manager.
+	DefaultInterval = 10 * time.Minute
+
+	// limitWindow is the time window of the daily amount and fee limits.
+	limitWindow = 24 * time.Hour
+
+	// failureBackoff is the time a channel is skipped after a swap could not
+	// be started on it.
+	failureBackoff = 1 * time.Hour
+)
+
+// Channel is a channel that the manager can swap on.
+type Channel struct {
+	ChannelId string
+	PeerId    string
+	Active    bool
+}
+
+type LightningClient interface {
+	ListChannels() ([]*Channel, error)
+	SpendableMsat(scid string) (uint64, error)
+	ReceivableMsat(scid string) (uint64, error)
+}
+
+type SwapService interface {
+	SwapOut(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*swap.SwapStateMachine, error)
+	SwapIn(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*swap.SwapStateMachine, error)
+	ListSwaps() ([]*swap.SwapStateMachine, error)
+	EstimatedOnchainFeeSat(asset string, swapType swap.SwapType) (uint64, error)
+}
+
+type PollService interface {
+	GetCompatiblePolls() (map[string]poll.PollInfo, error)
+}
+
+type Config struct {
+	// Interval is the time between two runs of the manager.
+	Interval time.Duration
+	// DryRun only records the decisions without starting any swaps.
+	DryRun bool
+	Rules  []*Rule
+}
+
+// Manager periodically checks the balance of the channels that a rule applies
+// to and starts a swap-out or swap-in if the local ratio of a channel leaves
+// the range of its rule. Every decision is recorded in the audit log.
+type Manager struct {
+	sync.Mutex
+	ctx  context.Context
+	done context.CancelFunc
+
+	cfg       *Config
+	nodeId    string
+	lightning LightningClient
+	swaps     SwapService
+	polls     PollService
+	audit     AuditLog
+	failures  map[string]time.Time
+}
+
+func NewManager(cfg *Config, nodeId string, lightning LightningClient, swaps SwapService, polls PollService, audit AuditLog) *Manager {
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+	ctx, done := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:       ctx,
+		done:      done,
+		cfg:       cfg,
+		nodeId:    nodeId,
+		lightning: lightning,
+		swaps:     swaps,
+		polls:     polls,
+		audit:     audit,
+		failures:  make(map[string]time.Time),
+	}
+}
+
+// Start runs the manager on every interval until Stop is called.
+func (m *Manager) Start() {
+	log.Infof("[Autoswap] started with %d rules, interval %s, dry run: %v", len(m.cfg.Rules), m.cfg.Interval, m.cfg.DryRun)
+	go func() {
+		clock := time.NewTicker(m.cfg.Interval)
+		defer clock.Stop()
+		for {
+			select {
+			case <-clock.C:
+				m.RunOnce()
+			case <-m.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (m *Manager) Stop() {
+	m.done()
+}
+
+// usage is the amount swapped and the fees paid for the swaps of a rule in
+// the limit window.
+type usage struct {
+	amountSat uint64
+	feeSat    uint64
+}
+
+// RunOnce checks all channels once and returns the decisions that were made.
+func (m *Manager) RunOnce() []*Decision {
+	m.Lock()
+	defer m.Unlock()
+
+	now := time.Now()
+	decisions, err := m.run(now)
+	if err != nil {
+		log.Infof("[Autoswap] could not run: %v", err)
+		return nil
+	}
+	for _, d := range decisions {
+		if d.Action == ActionSwapOut || d.Action == ActionSwapIn {
+			log.Infof("[Autoswap] %s of %d sat on channel %s (dry run: %v, swap: %s): %s %s", d.Action, d.AmountSat, d.ChannelId, d.DryRun, d.SwapId, d.Reason, d.Error)
+		} else {
+			log.Debugf("[Autoswap] %s on channel %s: %s %s", d.Action, d.ChannelId, d.Reason, d.Error)
+		}
+		if err := m.audit.Record(d); err != nil {
+			log.Infof("[Autoswap] could not write audit log: %v", err)
+		}
+	}
+	return decisions
+}
+
+func (m *Manager) run(now time.Time) ([]*Decision, error) {
+	channels, err := m.lightning.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+	polls, err := m.polls.GetCompatiblePolls()
+	if err != nil {
+		return nil, err
+	}
+	swaps, err := m.swaps.ListSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	channelRules := make(map[string]*Rule)
+	for _, ch := range channels {
+		if r := matchRule(m.cfg.Rules, ch); r != nil {
+			channelRules[lightning.Scid(ch.ChannelId).ClnStyle()] = r
+		}
+	}
+
+	// Collect the active swaps and the usage of the rules. The limits
+	// include all swaps that we initiated on the channels of a rule. Swaps
+	// that were canceled count as failed attempts.
+	activeSwaps := make(map[string]string)
+	usages := make(map[*Rule]*usage)
+	for _, s := range swaps {
+		scid := s.Data.GetScidInBoltFormat()
+		if !s.IsFinished() {
+			activeSwaps[scid] = s.SwapId.String()
+		}
+		r, ok := channelRules[scid]
+		if !ok || s.Data.InitiatorNodeId != m.nodeId {
+			continue
+		}
+		createdAt := time.Unix(s.Data.CreatedAt, 0)
+		if s.Current == swap.State_SwapCanceled && createdAt.After(m.failures[scid]) {
+			m.failures[scid] = createdAt
+		}
+		if createdAt.Before(now.Add(-limitWindow)) {
+			continue
+		}
+		u := usages[r]
+		if u == nil {
+			u = &usage{}
+			usages[r] = u
+		}
+		if s.Current != swap.State_SwapCanceled {
+			u.amountSat += s.Data.GetAmount()
+		}
+		u.feeSat += s.Data.OpeningTxFee + s.Data.ClaimTxFee + s.Data.GetPremium()
+	}
+
+	var decisions []*Decision
+	for _, ch := range channels {
+		r, ok := channelRules[lightning.Scid(ch.ChannelId).ClnStyle()]
+		if !ok {
+			continue
+		}
+		u := usages[r]
+		if u == nil {
+			u = &usage{}
+			usages[r] = u
+		}
+		d := m.decide(now, ch, r, u, polls, activeSwaps)
+		decisions = append(decisions, d)
+	}
+	return decisions, nil
+}
+
+// decide makes the decision for a single channel and starts the swap if
+// needed. The usage of the rule is updated with the amount of a started swap.
+func (m *Manager) decide(now time.Time, ch *Channel, r *Rule, u *usage, polls map[string]poll.PollInfo, activeSwaps map[string]string) *Decision {
+	d := &Decision{
+		Time:      now,
+		ChannelId: ch.ChannelId,
+		PeerId:    ch.PeerId,
+		Rule:      r.String(),
+		Asset:     r.Asset,
+		DryRun:    m.cfg.DryRun,
+	}
+	skip := func(format string, args ...interface{}) *Decision {
+		d.Action = ActionSkip
+		d.Reason = fmt.Sprintf(format, args...)
+		return d
+	}
+
+	if !ch.Active {
+		return skip("channel is not active")
+	}
+	scid := lightning.Scid(ch.ChannelId).ClnStyle()
+	if id, ok := activeSwaps[scid]; ok {
+		return skip("swap %s is in progress on the channel", id)
+	}
+	if t, ok := m.failures[scid]; ok && now.Before(t.Add(failureBackoff)) {
+		return skip("last swap attempt failed at %s, waiting until %s", t.Format(time.RFC3339), t.Add(failureBackoff).Format(time.RFC3339))
+	}
+
+	p, ok := polls[ch.PeerId]
+	if !ok {
+		return skip("peer does not run a compatible peerswap version")
+	}
+	if !p.PeerAllowed {
+		return skip("peer does not allow swaps with us")
+	}
+	if !containsAsset(p.Assets, r.Asset) {
+		return skip("peer does not support asset %s", r.Asset)
+	}
+
+	spendable, err := m.lightning.SpendableMsat(ch.ChannelId)
+	if err != nil {
+		d.Error = err.Error()
+		return skip("could not get spendable amount")
+	}
+	receivable, err := m.lightning.ReceivableMsat(ch.ChannelId)
+	if err != nil {
+		d.Error = err.Error()
+		return skip("could not get receivable amount")
+	}
+	total := spendable + receivable
+	if total == 0 {
+		return skip("channel has no balance")
+	}
+	d.LocalRatio = float64(spendable) / float64(total)
+
+	target := uint64(r.targetRatio() * float64(total))
+	switch {
+	case d.LocalRatio > r.MaxLocalRatio:
+		d.Action = ActionSwapOut
+		d.AmountSat = (spendable - target) / 1000
+		d.Reason = fmt.Sprintf("local ratio %.2f is above %.2f", d.LocalRatio, r.MaxLocalRatio)
+	case d.LocalRatio < r.MinLocalRatio:
+		d.Action = ActionSwapIn
+		d.AmountSat = (target - spendable) / 1000
+		d.Reason = fmt.Sprintf("local ratio %.2f is below %.2f", d.LocalRatio, r.MinLocalRatio)
+	default:
+		d.Action = ActionNone
+		d.Reason = fmt.Sprintf("local ratio %.2f is within %.2f and %.2f", d.LocalRatio, r.MinLocalRatio, r.MaxLocalRatio)
+		return d
+	}
+
+	// Apply the limits of the rule.
+	if r.MaxSwapSat != 0 && d.AmountSat > r.MaxSwapSat {
+		d.AmountSat = r.MaxSwapSat
+	}
+	if r.MaxSatPerDay != 0 {
+		if u.amountSat >= r.MaxSatPerDay {
+			return skip("daily amount limit of %d sat reached", r.MaxSatPerDay)
+		}
+		if left := r.MaxSatPerDay - u.amountSat; d.AmountSat > left {
+			d.AmountSat = left
+		}
+	}
+	if d.AmountSat < r.getMinSwapSat() {
+		return skip("%s, but swap amount %d sat is below the minimum of %d sat", d.Reason, d.AmountSat, r.getMinSwapSat())
+	}
+	if r.MaxFeeSatPerDay != 0 {
+		if u.feeSat >= r.MaxFeeSatPerDay {
+			return skip("daily fee budget of %d sat is used up", r.MaxFeeSatPerDay)
+		}
+		// The on-chain fees of the swap are paid out of the budget, the
+		// rest of it is left for the premium.
+		swapType := swap.SWAPTYPE_OUT
+		if d.Action == ActionSwapIn {
+			swapType = swap.SWAPTYPE_IN
+		}
+		d.OnchainFeeSat, err = m.swaps.EstimatedOnchainFeeSat(r.Asset, swapType)
+		if err != nil {
+			d.Error = err.Error()
+			return skip("could not estimate on-chain fees")
+		}
+		left := r.MaxFeeSatPerDay - u.feeSat
+		if d.OnchainFeeSat > left {
+			return skip("daily fee budget of %d sat has %d sat left, which does not cover the estimated on-chain fees of %d sat",
+				r.MaxFeeSatPerDay, left, d.OnchainFeeSat)
+		}
+		d.MaxPremiumSat = left - d.OnchainFeeSat
+	}
+
+	// The swap may use its whole fee allowance, it is reserved in the budget
+	// so that later swaps of the run do not get it again.
+	if m.cfg.DryRun {
+		u.amountSat += d.AmountSat
+		u.feeSat += d.OnchainFeeSat + d.MaxPremiumSat
+		return d
+	}
+
+	var sw *swap.SwapStateMachine
+	if d.Action == ActionSwapOut {
+		sw, err = m.swaps.SwapOut(ch.PeerId, r.Asset, ch.ChannelId, m.nodeId, d.AmountSat, d.MaxPremiumSat)
+	} else {
+		sw, err = m.swaps.SwapIn(ch.PeerId, r.Asset, ch.ChannelId, m.nodeId, d.AmountSat, d.MaxPremiumSat)
+	}
+	if err != nil {
+		m.failures[scid] = now
+		d.Error = err.Error()
+		return d
+	}
+	u.amountSat += d.AmountSat
+	u.feeSat += d.OnchainFeeSat + d.MaxPremiumSat
+	d.SwapId = sw.SwapId.String()
+	return d
+}
+
+func containsAsset(assets []string, asset string) bool {
+	for _, a := range assets {
+		if a == asset {
+			return true
+		}
+	}
+	return false
+}
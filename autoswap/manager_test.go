@@ -0,0 +1,350 @@
+package autoswap
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNodeId  = "node"
+	testPeerId  = "peer"
+	testChannel = "100x1x0"
+)
+
+func Test_RunOnce(t *testing.T) {
+	rule := &Rule{Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}
+
+	tests := map[string]struct {
+		spendableSat  uint64
+		receivableSat uint64
+		action        Action
+		amountSat     uint64
+	}{
+		"above range": {
+			spendableSat:  800000,
+			receivableSat: 200000,
+			action:        ActionSwapOut,
+			amountSat:     300000,
+		},
+		"below range": {
+			spendableSat:  100000,
+			receivableSat: 900000,
+			action:        ActionSwapIn,
+			amountSat:     400000,
+		},
+		"within range": {
+			spendableSat:  500000,
+			receivableSat: 500000,
+			action:        ActionNone,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			m, ln, swaps, audit := getTestManager(&Config{Rules: []*Rule{rule}})
+			ln.spendableMsat = tt.spendableSat * 1000
+			ln.receivableMsat = tt.receivableSat * 1000
+
+			decisions := m.RunOnce()
+			require.Len(t, decisions, 1)
+			assert.Equal(t, tt.action, decisions[0].Action)
+			assert.Equal(t, tt.amountSat, decisions[0].AmountSat)
+			assert.Equal(t, decisions, audit.decisions)
+
+			switch tt.action {
+			case ActionSwapOut:
+				require.Len(t, swaps.swapOuts, 1)
+				assert.Equal(t, tt.amountSat, swaps.swapOuts[0])
+				assert.NotEmpty(t, decisions[0].SwapId)
+			case ActionSwapIn:
+				require.Len(t, swaps.swapIns, 1)
+				assert.Equal(t, tt.amountSat, swaps.swapIns[0])
+			default:
+				assert.Empty(t, swaps.swapOuts)
+				assert.Empty(t, swaps.swapIns)
+			}
+		})
+	}
+}
+
+func Test_RunOnce_DryRun(t *testing.T) {
+	m, ln, swaps, _ := getTestManager(&Config{
+		DryRun: true,
+		Rules:  []*Rule{{Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}},
+	})
+	ln.spendableMsat = 800000 * 1000
+	ln.receivableMsat = 200000 * 1000
+
+	decisions := m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSwapOut, decisions[0].Action)
+	assert.True(t, decisions[0].DryRun)
+	assert.Empty(t, swaps.swapOuts)
+}
+
+func Test_RunOnce_Limits(t *testing.T) {
+	rule := &Rule{
+		Asset:           "btc",
+		MinLocalRatio:   0.3,
+		MaxLocalRatio:   0.7,
+		MaxSatPerDay:    400000,
+		MaxFeeSatPerDay: 1000,
+	}
+	m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}})
+	ln.spendableMsat = 800000 * 1000
+	ln.receivableMsat = 200000 * 1000
+
+	// A swap from today counts against the limits, a swap from two days ago
+	// does not.
+	swaps.swaps = []*swap.SwapStateMachine{
+		getTestSwap(testNodeId, 250000, 300, time.Now().Add(-1*time.Hour)),
+		getTestSwap(testNodeId, 250000, 300, time.Now().Add(-48*time.Hour)),
+	}
+
+	decisions := m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSwapOut, decisions[0].Action)
+	assert.EqualValues(t, 150000, decisions[0].AmountSat)
+	assert.EqualValues(t, 700, decisions[0].MaxPremiumSat)
+
+	// Daily amount limit reached.
+	swaps.swaps = []*swap.SwapStateMachine{
+		getTestSwap(testNodeId, 400000, 0, time.Now().Add(-1*time.Hour)),
+	}
+	decisions = m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSkip, decisions[0].Action)
+
+	// Fee budget used up.
+	swaps.swaps = []*swap.SwapStateMachine{
+		getTestSwap(testNodeId, 100000, 1000, time.Now().Add(-1*time.Hour)),
+	}
+	decisions = m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSkip, decisions[0].Action)
+}
+
+func Test_RunOnce_FeeBudget(t *testing.T) {
+	rule := &Rule{
+		Asset:           "btc",
+		MinLocalRatio:   0.3,
+		MaxLocalRatio:   0.7,
+		MaxFeeSatPerDay: 1000,
+	}
+	m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}})
+	swaps.swaps = []*swap.SwapStateMachine{
+		getTestSwap(testNodeId, 100000, 300, time.Now().Add(-1*time.Hour)),
+	}
+	swaps.onchainFeeSat = map[swap.SwapType]uint64{swap.SWAPTYPE_OUT: 200, swap.SWAPTYPE_IN: 100}
+
+	// The on-chain fees are taken from the budget before the premium.
+	ln.spendableMsat = 800000 * 1000
+	ln.receivableMsat = 200000 * 1000
+	decisions := m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSwapOut, decisions[0].Action)
+	assert.EqualValues(t, 200, decisions[0].OnchainFeeSat)
+	assert.EqualValues(t, 500, decisions[0].MaxPremiumSat)
+	require.Len(t, swaps.swapOuts, 1)
+
+	ln.spendableMsat = 200000 * 1000
+	ln.receivableMsat = 800000 * 1000
+	decisions = m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSwapIn, decisions[0].Action)
+	assert.EqualValues(t, 100, decisions[0].OnchainFeeSat)
+	assert.EqualValues(t, 600, decisions[0].MaxPremiumSat)
+	require.Len(t, swaps.swapIns, 1)
+
+	// The rest of the budget does not cover the on-chain fees.
+	swaps.onchainFeeSat[swap.SWAPTYPE_IN] = 701
+	decisions = m.RunOnce()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, ActionSkip, decisions[0].Action)
+	assert.Contains(t, decisions[0].Reason, "701")
+	assert.Len(t, swaps.swapIns, 1)
+}
+
+// Test_RunOnce_FeeBudgetPerRun checks that the fee allowance of a swap is
+// taken from the budget before the next channel of the same run is decided.
+func Test_RunOnce_FeeBudgetPerRun(t *testing.T) {
+	rule := &Rule{
+		Asset:           "btc",
+		MinLocalRatio:   0.3,
+		MaxLocalRatio:   0.7,
+		MaxFeeSatPerDay: 1000,
+	}
+	for _, dryRun := range []bool{false, true} {
+		m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}, DryRun: dryRun})
+		ln.channels = append(ln.channels, &Channel{ChannelId: "100x1x1", PeerId: testPeerId, Active: true})
+		ln.spendableMsat = 800000 * 1000
+		ln.receivableMsat = 200000 * 1000
+		swaps.onchainFeeSat = map[swap.SwapType]uint64{swap.SWAPTYPE_OUT: 200}
+
+		decisions := m.RunOnce()
+		require.Len(t, decisions, 2, dryRun)
+		assert.Equal(t, ActionSwapOut, decisions[0].Action, dryRun)
+		assert.EqualValues(t, 800, decisions[0].MaxPremiumSat, dryRun)
+		assert.Equal(t, ActionSkip, decisions[1].Action, dryRun)
+		assert.Contains(t, decisions[1].Reason, "used up", dryRun)
+	}
+}
+
+func Test_RunOnce_Skip(t *testing.T) {
+	rule := &Rule{Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}
+
+	t.Run("incompatible peer", func(t *testing.T) {
+		m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}})
+		ln.spendableMsat = 800000 * 1000
+		ln.receivableMsat = 200000 * 1000
+		m.polls.(*fakePolls).polls = map[string]poll.PollInfo{}
+
+		decisions := m.RunOnce()
+		require.Len(t, decisions, 1)
+		assert.Equal(t, ActionSkip, decisions[0].Action)
+		assert.Empty(t, swaps.swapOuts)
+	})
+
+	t.Run("active swap", func(t *testing.T) {
+		m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}})
+		ln.spendableMsat = 800000 * 1000
+		ln.receivableMsat = 200000 * 1000
+		active := getTestSwap(testPeerId, 100000, 0, time.Now())
+		active.Current = swap.State_SwapOutReceiver_AwaitClaimInvoicePayment
+		swaps.swaps = []*swap.SwapStateMachine{active}
+
+		decisions := m.RunOnce()
+		require.Len(t, decisions, 1)
+		assert.Equal(t, ActionSkip, decisions[0].Action)
+		assert.Empty(t, swaps.swapOuts)
+	})
+
+	t.Run("failed swap", func(t *testing.T) {
+		m, ln, swaps, _ := getTestManager(&Config{Rules: []*Rule{rule}})
+		ln.spendableMsat = 800000 * 1000
+		ln.receivableMsat = 200000 * 1000
+		swaps.err = errors.New("swap failed")
+
+		decisions := m.RunOnce()
+		require.Len(t, decisions, 1)
+		assert.Equal(t, ActionSwapOut, decisions[0].Action)
+		assert.Equal(t, "swap failed", decisions[0].Error)
+
+		// The channel is skipped after a failed attempt.
+		decisions = m.RunOnce()
+		require.Len(t, decisions, 1)
+		assert.Equal(t, ActionSkip, decisions[0].Action)
+		assert.Len(t, swaps.swapOuts, 1)
+	})
+
+	t.Run("no rule", func(t *testing.T) {
+		m, ln, _, _ := getTestManager(&Config{Rules: []*Rule{{ChannelId: "1x1x1", Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}}})
+		ln.spendableMsat = 800000 * 1000
+		ln.receivableMsat = 200000 * 1000
+
+		decisions := m.RunOnce()
+		assert.Empty(t, decisions)
+	})
+}
+
+func getTestManager(cfg *Config) (*Manager, *fakeLightning, *fakeSwaps, *memAuditLog) {
+	ln := &fakeLightning{channels: []*Channel{{ChannelId: testChannel, PeerId: testPeerId, Active: true}}}
+	swaps := &fakeSwaps{}
+	polls := &fakePolls{polls: map[string]poll.PollInfo{
+		testPeerId: {Assets: []string{"btc", "lbtc"}, PeerAllowed: true},
+	}}
+	audit := &memAuditLog{}
+	return NewManager(cfg, testNodeId, ln, swaps, polls, audit), ln, swaps, audit
+}
+
+func getTestSwap(initiator string, amountSat, feeSat uint64, createdAt time.Time) *swap.SwapStateMachine {
+	id := swap.NewSwapId()
+	return &swap.SwapStateMachine{
+		SwapId:  id,
+		Current: swap.State_ClaimedPreimage,
+		Data: &swap.SwapData{
+			SwapOutRequest: &swap.SwapOutRequestMessage{
+				SwapId: id,
+				Scid:   testChannel,
+				Amount: amountSat,
+			},
+			InitiatorNodeId: initiator,
+			CreatedAt:       createdAt.Unix(),
+			OpeningTxFee:    feeSat,
+		},
+	}
+}
+
+type fakeLightning struct {
+	channels       []*Channel
+	spendableMsat  uint64
+	receivableMsat uint64
+}
+
+func (f *fakeLightning) ListChannels() ([]*Channel, error) {
+	return f.channels, nil
+}
+
+func (f *fakeLightning) SpendableMsat(scid string) (uint64, error) {
+	return f.spendableMsat, nil
+}
+
+func (f *fakeLightning) ReceivableMsat(scid string) (uint64, error) {
+	return f.receivableMsat, nil
+}
+
+type fakeSwaps struct {
+	swaps    []*swap.SwapStateMachine
+	swapOuts []uint64
+	swapIns  []uint64
+	err      error
+
+	onchainFeeSat map[swap.SwapType]uint64
+}
+
+func (f *fakeSwaps) SwapOut(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*swap.SwapStateMachine, error) {
+	f.swapOuts = append(f.swapOuts, amtSat)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &swap.SwapStateMachine{SwapId: swap.NewSwapId()}, nil
+}
+
+func (f *fakeSwaps) SwapIn(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*swap.SwapStateMachine, error) {
+	f.swapIns = append(f.swapIns, amtSat)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &swap.SwapStateMachine{SwapId: swap.NewSwapId()}, nil
+}
+
+func (f *fakeSwaps) ListSwaps() ([]*swap.SwapStateMachine, error) {
+	return f.swaps, nil
+}
+
+func (f *fakeSwaps) EstimatedOnchainFeeSat(asset string, swapType swap.SwapType) (uint64, error) {
+	return f.onchainFeeSat[swapType], nil
+}
+
+type fakePolls struct {
+	polls map[string]poll.PollInfo
+}
+
+func (f *fakePolls) GetCompatiblePolls() (map[string]poll.PollInfo, error) {
+	return f.polls, nil
+}
+
+type memAuditLog struct {
+	decisions []*Decision
+}
+
+func (a *memAuditLog) Record(d *Decision) error {
+	a.decisions = append(a.decisions, d)
+	return nil
+}
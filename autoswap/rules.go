@@ -0,0 +1,149 @@
+package autoswap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/elementsproject/peerswap/lightning"
+)
+
+const (
+	// defaultMinSwapSat is the smallest swap the manager starts if a rule
+	// does not set a minimum. It matches the default minimum swap amount of
+	// the policy.
+	defaultMinSwapSat = 100000
+)
+
+// Rule describes the liquidity target of a channel. A rule with a ChannelId
+// applies to that channel only, a rule with a PeerId applies to all channels
+// with that peer, and a rule with neither applies to all channels. The most
+// specific rule wins.
+type Rule struct {
+	// ChannelId is the short channel id of the channel, e.g. 539268x845x1.
+	ChannelId string `json:"channel_id,omitempty"`
+	// PeerId is the node id of the channel peer.
+	PeerId string `json:"peer_id,omitempty"`
+	// Asset is the on-chain asset to swap with: btc or lbtc.
+	Asset string `json:"asset"`
+	// MinLocalRatio and MaxLocalRatio are the range of the local share of the
+	// channel balance, between 0 and 1, that the manager keeps the channel in.
+	// A swap brings the channel back to the middle of the range.
+	MinLocalRatio float64 `json:"min_local_ratio"`
+	MaxLocalRatio float64 `json:"max_local_ratio"`
+	// MinSwapSat and MaxSwapSat bound the amount of a single swap.
+	MinSwapSat uint64 `json:"min_swap_sat,omitempty"`
+	MaxSwapSat uint64 `json:"max_swap_sat,omitempty"`
+	// MaxSatPerDay is the maximum amount that is swapped on the channels of
+	// the rule in 24 hours. Zero means no limit.
+	MaxSatPerDay uint64 `json:"max_sat_per_day,omitempty"`
+	// MaxFeeSatPerDay is the fee budget of the rule in 24 hours. It covers
+	// the on-chain fees and premiums paid for swaps on the channels of the
+	// rule. Zero means no limit, in which case no premium is accepted.
+	MaxFeeSatPerDay uint64 `json:"max_fee_sat_per_day,omitempty"`
+}
+
+func (r *Rule) String() string {
+	switch {
+	case r.ChannelId != "":
+		return fmt.Sprintf("channel %s", r.ChannelId)
+	case r.PeerId != "":
+		return fmt.Sprintf("peer %s", r.PeerId)
+	default:
+		return "default"
+	}
+}
+
+func (r *Rule) Validate() error {
+	if r.ChannelId != "" && r.PeerId != "" {
+		return fmt.Errorf("rule %s: only one of channel_id and peer_id can be set", r)
+	}
+	if r.Asset != "btc" && r.Asset != "lbtc" {
+		return fmt.Errorf("rule %s: invalid asset %q (btc or lbtc)", r, r.Asset)
+	}
+	if r.MinLocalRatio < 0 || r.MaxLocalRatio > 1 || r.MinLocalRatio >= r.MaxLocalRatio {
+		return fmt.Errorf("rule %s: local ratio range must be within 0 and 1 with min_local_ratio < max_local_ratio", r)
+	}
+	if r.MaxSwapSat != 0 && r.MaxSwapSat < r.getMinSwapSat() {
+		return fmt.Errorf("rule %s: max_swap_sat must not be less than min_swap_sat", r)
+	}
+	return nil
+}
+
+func (r *Rule) getMinSwapSat() uint64 {
+	if r.MinSwapSat == 0 {
+		return defaultMinSwapSat
+	}
+	return r.MinSwapSat
+}
+
+// targetRatio is the middle of the local ratio range.
+func (r *Rule) targetRatio() float64 {
+	return (r.MinLocalRatio + r.MaxLocalRatio) / 2
+}
+
+// Rules is the content of the autoswap rules file.
+type Rules struct {
+	Rules []*Rule `json:"rules"`
+}
+
+// ReadRulesFromFile reads and validates the JSON rules file at path.
+func ReadRulesFromFile(path string) ([]*Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules Rules
+	err = json.Unmarshal(data, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse rules file %s: %w", path, err)
+	}
+	err = validateRules(rules.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return rules.Rules, nil
+}
+
+func validateRules(rules []*Rule) error {
+	seen := map[string]struct{}{}
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[r.String()]; ok {
+			return fmt.Errorf("duplicate rule for %s", r)
+		}
+		seen[r.String()] = struct{}{}
+	}
+	return nil
+}
+
+// matchRule returns the most specific rule for the channel or nil if no rule
+// applies.
+func matchRule(rules []*Rule, channel *Channel) *Rule {
+	var peerRule, defaultRule *Rule
+	for _, r := range rules {
+		switch {
+		case r.ChannelId != "":
+			if sameScid(r.ChannelId, channel.ChannelId) {
+				return r
+			}
+		case r.PeerId != "":
+			if r.PeerId == channel.PeerId {
+				peerRule = r
+			}
+		default:
+			defaultRule = r
+		}
+	}
+	if peerRule != nil {
+		return peerRule
+	}
+	return defaultRule
+}
+
+// sameScid compares two short channel ids regardless of the separator.
+func sameScid(a, b string) bool {
+	return lightning.Scid(a).ClnStyle() == lightning.Scid(b).ClnStyle()
+}
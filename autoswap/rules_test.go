@@ -0,0 +1,61 @@
+package autoswap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadRulesFromFile(t *testing.T) {
+	rules := `{
+		"rules": [
+			{"asset": "btc", "min_local_ratio": 0.3, "max_local_ratio": 0.7},
+			{"peer_id": "peer", "asset": "lbtc", "min_local_ratio": 0.2, "max_local_ratio": 0.8, "max_sat_per_day": 1000000},
+			{"channel_id": "100x1x0", "asset": "btc", "min_local_ratio": 0.4, "max_local_ratio": 0.6, "max_fee_sat_per_day": 5000}
+		]
+	}`
+	fp := filepath.Join(t.TempDir(), "autoswap.json")
+	require.NoError(t, os.WriteFile(fp, []byte(rules), 0600))
+
+	actual, err := ReadRulesFromFile(fp)
+	require.NoError(t, err)
+	require.Len(t, actual, 3)
+	assert.Equal(t, "lbtc", actual[1].Asset)
+	assert.EqualValues(t, 1000000, actual[1].MaxSatPerDay)
+	assert.EqualValues(t, 5000, actual[2].MaxFeeSatPerDay)
+}
+
+func Test_ValidateRules(t *testing.T) {
+	tests := map[string][]*Rule{
+		"invalid asset":    {{Asset: "eth", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}},
+		"invalid range":    {{Asset: "btc", MinLocalRatio: 0.7, MaxLocalRatio: 0.3}},
+		"ratio above one":  {{Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 1.5}},
+		"channel and peer": {{ChannelId: "1x1x1", PeerId: "peer", Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7}},
+		"duplicate": {
+			{PeerId: "peer", Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7},
+			{PeerId: "peer", Asset: "lbtc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7},
+		},
+		"max below min swap": {{Asset: "btc", MinLocalRatio: 0.3, MaxLocalRatio: 0.7, MaxSwapSat: 1000}},
+	}
+	for name, rules := range tests {
+		rules := rules
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, validateRules(rules))
+		})
+	}
+}
+
+func Test_MatchRule(t *testing.T) {
+	defaultRule := &Rule{Asset: "btc"}
+	peerRule := &Rule{PeerId: "peer", Asset: "btc"}
+	channelRule := &Rule{ChannelId: "100x1x0", Asset: "btc"}
+	rules := []*Rule{channelRule, peerRule, defaultRule}
+
+	assert.Equal(t, channelRule, matchRule(rules, &Channel{ChannelId: "100:1:0", PeerId: "peer"}))
+	assert.Equal(t, peerRule, matchRule(rules, &Channel{ChannelId: "100x2x0", PeerId: "peer"}))
+	assert.Equal(t, defaultRule, matchRule(rules, &Channel{ChannelId: "100x3x0", PeerId: "other"}))
+	assert.Nil(t, matchRule([]*Rule{peerRule}, &Channel{ChannelId: "100x3x0", PeerId: "other"}))
+}
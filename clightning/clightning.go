@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/elementsproject/peerswap/autoswap"
 	"github.com/elementsproject/peerswap/log"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -28,19 +29,33 @@ import (
 var methods = []peerswaprpcMethod{
 	//&ListNodes{}, we disable finding nodes with the featurebit for now, as you would only find clightning nodes
 	&ListPeers{},
+	&GetPeerReputation{},
+	&GetPeerStats{},
 	&LiquidSendToAddress{},
 	&GetSwap{},
+	&BumpSwapFee{},
+	&CancelSwap{},
+	&ListSwapEvents{},
+	&ExportSwaps{},
+	&ArchiveSwaps{},
+	&ListArchivedSwaps{},
 	&ListActiveSwaps{},
 	&AllowSwapRequests{},
 	&AddPeer{},
 	&RemovePeer{},
 	&AddSuspiciousPeer{},
 	&RemoveSuspiciousPeer{},
+	&EvaluatePolicy{},
+	&QuoteSwap{},
 	&SwapIn{},
 	&SwapOut{},
+	&BatchSwapOut{},
 	&ListSwaps{},
 	&LiquidGetAddress{},
 	&LiquidGetBalance{},
+	&BtcGetAddress{},
+	&BtcGetBalance{},
+	&BtcSendToAddress{},
 	&ReloadPolicyFile{},
 	&GetRequestedSwaps{},
 	&ListConfig{},
@@ -79,7 +94,8 @@ type ClightningClient struct {
 	nodeId          string
 	hexToIdMap      map[string]string
 
-	ctx context.Context
+	ctx      context.Context
+	notifier *notificationWriter
 
 	isReady bool
 
@@ -147,6 +163,7 @@ func NewClightningClient(ctx context.Context) (*ClightningClient, <-chan interfa
 	cl.Plugin.SetDynamic(true)
 	cl.initChan = make(chan interface{})
 	cl.hexToIdMap = make(map[string]string)
+	cl.notifier = newNotificationWriter(os.Stdout, swapEventNotification)
 	return cl, cl.initChan, nil
 }
 
@@ -304,6 +321,27 @@ func (cl *ClightningClient) checkChannel(ch PeerChannel) error {
 	return nil
 }
 
+// ListChannels returns the channels of the node for the autoswap manager.
+func (cl *ClightningClient) ListChannels() ([]*autoswap.Channel, error) {
+	var res ListPeerChannelsResponse
+	err := cl.glightning.Request(ListPeerChannelsRequest{}, &res)
+	if err != nil {
+		return nil, err
+	}
+	var channels []*autoswap.Channel
+	for _, ch := range res.Channels {
+		if ch.ShortChannelId == "" {
+			continue
+		}
+		channels = append(channels, &autoswap.Channel{
+			ChannelId: ch.ShortChannelId,
+			PeerId:    ch.PeerId,
+			Active:    cl.checkChannel(ch) == nil,
+		})
+	}
+	return channels, nil
+}
+
 // GetNodeId returns the lightning nodes pubkey
 func (cl *ClightningClient) GetNodeId() string {
 	return cl.nodeId
@@ -334,6 +372,7 @@ func (cl *ClightningClient) SetupClients(liquidWallet wallet.Wallet,
 	if cl.bitcoinChain != nil {
 		cl.bitcoinNetwork = bitcoinChain.GetChain()
 	}
+	go cl.notifySwapEvents()
 }
 
 // SetPeerswapConfig injects the peerswap config that is used during runtime.
@@ -371,7 +410,16 @@ func (cl *ClightningClient) GetLightningRpc() *glightning.Lightning {
 
 // Start starts the Plugin
 func (cl *ClightningClient) Start() error {
-	return cl.Plugin.Start(os.Stdin, os.Stdout)
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := cl.notifier.forward(r); err != nil {
+			log2.Printf("could not forward plugin output: %v", err)
+		}
+	}()
+	return cl.Plugin.Start(os.Stdin, w)
 }
 
 // SendMessage sends a hexmessage to a peer
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 	"strings"
 	"time"
 
@@ -181,12 +180,174 @@ func (s *LiquidSendToAddress) LongDescription() string {
 	return "'"
 }
 
+type BtcGetAddress struct {
+	cl *ClightningClient `json:"-"`
+}
+
+func (g *BtcGetAddress) New() interface{} {
+	return &BtcGetAddress{
+		cl: g.cl,
+	}
+}
+
+func (g *BtcGetAddress) Name() string {
+	return "peerswap-btc-getaddress"
+}
+
+func (g *BtcGetAddress) Call() (jrpc2.Result, error) {
+	if !g.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if !g.cl.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+	res, err := g.cl.glightning.NewAddr()
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("[Wallet] Getting btc address %s", res)
+	return &BtcGetAddressResponse{BtcAddress: res}, nil
+}
+
+func (g *BtcGetAddress) Description() string {
+	return "Returns a new bitcoin address of the core lightning wallet."
+}
+
+func (g *BtcGetAddress) LongDescription() string {
+	return ""
+}
+
+func (g *BtcGetAddress) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &BtcGetAddress{
+		cl: client,
+	}
+}
+
+type BtcGetAddressResponse struct {
+	BtcAddress string `json:"btc_address"`
+}
+
+type BtcGetBalance struct {
+	cl *ClightningClient
+}
+
+func (g *BtcGetBalance) Name() string {
+	return "peerswap-btc-getbalance"
+}
+
+func (g *BtcGetBalance) New() interface{} {
+	return &BtcGetBalance{
+		cl: g.cl,
+	}
+}
+
+func (g *BtcGetBalance) Call() (jrpc2.Result, error) {
+	if !g.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if !g.cl.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+	confirmed, unconfirmed, err := g.cl.getBtcBalance()
+	if err != nil {
+		return nil, err
+	}
+	return &BtcGetBalanceResponse{
+		ConfirmedBalance:   confirmed,
+		UnconfirmedBalance: unconfirmed,
+		ReservedBalance:    g.cl.swaps.ReservedOnchainSat("btc"),
+	}, nil
+}
+
+func (g *BtcGetBalance) Description() string {
+	return "Returns the bitcoin balance"
+}
+
+func (g *BtcGetBalance) LongDescription() string {
+	return "The reserved balance is the part of the confirmed balance that pending swaps will spend on their opening transactions."
+}
+
+func (g *BtcGetBalance) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &BtcGetBalance{
+		cl: client,
+	}
+}
+
+type BtcGetBalanceResponse struct {
+	ConfirmedBalance   uint64 `json:"confirmed_balance_sat"`
+	UnconfirmedBalance uint64 `json:"unconfirmed_balance_sat"`
+	ReservedBalance    uint64 `json:"reserved_balance_sat"`
+}
+
+type BtcSendToAddress struct {
+	Address   string `json:"address"`
+	AmountSat uint64 `json:"amount_sat"`
+	cl        *ClightningClient
+}
+
+func (s *BtcSendToAddress) Name() string {
+	return "peerswap-btc-sendtoaddress"
+}
+
+func (s *BtcSendToAddress) New() interface{} {
+	return &BtcSendToAddress{
+		cl: s.cl,
+	}
+}
+
+func (s *BtcSendToAddress) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &BtcSendToAddress{
+		cl: client,
+	}
+}
+
+func (s *BtcSendToAddress) Call() (jrpc2.Result, error) {
+	if !s.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if !s.cl.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+	if s.Address == "" {
+		return nil, errors.New("address must be set")
+	}
+	if s.AmountSat == 0 {
+		return nil, errors.New("amount_sat must be set")
+	}
+	confirmed, _, err := s.cl.getBtcBalance()
+	if err != nil {
+		return nil, err
+	}
+	err = s.cl.swaps.CheckSpendable("btc", confirmed, s.AmountSat)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.cl.glightning.Withdraw(s.Address, glightning.NewSat64(s.AmountSat), nil, nil)
+	if err != nil {
+		log.Infof("Error sending to address %v", err)
+		return nil, err
+	}
+	return &SendToAddressResponse{TxId: res.TxId}, nil
+}
+
+func (s *BtcSendToAddress) Description() string {
+	return "sends btc to an address"
+}
+
+func (s *BtcSendToAddress) LongDescription() string {
+	return "Only confirmed funds that are not reserved by pending swaps are sent."
+}
+
 // SwapOut starts a new swapout (paying an Invoice for onchain liquidity)
 type SwapOut struct {
 	ShortChannelId string            `json:"short_channel_id"`
 	SatAmt         uint64            `json:"amt_sat"`
 	Asset          string            `json:"asset"`
 	Force          bool              `json:"force"`
+	MaxPremium     uint64            `json:"max_premium"`
 	cl             *ClightningClient `json:"-"`
 }
 
@@ -260,7 +421,7 @@ func (l *SwapOut) Call() (jrpc2.Result, error) {
 	}
 
 	pk := l.cl.GetNodeId()
-	swapOut, err := l.cl.swaps.SwapOut(fundingChannels.Id, l.Asset, l.ShortChannelId, pk, l.SatAmt)
+	swapOut, err := l.cl.swaps.SwapOut(fundingChannels.Id, l.Asset, l.ShortChannelId, pk, l.SatAmt, l.MaxPremium)
 	if err != nil {
 		return nil, err
 	}
@@ -300,12 +461,139 @@ func (g *SwapOut) Get(client *ClightningClient) jrpc2.ServerMethod {
 	}
 }
 
+type BatchSwapOutEntry struct {
+	ShortChannelId string `json:"short_channel_id"`
+	SatAmt         uint64 `json:"amt_sat"`
+}
+
+// BatchSwapOut starts a swap out for every given channel. Swap outs with the
+// same peer are funded by the peer in a single opening transaction.
+type BatchSwapOut struct {
+	Swaps      []*BatchSwapOutEntry `json:"swaps"`
+	Asset      string               `json:"asset"`
+	Force      bool                 `json:"force"`
+	MaxPremium uint64               `json:"max_premium"`
+	cl         *ClightningClient    `json:"-"`
+}
+
+func (l *BatchSwapOut) New() interface{} {
+	return &BatchSwapOut{
+		cl: l.cl,
+	}
+}
+
+func (l *BatchSwapOut) Name() string {
+	return "peerswap-batch-swap-out"
+}
+
+func (l *BatchSwapOut) Call() (jrpc2.Result, error) {
+	if !l.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if len(l.Swaps) == 0 {
+		return nil, errors.New("Missing required swaps parameter")
+	}
+	if strings.Compare(l.Asset, "btc") != 0 {
+		return nil, errors.New("invalid asset, batched swap outs are only supported for btc")
+	}
+	if !l.cl.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+
+	funds, err := l.cl.glightning.ListFunds()
+	if err != nil {
+		return nil, err
+	}
+	fundingChannels := make(map[string]*glightning.FundingChannel)
+	for _, v := range funds.Channels {
+		fundingChannels[v.ShortChannelId] = v
+	}
+
+	var requests []*swap.BatchSwapOutRequest
+	for _, entry := range l.Swaps {
+		if entry.SatAmt <= 0 {
+			return nil, errors.New("Missing required amt_sat parameter")
+		}
+		if entry.ShortChannelId == "" {
+			return nil, errors.New("Missing required short_channel_id parameter")
+		}
+		fundingChannel, ok := fundingChannels[entry.ShortChannelId]
+		if !ok {
+			return nil, fmt.Errorf("fundingChannels %s not found", entry.ShortChannelId)
+		}
+		if fundingChannel.AmountMilliSatoshi.MSat() < (entry.SatAmt+5000)*1000 {
+			return nil, fmt.Errorf("not enough outbound capacity on %s to perform swapOut", entry.ShortChannelId)
+		}
+		if !fundingChannel.Connected {
+			return nil, fmt.Errorf("fundingChannels %s is not connected", entry.ShortChannelId)
+		}
+
+		// Skip this check when `force` is set.
+		if !l.Force && !l.cl.peerRunsPeerSwap(fundingChannel.Id) {
+			return nil, fmt.Errorf("peer %s does not run peerswap", fundingChannel.Id)
+		}
+		if !l.cl.isPeerConnected(fundingChannel.Id) {
+			return nil, fmt.Errorf("peer %s is not connected", fundingChannel.Id)
+		}
+
+		requests = append(requests, &swap.BatchSwapOutRequest{
+			PeerId:    fundingChannel.Id,
+			ChannelId: entry.ShortChannelId,
+			AmtSat:    entry.SatAmt,
+		})
+	}
+
+	pk := l.cl.GetNodeId()
+	swapOuts, err := l.cl.swaps.BatchSwapOut(l.Asset, pk, requests, l.MaxPremium)
+	if err != nil {
+		return nil, err
+	}
+
+	// In order to be responsive we wait for the `opening_tx` of all swaps to
+	// be sent before we return. The peer waits for all swaps of a batch before
+	// funding them, so we share a single, longer timeout.
+	deadline := time.Now().Add(90 * time.Second)
+	res := &peerswaprpc.BatchSwapOutResponse{}
+	for _, swapOut := range swapOuts {
+		if !swapOut.WaitForStateChange(func(st swap.StateType) bool {
+			switch st {
+			case swap.State_SwapOutSender_AwaitTxConfirmation, swap.State_SwapCanceled:
+				return true
+			default:
+				return false
+			}
+		}, time.Until(deadline)) {
+			// Timeout.
+			return nil, errors.New("rpc timeout reached, use peerswap-listswaps for info")
+		}
+		res.Swaps = append(res.Swaps, peerswaprpc.PrettyprintFromServiceSwap(swapOut))
+	}
+	return res, nil
+}
+
+func (l *BatchSwapOut) Description() string {
+	return "Initiates swap outs on several channels"
+}
+
+func (l *BatchSwapOut) LongDescription() string {
+	return "Swap outs with the same peer are funded by the peer in a single opening transaction. " +
+		"Only available for btc."
+}
+
+func (g *BatchSwapOut) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &BatchSwapOut{
+		cl: client,
+	}
+}
+
 // SwapIn Starts a new swap in(providing onchain liquidity)
 type SwapIn struct {
 	ShortChannelId string `json:"short_channel_id"`
 	SatAmt         uint64 `json:"amt_sat"`
 	Asset          string `json:"asset"`
 	Force          bool   `json:"force"`
+	MaxPremium     uint64 `json:"max_premium"`
 
 	cl *ClightningClient `json:"-"`
 }
@@ -376,7 +664,7 @@ func (l *SwapIn) Call() (jrpc2.Result, error) {
 	}
 
 	pk := l.cl.GetNodeId()
-	swapIn, err := l.cl.swaps.SwapIn(fundingChannels.Id, l.Asset, l.ShortChannelId, pk, l.SatAmt)
+	swapIn, err := l.cl.swaps.SwapIn(fundingChannels.Id, l.Asset, l.ShortChannelId, pk, l.SatAmt, l.MaxPremium)
 	if err != nil {
 		return nil, err
 	}
@@ -419,6 +707,18 @@ func (g *SwapIn) Get(client *ClightningClient) jrpc2.ServerMethod {
 // ListSwaps list all active and finished swaps
 type ListSwaps struct {
 	DetailedPrint bool              `json:"detailed,omitempty"`
+	PeerNodeId    string            `json:"peer_node_id,omitempty"`
+	ChannelId     string            `json:"channel_id,omitempty"`
+	Asset         string            `json:"asset,omitempty"`
+	Type          string            `json:"type,omitempty"`
+	Role          string            `json:"role,omitempty"`
+	States        []string          `json:"states,omitempty"`
+	Status        string            `json:"status,omitempty"`
+	CreatedAfter  int64             `json:"created_after,omitempty"`
+	CreatedBefore int64             `json:"created_before,omitempty"`
+	Descending    bool              `json:"descending,omitempty"`
+	Cursor        string            `json:"cursor,omitempty"`
+	Limit         uint32            `json:"limit,omitempty"`
 	cl            *ClightningClient `json:"-"`
 }
 
@@ -437,32 +737,56 @@ func (l *ListSwaps) Call() (jrpc2.Result, error) {
 		return nil, ErrWaitingForReady
 	}
 
-	swaps, err := l.cl.swaps.ListSwaps()
+	filter, err := peerswaprpc.NewListSwapsFilter(&peerswaprpc.ListSwapsRequest{
+		PeerNodeId:    l.PeerNodeId,
+		ChannelId:     l.ChannelId,
+		Asset:         l.Asset,
+		Type:          l.Type,
+		Role:          l.Role,
+		States:        l.States,
+		Status:        l.Status,
+		CreatedAfter:  l.CreatedAfter,
+		CreatedBefore: l.CreatedBefore,
+		Descending:    l.Descending,
+		Cursor:        l.Cursor,
+		Limit:         l.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	swaps, nextCursor, err := l.cl.swaps.ListSwapsFiltered(filter)
 	if err != nil {
 		return nil, err
 	}
-	sort.Slice(swaps, func(i, j int) bool {
-		if swaps[i].Data != nil && swaps[j].Data != nil {
-			return swaps[i].Data.CreatedAt < swaps[j].Data.CreatedAt
-		}
-		return false
-	})
 	if !l.DetailedPrint {
 		var pretty []*peerswaprpc.PrettyPrintSwap
 		for _, v := range swaps {
 			pretty = append(pretty, peerswaprpc.PrettyprintFromServiceSwap(v))
 		}
-		return &peerswaprpc.ListSwapsResponse{Swaps: pretty}, nil
+		return &peerswaprpc.ListSwapsResponse{Swaps: pretty, NextCursor: nextCursor}, nil
+	}
+	if l.Limit > 0 {
+		return &DetailedSwapsPage{Swaps: swaps, NextCursor: nextCursor}, nil
 	}
 	return swaps, nil
 }
 
+// DetailedSwapsPage is a page of the detailed swaps.
+type DetailedSwapsPage struct {
+	Swaps      []*swap.SwapStateMachine `json:"swaps"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
 func (l *ListSwaps) Description() string {
 	return "Returns a list of historical swaps."
 }
 
 func (l *ListSwaps) LongDescription() string {
-	return ""
+	return "Filters the swaps by peer_node_id, channel_id (short channel id), asset (btc or lbtc), " +
+		"type (swap-out or swap-in), role (sender or receiver), states, status (active or finished) " +
+		"and created_after/created_before (unix timestamps in seconds). The swaps are ordered by " +
+		"creation time, newest first if descending is set. If limit is set a page of swaps is " +
+		"returned, pass its next_cursor as cursor to get the next page."
 }
 
 func (g *ListSwaps) Get(client *ClightningClient) jrpc2.ServerMethod {
@@ -582,6 +906,10 @@ func (l *ListPeers) Call() (jrpc2.Result, error) {
 			if err != nil {
 				return nil, err
 			}
+			reputation, err := l.cl.swaps.GetPeerReputation(peer.Id)
+			if err != nil {
+				return nil, err
+			}
 
 			var paidFees uint64
 			var ReceiverSwapsOut, ReceiverSwapsIn, ReceiverSatsOut, ReceiverSatsIn uint64
@@ -627,7 +955,9 @@ func (l *ListPeers) Call() (jrpc2.Result, error) {
 					SatsOut:  ReceiverSatsOut,
 					SatsIn:   ReceiverSatsIn,
 				},
-				PaidFee: paidFees,
+				PaidFee:    paidFees,
+				Policy:     l.cl.policy.GetPeerPolicy(peer.Id),
+				Reputation: reputation,
 			}
 			channels, err := l.cl.glightning.ListChannelsBySource(peer.Id)
 			if err != nil {
@@ -697,6 +1027,410 @@ func (g *GetSwap) LongDescription() string {
 	return ""
 }
 
+type BumpSwapFee struct {
+	SwapId      string `json:"swap_id"`
+	TargetConf  uint32 `json:"target_conf,omitempty"`
+	SatPerVbyte uint64 `json:"sat_per_vbyte,omitempty"`
+	cl          *ClightningClient
+}
+
+func (b *BumpSwapFee) Name() string {
+	return "peerswap-bump-swap-fee"
+}
+
+func (b *BumpSwapFee) New() interface{} {
+	return &BumpSwapFee{
+		cl:          b.cl,
+		SwapId:      b.SwapId,
+		TargetConf:  b.TargetConf,
+		SatPerVbyte: b.SatPerVbyte,
+	}
+}
+
+func (b *BumpSwapFee) Call() (jrpc2.Result, error) {
+	if !b.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if b.SwapId == "" {
+		return nil, errors.New("swap_id required")
+	}
+	return b.cl.swaps.BumpSwapFee(b.SwapId, b.TargetConf, b.SatPerVbyte)
+}
+
+func (b *BumpSwapFee) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &BumpSwapFee{
+		cl: client,
+	}
+}
+
+func (b *BumpSwapFee) Description() string {
+	return "raises the fee of the unconfirmed opening or claim transaction of a swap"
+}
+
+func (b *BumpSwapFee) LongDescription() string {
+	return "The claim transaction is replaced (RBF), the opening transaction is paid for with a child transaction (CPFP). " +
+		"Set either target_conf or sat_per_vbyte, the default is a confirmation target of 2 blocks."
+}
+
+type CancelSwap struct {
+	SwapId string `json:"swap_id"`
+	Reason string `json:"reason,omitempty"`
+	cl     *ClightningClient
+}
+
+func (c *CancelSwap) Name() string {
+	return "peerswap-cancel-swap"
+}
+
+func (c *CancelSwap) New() interface{} {
+	return &CancelSwap{
+		cl:     c.cl,
+		SwapId: c.SwapId,
+		Reason: c.Reason,
+	}
+}
+
+func (c *CancelSwap) Call() (jrpc2.Result, error) {
+	if !c.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	if c.SwapId == "" {
+		return nil, errors.New("swap_id required")
+	}
+	swap, err := c.cl.swaps.CancelSwap(c.SwapId, c.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return peerswaprpc.PrettyprintFromServiceSwap(swap), nil
+}
+
+func (c *CancelSwap) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &CancelSwap{
+		cl: client,
+	}
+}
+
+func (c *CancelSwap) Description() string {
+	return "cancels a swap that we started and that waits for the agreement of the peer"
+}
+
+func (c *CancelSwap) LongDescription() string {
+	return "A swap-out can be canceled before the fee invoice is paid, a swap-in before the opening transaction " +
+		"is broadcasted. The reason is sent to the peer and the channel is released for new swaps."
+}
+
+type ListSwapEvents struct {
+	ReplaySince int64 `json:"replay_since,omitempty"`
+	cl          *ClightningClient
+}
+
+func (l *ListSwapEvents) Name() string {
+	return "peerswap-listswapevents"
+}
+
+func (l *ListSwapEvents) New() interface{} {
+	return &ListSwapEvents{
+		cl:          l.cl,
+		ReplaySince: l.ReplaySince,
+	}
+}
+
+func (l *ListSwapEvents) Call() (jrpc2.Result, error) {
+	if !l.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	// Use 1 instead of 0 as 0 skips the replay.
+	since := l.ReplaySince
+	if since == 0 {
+		since = 1
+	}
+	events, _, cancel := l.cl.swaps.SubscribeSwapEvents(since)
+	cancel()
+	if events == nil {
+		events = []*swap.SwapEvent{}
+	}
+	return events, nil
+}
+
+func (l *ListSwapEvents) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &ListSwapEvents{
+		cl: client,
+	}
+}
+
+func (l *ListSwapEvents) Description() string {
+	return "lists the recent swap events"
+}
+
+func (l *ListSwapEvents) LongDescription() string {
+	return "Lists the swap events after replay_since, a unix timestamp in nanoseconds. " +
+		"Use it to catch up on the " + swapEventNotification + " notifications after a reconnect."
+}
+
+type ExportSwaps struct {
+	StartTime  int64  `json:"start_time,omitempty"`
+	EndTime    int64  `json:"end_time,omitempty"`
+	PeerNodeId string `json:"peer_node_id,omitempty"`
+	Format     string `json:"format,omitempty"`
+	cl         *ClightningClient
+}
+
+func (l *ExportSwaps) Name() string {
+	return "peerswap-exportswaps"
+}
+
+func (l *ExportSwaps) New() interface{} {
+	return &ExportSwaps{
+		cl:         l.cl,
+		StartTime:  l.StartTime,
+		EndTime:    l.EndTime,
+		PeerNodeId: l.PeerNodeId,
+		Format:     l.Format,
+	}
+}
+
+func (l *ExportSwaps) Call() (jrpc2.Result, error) {
+	if !l.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	filter := &swap.ExportFilter{PeerNodeId: l.PeerNodeId}
+	if l.StartTime != 0 {
+		filter.From = time.Unix(l.StartTime, 0)
+	}
+	if l.EndTime != 0 {
+		filter.To = time.Unix(l.EndTime, 0)
+	}
+	exports, err := l.cl.swaps.ExportSwaps(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch l.Format {
+	case "", "json":
+		if exports == nil {
+			exports = []*swap.SwapExport{}
+		}
+		return exports, nil
+	case "csv":
+		var buf strings.Builder
+		if err := swap.WriteSwapExportsCsv(&buf, exports); err != nil {
+			return nil, err
+		}
+		return map[string]string{"csv": buf.String()}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %s, expected json or csv", l.Format)
+	}
+}
+
+func (l *ExportSwaps) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &ExportSwaps{
+		cl: client,
+	}
+}
+
+func (l *ExportSwaps) Description() string {
+	return "exports the fees and amounts of the finished swaps"
+}
+
+func (l *ExportSwaps) LongDescription() string {
+	return "Exports one row per finished swap with the fee and claim invoices, the on-chain fees, " +
+		"the premium and the net gain or cost in sat and msat. start_time and end_time filter " +
+		"by creation time as unix timestamps in seconds, format is json (default) or csv."
+}
+
+type ArchiveSwaps struct {
+	OlderThanDays uint32 `json:"older_than_days"`
+	cl            *ClightningClient
+}
+
+func (l *ArchiveSwaps) Name() string {
+	return "peerswap-archiveswaps"
+}
+
+func (l *ArchiveSwaps) New() interface{} {
+	return &ArchiveSwaps{
+		cl:            l.cl,
+		OlderThanDays: l.OlderThanDays,
+	}
+}
+
+func (l *ArchiveSwaps) Call() (jrpc2.Result, error) {
+	if !l.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	archived, err := l.cl.swaps.ArchiveSwaps(time.Duration(l.OlderThanDays) * 24 * time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	if archived == nil {
+		archived = []*swap.ArchivedSwap{}
+	}
+	return archived, nil
+}
+
+func (l *ArchiveSwaps) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &ArchiveSwaps{
+		cl: client,
+	}
+}
+
+func (l *ArchiveSwaps) Description() string {
+	return "moves finished swaps into the archive"
+}
+
+func (l *ArchiveSwaps) LongDescription() string {
+	return "Archives the swaps that finished more than older_than_days (at least 1) ago and whose claim " +
+		"transaction is deeply confirmed. Archived swaps keep their accounting record but no " +
+		"key material, preimages or raw transactions."
+}
+
+type ListArchivedSwaps struct {
+	StartTime  int64  `json:"start_time,omitempty"`
+	EndTime    int64  `json:"end_time,omitempty"`
+	PeerNodeId string `json:"peer_node_id,omitempty"`
+	cl         *ClightningClient
+}
+
+func (l *ListArchivedSwaps) Name() string {
+	return "peerswap-listarchivedswaps"
+}
+
+func (l *ListArchivedSwaps) New() interface{} {
+	return &ListArchivedSwaps{
+		cl:         l.cl,
+		StartTime:  l.StartTime,
+		EndTime:    l.EndTime,
+		PeerNodeId: l.PeerNodeId,
+	}
+}
+
+func (l *ListArchivedSwaps) Call() (jrpc2.Result, error) {
+	if !l.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+
+	filter := &swap.ExportFilter{PeerNodeId: l.PeerNodeId}
+	if l.StartTime != 0 {
+		filter.From = time.Unix(l.StartTime, 0)
+	}
+	if l.EndTime != 0 {
+		filter.To = time.Unix(l.EndTime, 0)
+	}
+	archived, err := l.cl.swaps.ListArchivedSwaps(filter)
+	if err != nil {
+		return nil, err
+	}
+	if archived == nil {
+		archived = []*swap.ArchivedSwap{}
+	}
+	return archived, nil
+}
+
+func (l *ListArchivedSwaps) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &ListArchivedSwaps{
+		cl: client,
+	}
+}
+
+func (l *ListArchivedSwaps) Description() string {
+	return "lists the archived swaps"
+}
+
+func (l *ListArchivedSwaps) LongDescription() string {
+	return "Lists the archived swaps. start_time and end_time filter by creation time as unix " +
+		"timestamps in seconds."
+}
+
+type GetPeerReputation struct {
+	PeerPubkey string `json:"peer_pubkey"`
+	cl         *ClightningClient
+}
+
+func (g *GetPeerReputation) Name() string {
+	return "peerswap-getpeerreputation"
+}
+
+func (g *GetPeerReputation) New() interface{} {
+	return &GetPeerReputation{
+		cl:         g.cl,
+		PeerPubkey: g.PeerPubkey,
+	}
+}
+
+func (g *GetPeerReputation) Call() (jrpc2.Result, error) {
+	if !g.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+	if g.PeerPubkey == "" {
+		return nil, errors.New("missing required peer_pubkey parameter")
+	}
+	return g.cl.swaps.GetPeerReputation(g.PeerPubkey)
+}
+
+func (g *GetPeerReputation) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &GetPeerReputation{
+		cl: client,
+	}
+}
+
+func (g *GetPeerReputation) Description() string {
+	return "returns the reputation of a peer"
+}
+
+func (g *GetPeerReputation) LongDescription() string {
+	return "Returns the reputation score of a peer, the counts of the outcomes of the finished " +
+		"swaps with the peer in the reputation window and the history of all outcomes."
+}
+
+type GetPeerStats struct {
+	PeerPubkey string `json:"peer_pubkey"`
+	Swaps      uint32 `json:"swaps,omitempty"`
+	cl         *ClightningClient
+}
+
+func (g *GetPeerStats) Name() string {
+	return "peerswap-getpeerstats"
+}
+
+func (g *GetPeerStats) New() interface{} {
+	return &GetPeerStats{
+		cl:         g.cl,
+		PeerPubkey: g.PeerPubkey,
+		Swaps:      g.Swaps,
+	}
+}
+
+func (g *GetPeerStats) Call() (jrpc2.Result, error) {
+	if !g.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+	if g.PeerPubkey == "" {
+		return nil, errors.New("missing required peer_pubkey parameter")
+	}
+	return g.cl.swaps.GetPeerStats(g.PeerPubkey, int(g.Swaps))
+}
+
+func (g *GetPeerStats) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &GetPeerStats{
+		cl: client,
+	}
+}
+
+func (g *GetPeerStats) Description() string {
+	return "returns the stats of the swaps with a peer"
+}
+
+func (g *GetPeerStats) LongDescription() string {
+	return "Returns the success rate, the median completion time, the cancel reasons, the " +
+		"volume per asset and direction, the fees paid and earned, the csv claims and the " +
+		"last swaps (10 if swaps is 0) of the finished swaps with a peer."
+}
+
 type PolicyReloader interface {
 	AddToAllowlist(pubkey string) error
 	RemoveFromAllowlist(pubkey string) error
@@ -707,6 +1441,7 @@ type PolicyReloader interface {
 	EnableSwaps() error
 	ReloadFile() error
 	Get() policy.Policy
+	GetPeerPolicy(peer string) *policy.PeerPolicy
 }
 type ReloadPolicyFile struct {
 	cl *ClightningClient
@@ -1061,6 +1796,130 @@ func (c RemoveSuspiciousPeer) LongDescription() string {
 		`with this node`
 }
 
+type EvaluatePolicy struct {
+	PeerPubkey     string `json:"peer_pubkey"`
+	Asset          string `json:"asset"`
+	Type           string `json:"type"`
+	SatAmt         uint64 `json:"amt_sat"`
+	ShortChannelId string `json:"short_channel_id,omitempty"`
+	cl             *ClightningClient
+}
+
+func (e *EvaluatePolicy) Name() string {
+	return "peerswap-evaluatepolicy"
+}
+
+func (e *EvaluatePolicy) New() interface{} {
+	return &EvaluatePolicy{
+		cl: e.cl,
+	}
+}
+
+func (e *EvaluatePolicy) Call() (jrpc2.Result, error) {
+	if !e.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+	if e.PeerPubkey == "" {
+		return nil, errors.New("missing required peer_pubkey parameter")
+	}
+	if e.SatAmt == 0 {
+		return nil, errors.New("missing required amt_sat parameter")
+	}
+	swapType, err := swap.ParseSwapType(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	return e.cl.swaps.EvaluatePolicy(e.PeerPubkey, e.Asset, swapType, e.SatAmt, e.ShortChannelId)
+}
+
+func (e *EvaluatePolicy) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &EvaluatePolicy{
+		cl: client,
+	}
+}
+
+func (e *EvaluatePolicy) Description() string {
+	return "checks a swap request of a peer against the policy"
+}
+
+func (e *EvaluatePolicy) LongDescription() string {
+	return "Runs the checks of a swap request of a peer without creating a swap and " +
+		"returns the result and the reason of every check. The type is the swap-out " +
+		"or swap-in that the peer requests. The channel balance is only checked if " +
+		"short_channel_id is given."
+}
+
+type QuoteSwap struct {
+	ShortChannelId string `json:"short_channel_id"`
+	Asset          string `json:"asset"`
+	Type           string `json:"type"`
+	SatAmt         uint64 `json:"amt_sat"`
+	cl             *ClightningClient
+}
+
+func (q *QuoteSwap) Name() string {
+	return "peerswap-quoteswap"
+}
+
+func (q *QuoteSwap) New() interface{} {
+	return &QuoteSwap{
+		cl: q.cl,
+	}
+}
+
+func (q *QuoteSwap) Call() (jrpc2.Result, error) {
+	if !q.cl.isReady {
+		return nil, ErrWaitingForReady
+	}
+	if q.ShortChannelId == "" {
+		return nil, errors.New("missing required short_channel_id parameter")
+	}
+	if q.SatAmt == 0 {
+		return nil, errors.New("missing required amt_sat parameter")
+	}
+	swapType, err := swap.ParseSwapType(q.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	funds, err := q.cl.glightning.ListFunds()
+	if err != nil {
+		return nil, err
+	}
+	var fundingChannel *glightning.FundingChannel
+	for _, v := range funds.Channels {
+		if v.ShortChannelId == q.ShortChannelId {
+			fundingChannel = v
+			break
+		}
+	}
+	if fundingChannel == nil {
+		return nil, errors.New("fundingChannels not found")
+	}
+
+	terms, err := q.cl.pollService.GetPeerTerms(fundingChannel.Id)
+	if err != nil {
+		return nil, err
+	}
+	return q.cl.swaps.QuoteSwap(fundingChannel.Id, q.Asset, swapType, q.SatAmt, q.ShortChannelId, terms)
+}
+
+func (q *QuoteSwap) Get(client *ClightningClient) jrpc2.ServerMethod {
+	return &QuoteSwap{
+		cl: client,
+	}
+}
+
+func (q *QuoteSwap) Description() string {
+	return "estimates the fees and the premium of a swap"
+}
+
+func (q *QuoteSwap) LongDescription() string {
+	return "Estimates the fees and the premium of a swap-out or swap-in on the channel " +
+		"without starting it, and runs the checks of our policy and the checks " +
+		"against the terms that the peer announced in its last poll."
+}
+
 type ListConfig struct {
 	cl *ClightningClient
 }
@@ -1119,6 +1978,8 @@ type PeerSwapPeer struct {
 	AsSender        *SwapStats             `json:"sent,omitempty"`
 	AsReceiver      *SwapStats             `json:"received,omitempty"`
 	PaidFee         uint64                 `json:"total_fee_paid,omitempty"`
+	Policy          *policy.PeerPolicy     `json:"policy,omitempty"`
+	Reputation      *swap.PeerReputation   `json:"reputation,omitempty"`
 }
 
 // checkFeatures checks if a node runs the peerswap Plugin
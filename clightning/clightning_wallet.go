@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/elementsproject/glightning/glightning"
 	"github.com/elementsproject/peerswap/lightning"
 	"github.com/elementsproject/peerswap/onchain"
@@ -60,6 +62,99 @@ func (cl *ClightningClient) CreateOpeningTransaction(swapParams *swap.OpeningPar
 	return sendRes.SignedTx, addr, sendRes.TxId, fee, vout, nil
 }
 
+// PrepareBatchOpeningTransaction creates and signs a transaction that funds
+// the opening outputs of several swaps. The inputs stay reserved until the
+// transaction is published with PublishBatchOpeningTransaction.
+func (cl *ClightningClient) PrepareBatchOpeningTransaction(swapParams []*swap.OpeningParams) (*swap.BatchOpeningTx, error) {
+	var addresses []string
+	var outputs []*glightning.Outputs
+	for _, params := range swapParams {
+		addr, err := cl.bitcoinChain.CreateOpeningAddress(params, onchain.BitcoinCsv)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addr)
+		outputs = append(outputs, &glightning.Outputs{
+			Address: addr,
+			Satoshi: params.Amount,
+		})
+	}
+	prepRes, err := cl.glightning.PrepareTx(outputs, &glightning.FeeRate{Directive: glightning.Urgent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// See CreateOpeningTransaction.
+	isV2, err := version.CompareVersionStrings(cl.Version(), "v23.05")
+	if err != nil {
+		return nil, err
+	}
+	if isV2 {
+		res, err := cl.glightning.SetPSBTVersion(prepRes.Psbt, 0)
+		if err != nil {
+			return nil, err
+		}
+		prepRes.Psbt = res.Psbt
+	}
+
+	fee, err := cl.bitcoinChain.GetFeeSatsFromTx(prepRes.Psbt, prepRes.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vouts []uint32
+	for _, params := range swapParams {
+		_, vout, err := cl.bitcoinChain.GetVoutAndVerify(prepRes.UnsignedTx, params)
+		if err != nil {
+			return nil, err
+		}
+		vouts = append(vouts, vout)
+	}
+
+	signRes, err := cl.glightning.SignPSBT(prepRes.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The wallet only spends segwit inputs, the txid of the unsigned tx is
+	// the txid of the published tx.
+	return &swap.BatchOpeningTx{
+		TxId:      prepRes.TxId,
+		TxHex:     prepRes.UnsignedTx,
+		Psbt:      signRes.SignedPSBT,
+		Fee:       fee,
+		Addresses: addresses,
+		Vouts:     vouts,
+	}, nil
+}
+
+// PublishBatchOpeningTransaction publishes a batch opening transaction that
+// was prepared by PrepareBatchOpeningTransaction.
+func (cl *ClightningClient) PublishBatchOpeningTransaction(tx *swap.BatchOpeningTx) (string, error) {
+	var res sendPsbtResult
+	err := cl.glightning.Request(&sendPsbtRequest{Psbt: tx.Psbt}, &res)
+	if err != nil {
+		return "", err
+	}
+	if res.TxId != tx.TxId {
+		return "", fmt.Errorf("published tx %s, expected %s", res.TxId, tx.TxId)
+	}
+	return res.Tx, nil
+}
+
+type sendPsbtRequest struct {
+	Psbt string `json:"psbt"`
+}
+
+func (r *sendPsbtRequest) Name() string {
+	return "sendpsbt"
+}
+
+type sendPsbtResult struct {
+	Tx   string `json:"tx"`
+	TxId string `json:"txid"`
+}
+
 func (cl *ClightningClient) CreatePreimageSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams) (txId, txHex, address string, err error) {
 
 	_, vout, err := cl.bitcoinChain.GetVoutAndVerify(claimParams.OpeningTxHex, swapParams)
@@ -72,6 +167,14 @@ func (cl *ClightningClient) CreatePreimageSpendingTransaction(swapParams *swap.O
 		return "", "", "", err
 	}
 
+	if swapParams.Taproot {
+		tx, err := cl.bitcoinChain.CreateTaprootPreimageSpendingTransaction(swapParams, claimParams, newAddr, vout)
+		if err != nil {
+			return "", "", "", err
+		}
+		return cl.publishSpendingTx(tx, newAddr)
+	}
+
 	tx, sigHash, redeemScript, err := cl.bitcoinChain.PrepareSpendingTransaction(swapParams, claimParams, newAddr, vout, 0, 0)
 	if err != nil {
 		return "", "", "", err
@@ -115,6 +218,14 @@ func (cl *ClightningClient) CreateCsvSpendingTransaction(swapParams *swap.Openin
 		return "", "", "", err
 	}
 
+	if swapParams.Taproot {
+		tx, err := cl.bitcoinChain.CreateTaprootCsvSpendingTransaction(swapParams, claimParams, newAddr, vout)
+		if err != nil {
+			return "", "", "", err
+		}
+		return cl.publishSpendingTx(tx, newAddr)
+	}
+
 	tx, sigHash, redeemScript, err := cl.bitcoinChain.PrepareSpendingTransaction(swapParams, claimParams, newAddr, vout, onchain.BitcoinCsv, 0)
 	if err != nil {
 		return "", "", "", err
@@ -156,6 +267,14 @@ func (cl *ClightningClient) CreateCoopSpendingTransaction(swapParams *swap.Openi
 	if err != nil {
 		return "", "", "", err
 	}
+	if swapParams.Taproot {
+		tx, err := cl.bitcoinChain.CreateTaprootCoopSpendingTransaction(swapParams, claimParams, refundAddr, vout, refundFee, takerSigner)
+		if err != nil {
+			return "", "", "", err
+		}
+		return cl.publishSpendingTx(tx, refundAddr)
+	}
+
 	spendingTx, sigHashBytes, redeemScript, err := cl.bitcoinChain.PrepareSpendingTransaction(swapParams, claimParams, refundAddr, vout, 0, refundFee)
 	if err != nil {
 		return "", "", "", err
@@ -188,6 +307,23 @@ func (cl *ClightningClient) CreateCoopSpendingTransaction(swapParams *swap.Openi
 	return spendingTx.TxHash().String(), txHex, address, nil
 }
 
+// publishSpendingTx publishes a signed transaction that spends the opening
+// output to address.
+func (cl *ClightningClient) publishSpendingTx(tx *wire.MsgTx, address string) (txId, txHex, addr string, err error) {
+	bytesBuffer := new(bytes.Buffer)
+	err = tx.Serialize(bytesBuffer)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	txHex = hex.EncodeToString(bytesBuffer.Bytes())
+	txId, err = cl.gbitcoin.SendRawTx(txHex)
+	if err != nil {
+		return "", "", "", err
+	}
+	return txId, txHex, address, nil
+}
+
 func (cl *ClightningClient) SetLabel(txID, address, label string) error {
 	// todo implement
 	// This function assigns an identifiable label to the target transaction based on the txid.
@@ -225,6 +361,25 @@ func (cl *ClightningClient) GetOnchainBalance() (uint64, error) {
 	return totalBalance, nil
 }
 
+// getBtcBalance returns the confirmed and the unconfirmed balance of the
+// wallet in sat.
+func (cl *ClightningClient) getBtcBalance() (confirmed, unconfirmed uint64, err error) {
+	funds, err := cl.glightning.ListFunds()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, output := range funds.Outputs {
+		switch output.Status {
+		case "confirmed":
+			confirmed += output.AmountMilliSatoshi.MSat() / 1000
+		case "unconfirmed":
+			unconfirmed += output.AmountMilliSatoshi.MSat() / 1000
+		}
+	}
+	return confirmed, unconfirmed, nil
+}
+
 // GetFlatOpeningTXFee returns an estimated size for the opening transaction. This
 // can be used to calculate the amount of the fee invoice and should cover most
 // but not all cases. For an explanation of the estimation see comments of the
@@ -233,6 +388,95 @@ func (cl *ClightningClient) GetFlatOpeningTXFee() (uint64, error) {
 	return cl.bitcoinChain.GetFee(onchain.EstimatedOpeningTxSize)
 }
 
+// GetFeeRate returns the estimated fee rate in sat/vb for a confirmation within
+// targetConf blocks.
+func (cl *ClightningClient) GetFeeRate(targetConf uint32) (uint64, error) {
+	return cl.bitcoinChain.GetFeeRate(targetConf)
+}
+
+func (cl *ClightningClient) GetSpendingTxFee(swapParams *swap.OpeningParams, txHex string) (uint64, error) {
+	return cl.bitcoinChain.GetSpendingTxFee(swapParams, txHex)
+}
+
+// GetTxConfirmations returns the confirmations of a transaction from the
+// wallet of core lightning. The wallet only learns about incoming transactions
+// once they are confirmed, so an unknown transaction is unconfirmed.
+func (cl *ClightningClient) GetTxConfirmations(txId string) (uint32, error) {
+	txs, err := cl.glightning.ListTransactions()
+	if err != nil {
+		return 0, err
+	}
+	for _, tx := range txs {
+		if tx.Hash != txId || tx.Blockheight == 0 {
+			continue
+		}
+		info, err := cl.glightning.GetInfo()
+		if err != nil {
+			return 0, err
+		}
+		return uint32(info.Blockheight-tx.Blockheight) + 1, nil
+	}
+	return 0, nil
+}
+
+type getMempoolEntryRequest struct {
+	TxId string `json:"txid"`
+}
+
+func (r *getMempoolEntryRequest) Name() string {
+	return "getmempoolentry"
+}
+
+type mempoolEntry struct {
+	Vsize uint64 `json:"vsize"`
+	Fees  struct {
+		Base float64 `json:"base"`
+	} `json:"fees"`
+}
+
+// BumpOpeningTransaction spends the unconfirmed change output of the opening
+// transaction to a new address of the wallet with a fee rate that pays for the
+// opening transaction (CPFP). As the change is spent by the first child, the
+// opening transaction can only be bumped once.
+func (cl *ClightningClient) BumpOpeningTransaction(openingTxHex string, satPerVbyte uint64) (string, error) {
+	txId, err := cl.bitcoinChain.TxIdFromHex(openingTxHex)
+	if err != nil {
+		return "", err
+	}
+	var entry mempoolEntry
+	err = cl.gbitcoin.Request(&getMempoolEntryRequest{TxId: txId}, &entry)
+	if err != nil {
+		return "", fmt.Errorf("opening transaction %s is not in the mempool: %w", txId, err)
+	}
+
+	funds, err := cl.glightning.ListFunds()
+	if err != nil {
+		return "", err
+	}
+	var change *glightning.Utxo
+	for _, output := range funds.Outputs {
+		if output.TxId == txId {
+			change = &glightning.Utxo{TxId: output.TxId, Index: uint(output.Output)}
+		}
+	}
+	if change == nil {
+		return "", fmt.Errorf("opening transaction %s has no unspent change output", txId)
+	}
+
+	newAddr, err := cl.glightning.NewAddr()
+	if err != nil {
+		return "", err
+	}
+	parentFee := uint64(math.Round(entry.Fees.Base * 1e8))
+	childFeeRate := onchain.GetCpfpFeeRate(entry.Vsize, parentFee, satPerVbyte)
+	minConf := uint16(0)
+	res, err := cl.glightning.WithdrawWithUtxos(newAddr, glightning.AllSats(), glightning.NewFeeRate(glightning.PerKb, uint(childFeeRate*1000)), &minConf, []*glightning.Utxo{change})
+	if err != nil {
+		return "", err
+	}
+	return res.TxId, nil
+}
+
 func (cl *ClightningClient) GetAsset() string {
 	return ""
 }
@@ -27,6 +27,7 @@ const (
 	defaultPolicyFileName   = "policy.conf"
 	defaultConfigFileName   = "peerswap.conf"
 	defaultPeerswapSubDir   = "peerswap"
+	defaultAutoSwapRules    = "autoswap.json"
 )
 
 type BitcoinConf struct {
@@ -52,6 +53,40 @@ type LiquidConf struct {
 	LiquidSwaps     *bool
 }
 
+// AutoSwapConf is the config of the automatic liquidity manager.
+type AutoSwapConf struct {
+	Enabled   bool
+	DryRun    bool
+	Interval  string
+	RulesFile string
+}
+
+// MetricsConf is the config of the prometheus metrics endpoint.
+type MetricsConf struct {
+	// Listen is the host to serve the metrics on, disabled if empty.
+	Listen string
+}
+
+// DatabaseConf selects the database backend.
+type DatabaseConf struct {
+	// Backend is bolt (default), sqlite or postgres.
+	Backend string
+	// Dsn is the path to the sqlite database or the postgres connection
+	// string. Sqlite defaults to swaps.sqlite in the peerswap dir.
+	Dsn string
+	// ArchiveAfterDays archives finished swaps after this number of days,
+	// disabled if 0.
+	ArchiveAfterDays uint32
+}
+
+// WebhookConf is the config of the webhooks that swap events are posted to.
+type WebhookConf struct {
+	Urls []string
+	// Secret signs the payloads, generated in webhook.secret in the
+	// peerswap dir if empty.
+	Secret string
+}
+
 type Config struct {
 	LightningDir string
 	PeerswapDir  string
@@ -60,6 +95,10 @@ type Config struct {
 	Bitcoin      *BitcoinConf
 	Liquid       *LiquidConf
 	LWK          *lwk.Conf
+	AutoSwap     *AutoSwapConf
+	Metrics      *MetricsConf
+	Database     *DatabaseConf
+	Webhook      *WebhookConf
 }
 
 func (c Config) String() string {
@@ -69,6 +108,16 @@ func (c Config) String() string {
 	lcopy.RpcPassword = "*****"
 	c.Bitcoin = &bcopy
 	c.Liquid = &lcopy
+	if c.Database != nil && c.Database.Dsn != "" {
+		dcopy := *c.Database
+		dcopy.Dsn = "*****"
+		c.Database = &dcopy
+	}
+	if c.Webhook != nil && c.Webhook.Secret != "" {
+		wcopy := *c.Webhook
+		wcopy.Secret = "*****"
+		c.Webhook = &wcopy
+	}
 	b, _ := json.Marshal(c)
 	return string(b)
 }
@@ -175,8 +224,12 @@ func ReadFromFile() Processor {
 		}
 
 		var fileConf struct {
-			Bitcoin *BitcoinConf
-			Liquid  *LiquidConf
+			Bitcoin  *BitcoinConf
+			Liquid   *LiquidConf
+			AutoSwap *AutoSwapConf
+			Metrics  *MetricsConf
+			Database *DatabaseConf
+			Webhook  *WebhookConf
 		}
 
 		err = toml.Unmarshal(data, &fileConf)
@@ -202,6 +255,11 @@ func ReadFromFile() Processor {
 			c.Liquid.RpcWallet = fileConf.Liquid.RpcWallet
 			c.Liquid.LiquidSwaps = fileConf.Liquid.LiquidSwaps
 		}
+
+		c.AutoSwap = fileConf.AutoSwap
+		c.Metrics = fileConf.Metrics
+		c.Database = fileConf.Database
+		c.Webhook = fileConf.Webhook
 		lc, err := LWKConfigFromToml(filepath.Join(c.PeerswapDir, defaultConfigFileName))
 		if err != nil {
 			return nil, err
@@ -283,6 +341,10 @@ func PeerSwapFallback() Processor {
 			c.Liquid.RpcWallet = defaultLiquidWalletName
 		}
 
+		if c.AutoSwap != nil && c.AutoSwap.RulesFile == "" {
+			c.AutoSwap.RulesFile = filepath.Join(c.PeerswapDir, defaultAutoSwapRules)
+		}
+
 		return c, nil
 	}
 }
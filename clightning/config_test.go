@@ -84,3 +84,60 @@ func Test_ReadFromFile_EmptyFile(t *testing.T) {
 
 	assert.EqualValues(t, expected, actual)
 }
+
+func Test_ReadFromFile_AutoSwap(t *testing.T) {
+	conf := `
+	[AutoSwap]
+	enabled=true
+	dryrun=true
+	interval="5m"
+	`
+
+	dir := t.TempDir()
+	fp := filepath.Join(dir, "peerswap.conf")
+	_ = ioutil.WriteFile(fp, []byte(conf), fs.ModePerm)
+
+	c := &Config{PeerswapDir: dir, Bitcoin: &BitcoinConf{}, Liquid: &LiquidConf{}}
+	c, err := ReadFromFile()(c)
+	if err != nil {
+		t.Fatalf("ERROR: %v", err)
+	}
+	c, err = PeerSwapFallback()(c)
+	if err != nil {
+		t.Fatalf("ERROR: %v", err)
+	}
+
+	expected := &AutoSwapConf{
+		Enabled:   true,
+		DryRun:    true,
+		Interval:  "5m",
+		RulesFile: filepath.Join(dir, "autoswap.json"),
+	}
+
+	assert.EqualValues(t, expected, c.AutoSwap)
+}
+
+func Test_ReadFromFile_Webhook(t *testing.T) {
+	conf := `
+	[Webhook]
+	urls=["http://localhost:8080/hook", "https://example.com/hook"]
+	secret="topsecret"
+	`
+
+	dir := t.TempDir()
+	fp := filepath.Join(dir, "peerswap.conf")
+	_ = ioutil.WriteFile(fp, []byte(conf), fs.ModePerm)
+
+	c := &Config{PeerswapDir: dir, Bitcoin: &BitcoinConf{}, Liquid: &LiquidConf{}}
+	c, err := ReadFromFile()(c)
+	if err != nil {
+		t.Fatalf("ERROR: %v", err)
+	}
+
+	expected := &WebhookConf{
+		Urls:   []string{"http://localhost:8080/hook", "https://example.com/hook"},
+		Secret: "topsecret",
+	}
+	assert.EqualValues(t, expected, c.Webhook)
+	assert.NotContains(t, c.String(), "topsecret")
+}
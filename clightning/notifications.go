@@ -0,0 +1,153 @@
+package clightning
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/elementsproject/peerswap/log"
+	"github.com/elementsproject/peerswap/swap"
+)
+
+// swapEventNotification is the custom notification that is sent on every
+// state change of a swap. Plugins subscribe to it with
+// `"subscriptions": ["peerswap_swap_event"]`.
+const swapEventNotification = "peerswap_swap_event"
+
+// notificationWriter sits between the plugin and stdout. Core lightning only
+// forwards custom notifications that a plugin declares in its manifest, which
+// glightning does not support, so the writer adds them to the getmanifest
+// response. It also serializes our notifications with the messages of the
+// plugin.
+type notificationWriter struct {
+	sync.Mutex
+	out           io.Writer
+	notifications []string
+}
+
+func newNotificationWriter(out io.Writer, notifications ...string) *notificationWriter {
+	return &notificationWriter{
+		out:           out,
+		notifications: notifications,
+	}
+}
+
+// forward copies the messages of the plugin to the output until in is
+// closed. Messages are single lines of json followed by an empty line.
+func (w *notificationWriter) forward(in io.Reader) error {
+	r := bufio.NewReader(in)
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 1 {
+			if werr := w.write(w.addNotificationsToManifest(line[:len(line)-1])); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Notify sends a custom notification with the params as payload.
+func (w *notificationWriter) Notify(method string, params interface{}) error {
+	msg, err := json.Marshal(struct {
+		JsonRpc string      `json:"jsonrpc"`
+		Method  string      `json:"method"`
+		Params  interface{} `json:"params"`
+	}{
+		JsonRpc: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+	return w.write(msg)
+}
+
+func (w *notificationWriter) write(msg []byte) error {
+	w.Lock()
+	defer w.Unlock()
+	_, err := w.out.Write(append(msg, '\n', '\n'))
+	return err
+}
+
+// addNotificationsToManifest adds the notifications to the message if it is
+// the response to getmanifest. All other messages are returned unchanged.
+func (w *notificationWriter) addNotificationsToManifest(msg []byte) []byte {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &response); err != nil {
+		return msg
+	}
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(response["result"], &result); err != nil {
+		return msg
+	}
+	if _, ok := result["rpcmethods"]; !ok {
+		return msg
+	}
+
+	type notification struct {
+		Method string `json:"method"`
+	}
+	var notifications []notification
+	for _, n := range w.notifications {
+		notifications = append(notifications, notification{Method: n})
+	}
+	var err error
+	if result["notifications"], err = json.Marshal(notifications); err != nil {
+		return msg
+	}
+	if response["result"], err = json.Marshal(result); err != nil {
+		return msg
+	}
+	patched, err := json.Marshal(response)
+	if err != nil {
+		return msg
+	}
+	return patched
+}
+
+// notifySwapEvents sends a notification for every swap event until the
+// context is done. The payload is wrapped in an object named after the
+// notification, like the notifications of core lightning.
+func (cl *ClightningClient) notifySwapEvents() {
+	_, events, cancel := cl.swaps.SubscribeSwapEvents(0)
+	defer func() { cancel() }()
+
+	var replay []*swap.SwapEvent
+	var last int64
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				// We fell behind, resubscribe and replay the missed events.
+				log.Infof("[SwapEvents] notifications fell behind, resubscribing")
+				replay, events, cancel = cl.swaps.SubscribeSwapEvents(last)
+				for _, e := range replay {
+					cl.notifySwapEvent(e)
+					last = e.Timestamp
+				}
+				continue
+			}
+			cl.notifySwapEvent(event)
+			last = event.Timestamp
+		case <-cl.ctx.Done():
+			return
+		}
+	}
+}
+
+func (cl *ClightningClient) notifySwapEvent(event *swap.SwapEvent) {
+	err := cl.notifier.Notify(swapEventNotification, map[string]*swap.SwapEvent{
+		swapEventNotification: event,
+	})
+	if err != nil {
+		log.Infof("[SwapEvents] could not send notification: %v", err)
+	}
+}
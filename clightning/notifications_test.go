@@ -0,0 +1,41 @@
+package clightning
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := newNotificationWriter(&out, swapEventNotification)
+
+	in := `{"jsonrpc":"2.0","id":1,"result":{"options":[],"rpcmethods":[],"dynamic":true}}` + "\n\n" +
+		`{"jsonrpc":"2.0","id":2,"result":{"swap_id":"id"}}` + "\n\n"
+	require.NoError(t, w.forward(strings.NewReader(in)))
+	require.NoError(t, w.Notify(swapEventNotification, map[string]string{"swap_id": "id"}))
+
+	msgs := strings.Split(strings.TrimSuffix(out.String(), "\n\n"), "\n\n")
+	require.Len(t, msgs, 3)
+
+	var manifest struct {
+		Result struct {
+			Dynamic       bool `json:"dynamic"`
+			Notifications []struct {
+				Method string `json:"method"`
+			} `json:"notifications"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(msgs[0]), &manifest))
+	assert.True(t, manifest.Result.Dynamic)
+	require.Len(t, manifest.Result.Notifications, 1)
+	assert.Equal(t, swapEventNotification, manifest.Result.Notifications[0].Method)
+
+	// Other responses are not changed.
+	assert.Equal(t, `{"jsonrpc":"2.0","id":2,"result":{"swap_id":"id"}}`, msgs[1])
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"peerswap_swap_event","params":{"swap_id":"id"}}`, msgs[2])
+}
@@ -0,0 +1,38 @@
+// peerswap-convertpolicy converts a policy file of the legacy ini format into
+// the structured json format. The converted file is validated before it is
+// written. Peerswap picks up the new file when it is written to the policy
+// file path, otherwise point the policy file option to the new file.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/elementsproject/peerswap/policy"
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	In  string `long:"in" description:"path to the policy file to convert" required:"true"`
+	Out string `long:"out" description:"path to write the structured policy file to, may be the same as in" required:"true"`
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		return err
+	}
+
+	if err := policy.ConvertFile(opts.In, opts.Out); err != nil {
+		return err
+	}
+	fmt.Printf("converted %s to %s\n", opts.In, opts.Out)
+	return nil
+}
@@ -0,0 +1,65 @@
+// peerswap-migratedb copies the swaps, requested swaps, polls and the version
+// from the bbolt database of peerswap into a SQLite or Postgres database.
+// Peerswap must be stopped while the database is migrated.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/elementsproject/peerswap/sqlstore"
+	"github.com/jessevdk/go-flags"
+	"go.etcd.io/bbolt"
+)
+
+type options struct {
+	BoltPath string `long:"bolt" description:"path to the bbolt swaps database" required:"true"`
+	Backend  string `long:"backend" description:"sql backend to migrate to: sqlite or postgres" required:"true"`
+	Dsn      string `long:"dsn" description:"path to the sqlite database or postgres connection string" required:"true"`
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		return err
+	}
+
+	backend, err := sqlstore.ParseBackend(opts.Backend)
+	if err != nil {
+		return err
+	}
+	if backend == sqlstore.BackendBolt {
+		return fmt.Errorf("backend must be sqlite or postgres")
+	}
+	if _, err := os.Stat(opts.BoltPath); err != nil {
+		return err
+	}
+
+	// The timeout fails the migration if peerswap still holds the database.
+	boltDb, err := bbolt.Open(opts.BoltPath, 0700, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return fmt.Errorf("could not open %s, is peerswap still running? %w", opts.BoltPath, err)
+	}
+	defer boltDb.Close()
+
+	db, err := sqlstore.Open(backend, opts.Dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	report, err := sqlstore.MigrateFromBolt(boltDb, db)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("migrated %s\n", report)
+	return nil
+}
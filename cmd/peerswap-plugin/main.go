@@ -9,11 +9,14 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/elementsproject/peerswap/autoswap"
 	"github.com/elementsproject/peerswap/elements"
 	"github.com/elementsproject/peerswap/isdev"
 	"github.com/elementsproject/peerswap/log"
 	"github.com/elementsproject/peerswap/lwk"
+	"github.com/elementsproject/peerswap/metrics"
 	"github.com/elementsproject/peerswap/version"
+	"github.com/elementsproject/peerswap/webhook"
 	"golang.org/x/sys/unix"
 
 	"github.com/vulpemventures/go-elements/network"
@@ -28,10 +31,10 @@ import (
 	"github.com/elementsproject/peerswap/onchain"
 	"github.com/elementsproject/peerswap/policy"
 	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/sqlstore"
 	"github.com/elementsproject/peerswap/swap"
 	"github.com/elementsproject/peerswap/txwatcher"
 	"github.com/elementsproject/peerswap/wallet"
-	"go.etcd.io/bbolt"
 )
 
 var supportedAssets = []string{}
@@ -116,7 +119,7 @@ func outer() error {
 
 func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) error {
 	log.Infof("PeerSwap starting up with commit %s", GitCommit)
-	log.Infof("DB version: %s, Protocol version: %d", version.GetCurrentVersion(), swap.PEERSWAP_PROTOCOL_VERSION)
+	log.Infof("DB version: %s, Protocol versions: %d to %d", version.GetCurrentVersion(), swap.PEERSWAP_MIN_PROTOCOL_VERSION, swap.PEERSWAP_MAX_PROTOCOL_VERSION)
 	if isdev.IsDev() {
 		log.Infof("Dev-mode enabled.")
 	}
@@ -302,10 +305,23 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 	}
 
 	// db
-	swapDb, err := bbolt.Open(filepath.Join(config.DbPath), 0700, nil)
+	var dbBackend sqlstore.Backend
+	var dbDsn string
+	if config.Database != nil {
+		dbBackend, err = sqlstore.ParseBackend(config.Database.Backend)
+		if err != nil {
+			return err
+		}
+		dbDsn = config.Database.Dsn
+	} else {
+		dbBackend = sqlstore.BackendBolt
+	}
+	stores, err := sqlstore.OpenStores(dbBackend, dbDsn, config.DbPath)
 	if err != nil {
 		return err
 	}
+	defer stores.Close()
+	log.Infof("using %s database", dbBackend)
 
 	// policy
 	pol, err := policy.CreateFromFile(config.PolicyPath)
@@ -315,15 +331,8 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 	log.Infof("using policy:\n%s", pol)
 
 	// Swap store.
-	swapStore, err := swap.NewBboltStore(swapDb)
-	if err != nil {
-		return err
-	}
-
-	requestedSwapStore, err := swap.NewRequestedSwapsStore(swapDb)
-	if err != nil {
-		return err
-	}
+	swapStore := stores.Swaps
+	requestedSwapStore := stores.RequestedSwaps
 
 	// Manager for send message retry.
 	mesmgr := messages.NewManager()
@@ -345,6 +354,25 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 	)
 	swapService := swap.NewSwapService(swapServices)
 
+	// webhooks
+	if config.Webhook != nil && len(config.Webhook.Urls) > 0 {
+		secret, err := webhook.LoadSecret(config.Webhook.Secret, filepath.Join(config.PeerswapDir, "webhook.secret"))
+		if err != nil {
+			return err
+		}
+		webhookStore, err := webhook.NewBoltStore(filepath.Join(config.PeerswapDir, "webhooks.db"))
+		if err != nil {
+			return err
+		}
+		defer webhookStore.Close()
+		sink, err := webhook.NewSink(webhookStore, config.Webhook.Urls, secret)
+		if err != nil {
+			return err
+		}
+		sink.Start(ctx, swapService)
+		log.Infof("sending swap events to %d webhooks", len(config.Webhook.Urls))
+	}
+
 	if liquidTxWatcher != nil && liquidEnabled {
 		err := liquidTxWatcher.StartWatchingTxs()
 		if err != nil {
@@ -366,13 +394,22 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 		return err
 	}
 
-	pollStore, err := poll.NewStore(swapDb)
-	if err != nil {
-		return err
-	}
-	pollService := poll.NewService(1*time.Hour, 2*time.Hour, pollStore, lightningPlugin, pol, lightningPlugin, supportedAssets)
+	pollService := poll.NewService(1*time.Hour, 2*time.Hour, stores.Polls, lightningPlugin, pol, lightningPlugin, supportedAssets)
 	pollService.Start()
 	defer pollService.Stop()
+	swapService.SetPeerTermsGetter(pollService)
+
+	// Reload the policy on changes of the policy file and poll the peers
+	// with the new policy.
+	stopPolicyWatch := pol.WatchFile(policy.DefaultWatchInterval, func(err error) {
+		if err != nil {
+			log.Infof("policy file changed but was not reloaded: %v", err)
+			return
+		}
+		log.Infof("reloaded policy file:\n%s", pol)
+		pollService.PollAllPeers()
+	})
+	defer stopPolicyWatch()
 
 	sp := swap.NewRequestedSwapsPrinter(requestedSwapStore)
 	lightningPlugin.SetupClients(liquidRpcWallet, swapService, pol, sp, bitcoinCli, bitcoinOnChainService, pollService)
@@ -383,10 +420,7 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 	lightningPlugin.SetReady()
 
 	// Try to upgrade version if needed
-	versionService, err := version.NewVersionService(swapDb)
-	if err != nil {
-		return err
-	}
+	versionService := version.NewVersionServiceWithStore(stores.Version)
 	err = versionService.SafeUpgrade(swapService)
 	if err != nil {
 		return err
@@ -397,6 +431,73 @@ func run(ctx context.Context, lightningPlugin *clightning.ClightningClient) erro
 	if err != nil {
 		return err
 	}
+	go swapService.AutoBumpFees(ctx, swap.DefaultFeeBumpInterval)
+	if config.Database != nil && config.Database.ArchiveAfterDays > 0 {
+		go swapService.AutoArchiveSwaps(ctx, swap.DefaultArchiveInterval, time.Duration(config.Database.ArchiveAfterDays)*24*time.Hour)
+	}
+
+	// autoswap
+	if config.AutoSwap != nil && config.AutoSwap.Enabled {
+		var interval time.Duration
+		if config.AutoSwap.Interval != "" {
+			interval, err = time.ParseDuration(config.AutoSwap.Interval)
+			if err != nil {
+				return fmt.Errorf("invalid autoswap interval: %w", err)
+			}
+		}
+		rules, err := autoswap.ReadRulesFromFile(config.AutoSwap.RulesFile)
+		if err != nil {
+			return err
+		}
+		auditLog, err := autoswap.NewFileAuditLog(filepath.Join(config.PeerswapDir, "autoswap_audit.log"))
+		if err != nil {
+			return err
+		}
+		defer auditLog.Close()
+
+		autoSwapManager := autoswap.NewManager(
+			&autoswap.Config{
+				Interval: interval,
+				DryRun:   config.AutoSwap.DryRun,
+				Rules:    rules,
+			},
+			lightningPlugin.GetNodeId(),
+			lightningPlugin,
+			swapService,
+			pollService,
+			auditLog,
+		)
+		autoSwapManager.Start()
+		defer autoSwapManager.Stop()
+	}
+
+	// metrics
+	if config.Metrics != nil && config.Metrics.Listen != "" {
+		m := metrics.NewMetrics()
+		err = m.AddPollService(pollService)
+		if err != nil {
+			return err
+		}
+		if bitcoinTxWatcher != nil {
+			err = m.AddTxWatcher("btc", bitcoinTxWatcher)
+			if err != nil {
+				return err
+			}
+		}
+		if w, ok := liquidTxWatcher.(metrics.TxWatcher); ok && liquidEnabled {
+			err = m.AddTxWatcher("lbtc", w)
+			if err != nil {
+				return err
+			}
+		}
+		go m.Run(ctx, swapService)
+		go func() {
+			err := m.ListenAndServe(ctx, config.Metrics.Listen)
+			if err != nil {
+				log.Infof("could not serve metrics: %v", err)
+			}
+		}()
+	}
 
 	log.Infof("peerswap initialized")
 
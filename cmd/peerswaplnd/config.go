@@ -7,8 +7,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/elementsproject/peerswap/autoswap"
 	"github.com/elementsproject/peerswap/lwk"
 	"github.com/jessevdk/go-flags"
 )
@@ -33,21 +35,30 @@ var (
 	DefaultBitcoinEnabled = true
 	DefaultLogLevel       = LOGLEVEL_DEBUG
 	DefaultPolicyFile     = filepath.Join(DefaultDatadir, "policy.conf")
+	DefaultAutoSwapRules  = filepath.Join(DefaultDatadir, "autoswap.json")
 
 	defaultLndDir = btcutil.AppDataDir("lnd", false)
 )
 
 type PeerSwapConfig struct {
-	Host       string   `long:"host" description:"host to listen on for grpc connections"`
-	RestHost   string   `long:"resthost" description:"host to listen for rest connection"`
-	ConfigFile string   `long:"configfile" description:"path to configfile"`
-	PolicyFile string   `long:"policyfile" description:"path to policyfile"`
-	DataDir    string   `long:"datadir" description:"peerswap datadir"`
-	LogLevel   LogLevel `long:"loglevel" description:"loglevel (1=Info, 2=Debug)"`
+	Host        string   `long:"host" description:"host to listen on for grpc connections"`
+	RestHost    string   `long:"resthost" description:"host to listen for rest connection"`
+	MetricsHost string   `long:"metricshost" description:"host to serve prometheus metrics on, disabled if empty"`
+	ConfigFile  string   `long:"configfile" description:"path to configfile"`
+	PolicyFile  string   `long:"policyfile" description:"path to policyfile"`
+	DataDir     string   `long:"datadir" description:"peerswap datadir"`
+	LogLevel    LogLevel `long:"loglevel" description:"loglevel (1=Info, 2=Debug)"`
+
+	TLSCertPath   string   `long:"tlscertpath" description:"path to the tls certificate of the grpc and rest interface, generated if missing (default: datadir/tls.cert)"`
+	TLSKeyPath    string   `long:"tlskeypath" description:"path to the tls key of the grpc and rest interface, generated if missing (default: datadir/tls.key)"`
+	TLSExtraHosts []string `long:"tlsextrahost" description:"domain or ip that the generated tls certificate is valid for besides localhost, can be given multiple times"`
 
 	LndConfig      *LndConfig     `group:"Lnd Grpc config" namespace:"lnd"`
 	ElementsConfig *OnchainConfig `group:"Elements Rpc Config" namespace:"elementsd"`
 	LWKConfig      *lwk.Conf
+	AutoSwapConfig *AutoSwapConfig `group:"Autoswap config" namespace:"autoswap"`
+	DbConfig       *DbConfig       `group:"Database config" namespace:"db"`
+	WebhookConfig  *WebhookConfig  `group:"Webhook config" namespace:"webhook"`
 
 	LiquidEnabled  bool `long:"liquidswaps" description:"enable bitcoin peerswaps"`
 	BitcoinEnabled bool `long:"bitcoinswaps" description:"enable bitcoin peerswaps"`
@@ -67,15 +78,41 @@ func (p *PeerSwapConfig) String() string {
 		lwkConf = fmt.Sprintf("lwk: signername: %s, walletname: %s, lwkendpoint: %s, electrumendpoint: %s, network: %s, liquidswaps: %v", p.LWKConfig.GetSignerName(), p.LWKConfig.GetWalletName(), p.LWKConfig.GetLWKEndpoint(), p.LWKConfig.GetElectrumEndpoint(), p.LWKConfig.GetNetwork(), p.LWKConfig.GetLiquidSwaps())
 	}
 
+	var autoSwapString string
+	if p.AutoSwapConfig != nil {
+		autoSwapString = fmt.Sprintf("enabled: %v, dryrun: %v, interval: %s, rulesfile: %s", p.AutoSwapConfig.Enabled, p.AutoSwapConfig.DryRun, p.AutoSwapConfig.Interval, p.AutoSwapConfig.RulesFile)
+	}
+
+	// The dsn is not printed as it can hold the postgres password.
+	var dbString string
+	if p.DbConfig != nil {
+		dbString = fmt.Sprintf("%s, archive after days: %d", p.DbConfig.Backend, p.DbConfig.ArchiveAfterDays)
+	}
+
+	// The webhook secret is not printed.
+	var webhookString string
+	if p.WebhookConfig != nil {
+		webhookString = strings.Join(p.WebhookConfig.Urls, ",")
+	}
+
 	if p.DataDir != DefaultDatadir && p.PolicyFile == DefaultPolicyFile {
 		p.PolicyFile = filepath.Join(p.DataDir, "policy.conf")
 	}
+	if p.DataDir != DefaultDatadir && p.AutoSwapConfig != nil && p.AutoSwapConfig.RulesFile == DefaultAutoSwapRules {
+		p.AutoSwapConfig.RulesFile = filepath.Join(p.DataDir, "autoswap.json")
+	}
 
-	return fmt.Sprintf("Host %s, ConfigFile %s, Datadir %s, Bitcoin enabled: %v, Lnd Config: %s, elements: %s, lwk config: %s",
-		p.Host, p.ConfigFile, p.DataDir, p.BitcoinEnabled, lndString, liquidString, lwkConf)
+	return fmt.Sprintf("Host %s, ConfigFile %s, Datadir %s, TLS cert %s, Bitcoin enabled: %v, Lnd Config: %s, elements: %s, lwk config: %s, autoswap: %s, db backend: %s, webhooks: %s",
+		p.Host, p.ConfigFile, p.DataDir, p.TLSCertPath, p.BitcoinEnabled, lndString, liquidString, lwkConf, autoSwapString, dbString, webhookString)
 }
 
 func (p *PeerSwapConfig) Validate() error {
+	if p.TLSCertPath == "" {
+		p.TLSCertPath = filepath.Join(p.DataDir, "tls.cert")
+	}
+	if p.TLSKeyPath == "" {
+		p.TLSKeyPath = filepath.Join(p.DataDir, "tls.key")
+	}
 	if p.ElementsConfig.RpcHost != "" && p.ElementsConfig.LiquidSwaps != false {
 		err := p.ElementsConfig.Validate()
 		if err != nil {
@@ -134,6 +171,25 @@ func (o *OnchainConfig) Validate() error {
 	return nil
 }
 
+type AutoSwapConfig struct {
+	Enabled   bool          `long:"enabled" description:"enable the automatic liquidity manager"`
+	DryRun    bool          `long:"dryrun" description:"only record the decisions of the liquidity manager without starting swaps"`
+	Interval  time.Duration `long:"interval" description:"time between two runs of the liquidity manager"`
+	RulesFile string        `long:"rulesfile" description:"path to the autoswap rules file"`
+}
+
+type DbConfig struct {
+	Backend string `long:"backend" description:"database backend: bolt, sqlite or postgres"`
+	Dsn     string `long:"dsn" description:"path to the sqlite database or postgres connection string, sqlite defaults to swaps.sqlite in the datadir"`
+
+	ArchiveAfterDays uint32 `long:"archiveafterdays" description:"archive finished swaps after this number of days, disabled if 0"`
+}
+
+type WebhookConfig struct {
+	Urls   []string `long:"url" description:"url that swap events are posted to, can be given multiple times"`
+	Secret string   `long:"secret" description:"secret that the webhook payloads are signed with (default: generated in datadir/webhook.secret)"`
+}
+
 type LndConfig struct {
 	LndHost      string `long:"host" description:"host:port for lnd connection"`
 	TlsCertPath  string `long:"tlscertpath" description:"path to the lnd TLS cert."`
@@ -155,6 +211,12 @@ func DefaultConfig() *PeerSwapConfig {
 		BitcoinEnabled: DefaultBitcoinEnabled,
 		ElementsConfig: defaultLiquidConfig(),
 		LogLevel:       DefaultLogLevel,
+		AutoSwapConfig: &AutoSwapConfig{
+			Interval:  autoswap.DefaultInterval,
+			RulesFile: DefaultAutoSwapRules,
+		},
+		DbConfig:      &DbConfig{},
+		WebhookConfig: &WebhookConfig{},
 	}
 }
 
@@ -16,13 +16,16 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/elementsproject/peerswap/autoswap"
 	"github.com/elementsproject/peerswap/elements"
 	"github.com/elementsproject/peerswap/isdev"
 	"github.com/elementsproject/peerswap/lnd"
 	"github.com/elementsproject/peerswap/log"
 	"github.com/elementsproject/peerswap/lwk"
+	"github.com/elementsproject/peerswap/metrics"
 
 	"github.com/elementsproject/peerswap/version"
+	"github.com/elementsproject/peerswap/webhook"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -36,6 +39,7 @@ import (
 	"github.com/elementsproject/peerswap/peerswaprpc"
 	"github.com/elementsproject/peerswap/policy"
 	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/sqlstore"
 	"github.com/elementsproject/peerswap/swap"
 	"github.com/elementsproject/peerswap/txwatcher"
 	"github.com/elementsproject/peerswap/wallet"
@@ -43,9 +47,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/vulpemventures/go-elements/network"
-	"go.etcd.io/bbolt"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -106,7 +109,7 @@ func run() error {
 		return err
 	}
 	log.Infof("PeerSwap LND starting up with commit %s and cfg: %s", GitCommit, cfg)
-	log.Infof("DB version: %s, Protocol version: %d", version.GetCurrentVersion(), swap.PEERSWAP_PROTOCOL_VERSION)
+	log.Infof("DB version: %s, Protocol versions: %d to %d", version.GetCurrentVersion(), swap.PEERSWAP_MIN_PROTOCOL_VERSION, swap.PEERSWAP_MAX_PROTOCOL_VERSION)
 	if isdev.IsDev() {
 		log.Infof("Dev-mode enabled.")
 	}
@@ -291,10 +294,16 @@ func run() error {
 	}
 
 	// db
-	swapDb, err := bbolt.Open(filepath.Join(cfg.DataDir, "swaps"), 0700, nil)
+	dbBackend, err := sqlstore.ParseBackend(cfg.DbConfig.Backend)
 	if err != nil {
 		return err
 	}
+	stores, err := sqlstore.OpenStores(dbBackend, cfg.DbConfig.Dsn, filepath.Join(cfg.DataDir, "swaps"))
+	if err != nil {
+		return err
+	}
+	defer stores.Close()
+	log.Infof("using %s database", dbBackend)
 
 	// policy
 	pol, err := policy.CreateFromFile(cfg.PolicyFile)
@@ -304,14 +313,8 @@ func run() error {
 
 	// setup swap services
 	log.Infof("using policy:\n%s", pol)
-	swapStore, err := swap.NewBboltStore(swapDb)
-	if err != nil {
-		return err
-	}
-	requestedSwapStore, err := swap.NewRequestedSwapsStore(swapDb)
-	if err != nil {
-		return err
-	}
+	swapStore := stores.Swaps
+	requestedSwapStore := stores.RequestedSwaps
 
 	// Manager for send message retry.
 	mesmgr := messages.NewManager()
@@ -333,6 +336,25 @@ func run() error {
 	)
 	swapService := swap.NewSwapService(swapServices)
 
+	// webhooks
+	if len(cfg.WebhookConfig.Urls) > 0 {
+		secret, err := webhook.LoadSecret(cfg.WebhookConfig.Secret, filepath.Join(cfg.DataDir, "webhook.secret"))
+		if err != nil {
+			return err
+		}
+		webhookStore, err := webhook.NewBoltStore(filepath.Join(cfg.DataDir, "webhooks.db"))
+		if err != nil {
+			return err
+		}
+		defer webhookStore.Close()
+		sink, err := webhook.NewSink(webhookStore, cfg.WebhookConfig.Urls, secret)
+		if err != nil {
+			return err
+		}
+		sink.Start(ctx, swapService)
+		log.Infof("sending swap events to %d webhooks", len(cfg.WebhookConfig.Urls))
+	}
+
 	if liquidTxWatcher != nil {
 		err := liquidTxWatcher.StartWatchingTxs()
 		if err != nil {
@@ -347,10 +369,7 @@ func run() error {
 	}
 
 	// Try to upgrade version if needed
-	versionService, err := version.NewVersionService(swapDb)
-	if err != nil {
-		return err
-	}
+	versionService := version.NewVersionServiceWithStore(stores.Version)
 	err = versionService.SafeUpgrade(swapService)
 	if err != nil {
 		return err
@@ -360,14 +379,27 @@ func run() error {
 	if err != nil {
 		return err
 	}
-
-	pollStore, err := poll.NewStore(swapDb)
-	if err != nil {
-		return err
+	go swapService.AutoBumpFees(ctx, swap.DefaultFeeBumpInterval)
+	if cfg.DbConfig.ArchiveAfterDays > 0 {
+		go swapService.AutoArchiveSwaps(ctx, swap.DefaultArchiveInterval, time.Duration(cfg.DbConfig.ArchiveAfterDays)*24*time.Hour)
 	}
-	pollService := poll.NewService(1*time.Hour, 2*time.Hour, pollStore, lnd, pol, lnd, supportedAssets)
+
+	pollService := poll.NewService(1*time.Hour, 2*time.Hour, stores.Polls, lnd, pol, lnd, supportedAssets)
 	pollService.Start()
 	defer pollService.Stop()
+	swapService.SetPeerTermsGetter(pollService)
+
+	// Reload the policy on changes of the policy file and poll the peers
+	// with the new policy.
+	stopPolicyWatch := pol.WatchFile(policy.DefaultWatchInterval, func(err error) {
+		if err != nil {
+			log.Infof("policy file changed but was not reloaded: %v", err)
+			return
+		}
+		log.Infof("reloaded policy file:\n%s", pol)
+		pollService.PollAllPeers()
+	})
+	defer stopPolicyWatch()
 
 	// Add poll handler to peer event listener.
 	err = peerListener.AddHandler(lnrpc.PeerEvent_PEER_ONLINE, pollService.Poll)
@@ -378,6 +410,66 @@ func run() error {
 	// Start internal lnd listener.
 	lnd.StartListening()
 
+	// autoswap
+	if cfg.AutoSwapConfig.Enabled {
+		rules, err := autoswap.ReadRulesFromFile(cfg.AutoSwapConfig.RulesFile)
+		if err != nil {
+			return err
+		}
+		auditLog, err := autoswap.NewFileAuditLog(filepath.Join(cfg.DataDir, "autoswap_audit.log"))
+		if err != nil {
+			return err
+		}
+		defer auditLog.Close()
+
+		autoSwapManager := autoswap.NewManager(
+			&autoswap.Config{
+				Interval: cfg.AutoSwapConfig.Interval,
+				DryRun:   cfg.AutoSwapConfig.DryRun,
+				Rules:    rules,
+			},
+			info.IdentityPubkey,
+			lnd,
+			swapService,
+			pollService,
+			auditLog,
+		)
+		autoSwapManager.Start()
+		defer autoSwapManager.Stop()
+	}
+
+	// metrics
+	if cfg.MetricsHost != "" {
+		m := metrics.NewMetrics()
+		err = m.AddPollService(pollService)
+		if err != nil {
+			return err
+		}
+		if lndTxWatcher != nil {
+			err = lndTxWatcher.WatchBlocks()
+			if err != nil {
+				return err
+			}
+			err = m.AddTxWatcher("btc", lndTxWatcher)
+			if err != nil {
+				return err
+			}
+		}
+		if w, ok := liquidTxWatcher.(metrics.TxWatcher); ok {
+			err = m.AddTxWatcher("lbtc", w)
+			if err != nil {
+				return err
+			}
+		}
+		go m.Run(ctx, swapService)
+		go func() {
+			err := m.ListenAndServe(ctx, cfg.MetricsHost)
+			if err != nil {
+				core_log.Fatal(err)
+			}
+		}()
+	}
+
 	// setup grpc server
 	sp := swap.NewRequestedSwapsPrinter(requestedSwapStore)
 	peerswaprpcServer := peerswaprpc.NewPeerswapServer(
@@ -397,7 +489,21 @@ func run() error {
 	}
 	defer lis.Close()
 
-	grpcSrv := grpc.NewServer()
+	// The rpc interface is served over tls and every call needs a token
+	// that grants the scope of the method.
+	tlsCert, err := peerswaprpc.LoadTLSCertificate(cfg.TLSCertPath, cfg.TLSKeyPath, cfg.TLSExtraHosts)
+	if err != nil {
+		return err
+	}
+	authenticator, err := peerswaprpc.NewAuthenticator(cfg.DataDir)
+	if err != nil {
+		return err
+	}
+	grpcSrv := grpc.NewServer(
+		grpc.Creds(credentials.NewServerTLSFromCert(&tlsCert)),
+		grpc.UnaryInterceptor(authenticator.UnaryServerInterceptor),
+		grpc.StreamInterceptor(authenticator.StreamServerInterceptor),
+	)
 
 	peerswaprpc.RegisterPeerSwapServer(grpcSrv, peerswaprpcServer)
 
@@ -421,13 +527,20 @@ func run() error {
 				},
 			}),
 		)
-		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-		err := peerswaprpc.RegisterPeerSwapHandlerFromEndpoint(ctx, mux, cfg.Host, opts)
+		// The gateway forwards the authorization header of a request, so
+		// the grpc server checks the token of rest calls too. The
+		// certificate is always valid for localhost.
+		creds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "localhost")
+		if err != nil {
+			return err
+		}
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+		err = peerswaprpc.RegisterPeerSwapHandlerFromEndpoint(ctx, mux, cfg.Host, opts)
 		if err != nil {
 			return err
 		}
 		go func() {
-			err := http.ListenAndServe(cfg.RestHost, mux)
+			err := http.ListenAndServeTLS(cfg.RestHost, cfg.TLSCertPath, cfg.TLSKeyPath, mux)
 			if err != nil {
 				core_log.Fatal(err)
 			}
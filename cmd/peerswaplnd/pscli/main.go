@@ -3,12 +3,20 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	log2 "log"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/elementsproject/peerswap/cmd/peerswaplnd"
 	"github.com/elementsproject/peerswap/peerswaprpc"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/urfave/cli"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
@@ -25,13 +33,24 @@ func main() {
 			Value: "localhost:42069",
 			Usage: "peerswapd grpc address host:port",
 		},
+		cli.StringFlag{
+			Name:  "tlscertpath",
+			Value: filepath.Join(peerswaplnd.DefaultDatadir, "tls.cert"),
+			Usage: "path to the tls certificate of peerswapd",
+		},
+		cli.StringFlag{
+			Name:  "macaroonpath",
+			Value: peerswaprpc.TokenFile(peerswaplnd.DefaultDatadir, peerswaprpc.ScopeAdmin),
+			Usage: "path to the token that authenticates the calls, e.g. readonly.macaroon to only read",
+		},
 	}
 	app.Commands = []cli.Command{
-		swapOutCommand, swapInCommand, getSwapCommand, listSwapsCommand,
-		listPeersCommand, reloadPolicyFileCommand, listRequestedSwapsCommand,
+		swapOutCommand, batchSwapOutCommand, swapInCommand, quoteSwapCommand, getSwapCommand, bumpSwapFeeCommand, cancelSwapCommand, subscribeSwapEventsCommand, exportSwapsCommand, archiveSwapsCommand, listArchivedSwapsCommand, listSwapsCommand,
+		listPeersCommand, getPeerReputationCommand, getPeerStatsCommand, reloadPolicyFileCommand, listRequestedSwapsCommand,
 		liquidGetBalanceCommand, liquidGetAddressCommand, liquidSendToAddressCommand,
+		btcGetBalanceCommand, btcGetAddressCommand, btcSendToAddressCommand,
 		stopCommand, listActiveSwapsCommand, allowSwapRequestsCommand, addPeerCommand, removePeerCommand,
-		addSusPeerCommand, removeSusPeerCommand,
+		addSusPeerCommand, removeSusPeerCommand, evaluatePolicyCommand,
 	}
 	app.Version = fmt.Sprintf("commit: %s", GitCommit)
 	err := app.Run(os.Args)
@@ -65,6 +84,10 @@ var (
 		Name:     "address",
 		Required: true,
 	}
+	btcAddressFlag = cli.StringFlag{
+		Name:     "address",
+		Required: true,
+	}
 	allowFlag = cli.BoolFlag{
 		Name:     "allow_swaps",
 		Required: true,
@@ -73,6 +96,108 @@ var (
 		Name:     "peer_pubkey",
 		Required: true,
 	}
+	maxPremiumFlag = cli.Uint64Flag{
+		Name:  "max_premium",
+		Usage: "Maximum premium in Sats that the peer may ask for",
+		Value: 0,
+	}
+	batchSwapFlag = cli.StringSliceFlag{
+		Name:     "swap",
+		Usage:    "swap-out of the batch as '<channel_id>:<sat_amt>', can be given multiple times",
+		Required: true,
+	}
+	targetConfFlag = cli.Uint64Flag{
+		Name:  "target_conf",
+		Usage: "Confirmation target in blocks to estimate the fee rate for",
+	}
+	satPerVbyteFlag = cli.Uint64Flag{
+		Name:  "sat_per_vbyte",
+		Usage: "Fee rate in sat/vb, overrides the estimation",
+	}
+	cancelReasonFlag = cli.StringFlag{
+		Name:  "reason",
+		Usage: "Reason of the cancel that is sent to the peer",
+	}
+	replaySinceFlag = cli.Int64Flag{
+		Name:  "replay_since",
+		Usage: "Replays the events after this unix timestamp in nanoseconds",
+	}
+	startTimeFlag = cli.StringFlag{
+		Name:  "start_time",
+		Usage: "Selects the swaps created at or after this date (YYYY-MM-DD or RFC3339)",
+	}
+	endTimeFlag = cli.StringFlag{
+		Name:  "end_time",
+		Usage: "Selects the swaps created before this date (YYYY-MM-DD or RFC3339)",
+	}
+	requestTypeFlag = cli.StringFlag{
+		Name:     "type",
+		Usage:    "type of the swap that the peer requests: 'swap-out' | 'swap-in'",
+		Required: true,
+	}
+	quoteTypeFlag = cli.StringFlag{
+		Name:     "type",
+		Usage:    "type of the swap to quote: 'swap-out' | 'swap-in'",
+		Required: true,
+	}
+	evaluateChannelIdFlag = cli.Uint64Flag{
+		Name:  "channel_id",
+		Usage: "channel id of the request, the channel balance is only checked if it is set",
+	}
+	peerFlag = cli.StringFlag{
+		Name:  "peer",
+		Usage: "Selects the swaps with this peer pubkey",
+	}
+	channelFilterFlag = cli.Uint64Flag{
+		Name:  "channel_id",
+		Usage: "Selects the swaps on this channel",
+	}
+	assetFilterFlag = cli.StringFlag{
+		Name:  "asset",
+		Usage: "Selects the swaps of this asset: 'btc' | 'lbtc'",
+	}
+	typeFlag = cli.StringFlag{
+		Name:  "type",
+		Usage: "Selects the swaps of this type: 'swap-out' | 'swap-in'",
+	}
+	roleFlag = cli.StringFlag{
+		Name:  "role",
+		Usage: "Selects the swaps in which we have this role: 'sender' | 'receiver'",
+	}
+	stateFlag = cli.StringSliceFlag{
+		Name:  "state",
+		Usage: "Selects the swaps in this state, can be given multiple times",
+	}
+	statusFlag = cli.StringFlag{
+		Name:  "status",
+		Usage: "Selects the active or the finished swaps: 'active' | 'finished'",
+	}
+	descendingFlag = cli.BoolFlag{
+		Name:  "descending",
+		Usage: "Lists the newest swaps first",
+	}
+	cursorFlag = cli.StringFlag{
+		Name:  "cursor",
+		Usage: "Lists the page after the next_cursor of the previous page",
+	}
+	limitFlag = cli.UintFlag{
+		Name:  "limit",
+		Usage: "Number of swaps per page, 0 lists all swaps",
+	}
+	formatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "Output format: 'json' | 'csv'",
+		Value: "json",
+	}
+	lastSwapsFlag = cli.UintFlag{
+		Name:  "swaps",
+		Usage: "Number of the last swaps to show, 10 if 0",
+	}
+	olderThanDaysFlag = cli.UintFlag{
+		Name:     "older_than_days",
+		Usage:    "Archives the swaps that finished more than this number of days ago, at least 1",
+		Required: true,
+	}
 
 	swapOutCommand = cli.Command{
 		Name:  "swapout",
@@ -81,10 +206,22 @@ var (
 			satAmountFlag,
 			channelIdFlag,
 			assetFlag,
+			maxPremiumFlag,
 		},
 		Action: swapOut,
 	}
 
+	batchSwapOutCommand = cli.Command{
+		Name:  "batchswapout",
+		Usage: "Perform swap-outs on several channels, swap-outs with the same peer share one opening transaction",
+		Flags: []cli.Flag{
+			batchSwapFlag,
+			assetFlag,
+			maxPremiumFlag,
+		},
+		Action: batchSwapOut,
+	}
+
 	swapInCommand = cli.Command{
 		Name:  "swapin",
 		Usage: "Perform a swap-in (sending onchain funds to receive lightning funds)",
@@ -92,6 +229,7 @@ var (
 			satAmountFlag,
 			channelIdFlag,
 			assetFlag,
+			maxPremiumFlag,
 		},
 		Action: swapIn,
 	}
@@ -105,10 +243,84 @@ var (
 		Action: getSwap,
 	}
 
+	bumpSwapFeeCommand = cli.Command{
+		Name:  "bumpswapfee",
+		Usage: "Raise the fee of the unconfirmed opening or claim transaction of a swap",
+		Flags: []cli.Flag{
+			swapIdFlag,
+			targetConfFlag,
+			satPerVbyteFlag,
+		},
+		Action: bumpSwapFee,
+	}
+	cancelSwapCommand = cli.Command{
+		Name:  "cancelswap",
+		Usage: "Cancel a swap that we started and that waits for the agreement of the peer",
+		Flags: []cli.Flag{
+			swapIdFlag,
+			cancelReasonFlag,
+		},
+		Action: cancelSwap,
+	}
+
+	subscribeSwapEventsCommand = cli.Command{
+		Name:  "subscribeswapevents",
+		Usage: "Prints the state changes of all swaps as they happen",
+		Flags: []cli.Flag{
+			replaySinceFlag,
+		},
+		Action: subscribeSwapEvents,
+	}
+
+	exportSwapsCommand = cli.Command{
+		Name:  "exportswaps",
+		Usage: "Exports the fees and amounts of the finished swaps for accounting",
+		Flags: []cli.Flag{
+			startTimeFlag,
+			endTimeFlag,
+			peerFlag,
+			formatFlag,
+		},
+		Action: exportSwaps,
+	}
+
+	archiveSwapsCommand = cli.Command{
+		Name:  "archiveswaps",
+		Usage: "Moves finished swaps into the archive and drops their key material",
+		Flags: []cli.Flag{
+			olderThanDaysFlag,
+		},
+		Action: archiveSwaps,
+	}
+
+	listArchivedSwapsCommand = cli.Command{
+		Name:  "listarchivedswaps",
+		Usage: "lists the archived swaps",
+		Flags: []cli.Flag{
+			startTimeFlag,
+			endTimeFlag,
+			peerFlag,
+		},
+		Action: listArchivedSwaps,
+	}
+
 	listSwapsCommand = cli.Command{
-		Name:   "listswaps",
-		Usage:  "lists all swaps",
-		Flags:  []cli.Flag{},
+		Name:  "listswaps",
+		Usage: "lists all swaps",
+		Flags: []cli.Flag{
+			peerFlag,
+			channelFilterFlag,
+			assetFilterFlag,
+			typeFlag,
+			roleFlag,
+			stateFlag,
+			statusFlag,
+			startTimeFlag,
+			endTimeFlag,
+			descendingFlag,
+			cursorFlag,
+			limitFlag,
+		},
 		Action: listSwaps,
 	}
 
@@ -118,6 +330,23 @@ var (
 		Flags:  []cli.Flag{},
 		Action: listPeers,
 	}
+	getPeerReputationCommand = cli.Command{
+		Name:  "getpeerreputation",
+		Usage: "shows the reputation score and the swap outcomes of a peer",
+		Flags: []cli.Flag{
+			pubkeyFlag,
+		},
+		Action: getPeerReputation,
+	}
+	getPeerStatsCommand = cli.Command{
+		Name:  "getpeerstats",
+		Usage: "shows the success rate, volumes, fees and last swaps of a peer",
+		Flags: []cli.Flag{
+			pubkeyFlag,
+			lastSwapsFlag,
+		},
+		Action: getPeerStats,
+	}
 	reloadPolicyFileCommand = cli.Command{
 		Name:   "reloadpolicy",
 		Usage:  "reloads the policy file and polls all peers with the new policy",
@@ -151,6 +380,27 @@ var (
 		},
 		Action: liquidSendToAddress,
 	}
+	btcGetAddressCommand = cli.Command{
+		Name:   "btc-getaddress",
+		Usage:  "gets a new btc address of the lnd wallet",
+		Flags:  []cli.Flag{},
+		Action: btcGetAddress,
+	}
+	btcGetBalanceCommand = cli.Command{
+		Name:   "btc-getbalance",
+		Usage:  "gets the confirmed, unconfirmed and by pending swaps reserved btc balance",
+		Flags:  []cli.Flag{},
+		Action: btcGetBalance,
+	}
+	btcSendToAddressCommand = cli.Command{
+		Name:  "btc-sendtoaddress",
+		Usage: "sends the sat amount to a btc address",
+		Flags: []cli.Flag{
+			satAmountFlag,
+			btcAddressFlag,
+		},
+		Action: btcSendToAddress,
+	}
 	listActiveSwapsCommand = cli.Command{
 		Name:   "listactiveswaps",
 		Usage:  "list active swaps",
@@ -196,6 +446,29 @@ var (
 		},
 		Action: removeSusPeer,
 	}
+	evaluatePolicyCommand = cli.Command{
+		Name:  "evaluatepolicy",
+		Usage: "Checks a swap request of a peer against the policy without creating a swap",
+		Flags: []cli.Flag{
+			pubkeyFlag,
+			assetFlag,
+			requestTypeFlag,
+			satAmountFlag,
+			evaluateChannelIdFlag,
+		},
+		Action: evaluatePolicy,
+	}
+	quoteSwapCommand = cli.Command{
+		Name:  "quoteswap",
+		Usage: "Estimates the fees and the premium of a swap without starting it",
+		Flags: []cli.Flag{
+			satAmountFlag,
+			channelIdFlag,
+			assetFlag,
+			quoteTypeFlag,
+		},
+		Action: quoteSwap,
+	}
 	stopCommand = cli.Command{
 		Name:   "stop",
 		Usage:  "stops the peerswap daemon",
@@ -216,6 +489,7 @@ func swapIn(ctx *cli.Context) error {
 		ChannelId:  ctx.Uint64(channelIdFlag.Name),
 		SwapAmount: ctx.Uint64(satAmountFlag.Name),
 		Asset:      ctx.String(assetFlag.Name),
+		MaxPremium: ctx.Uint64(maxPremiumFlag.Name),
 	})
 	if err != nil {
 		return err
@@ -236,6 +510,46 @@ func swapOut(ctx *cli.Context) error {
 		ChannelId:  ctx.Uint64(channelIdFlag.Name),
 		SwapAmount: ctx.Uint64(satAmountFlag.Name),
 		Asset:      ctx.String(assetFlag.Name),
+		MaxPremium: ctx.Uint64(maxPremiumFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func batchSwapOut(ctx *cli.Context) error {
+	var swaps []*peerswaprpc.BatchSwapOutEntry
+	for _, s := range ctx.StringSlice(batchSwapFlag.Name) {
+		parts := strings.Split(s, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid swap %s, expected '<channel_id>:<sat_amt>'", s)
+		}
+		channelId, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid channel_id in swap %s: %v", s, err)
+		}
+		amt, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid sat_amt in swap %s: %v", s, err)
+		}
+		swaps = append(swaps, &peerswaprpc.BatchSwapOutEntry{
+			ChannelId:  channelId,
+			SwapAmount: amt,
+		})
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.BatchSwapOut(context.Background(), &peerswaprpc.BatchSwapOutRequest{
+		Swaps:      swaps,
+		Asset:      ctx.String(assetFlag.Name),
+		MaxPremium: ctx.Uint64(maxPremiumFlag.Name),
 	})
 	if err != nil {
 		return err
@@ -261,6 +575,162 @@ func getSwap(ctx *cli.Context) error {
 	return nil
 }
 
+func bumpSwapFee(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.BumpSwapFee(context.Background(), &peerswaprpc.BumpSwapFeeRequest{
+		SwapId:      ctx.String(swapIdFlag.Name),
+		TargetConf:  uint32(ctx.Uint64(targetConfFlag.Name)),
+		SatPerVbyte: ctx.Uint64(satPerVbyteFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func cancelSwap(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.CancelSwap(context.Background(), &peerswaprpc.CancelSwapRequest{
+		SwapId: ctx.String(swapIdFlag.Name),
+		Reason: ctx.String(cancelReasonFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func subscribeSwapEvents(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeSwapEvents(context.Background(), &peerswaprpc.SubscribeSwapEventsRequest{
+		ReplaySince: ctx.Int64(replaySinceFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		printRespJSON(event)
+	}
+}
+
+func exportSwaps(ctx *cli.Context) error {
+	startTime, err := parseDate(ctx.String(startTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+	endTime, err := parseDate(ctx.String(endTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.ExportSwaps(context.Background(), &peerswaprpc.ExportSwapsRequest{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		PeerNodeId: ctx.String(peerFlag.Name),
+		Format:     ctx.String(formatFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	if ctx.String(formatFlag.Name) == "csv" {
+		fmt.Print(res.Csv)
+		return nil
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func archiveSwaps(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.ArchiveSwaps(context.Background(), &peerswaprpc.ArchiveSwapsRequest{
+		OlderThanDays: uint32(ctx.Uint(olderThanDaysFlag.Name)),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func listArchivedSwaps(ctx *cli.Context) error {
+	startTime, err := parseDate(ctx.String(startTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+	endTime, err := parseDate(ctx.String(endTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.ListArchivedSwaps(context.Background(), &peerswaprpc.ListArchivedSwapsRequest{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		PeerNodeId: ctx.String(peerFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+// parseDate parses a date as YYYY-MM-DD in UTC or as RFC3339 into a unix
+// timestamp. An empty date is 0.
+func parseDate(date string) (int64, error) {
+	if date == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date %s, expected YYYY-MM-DD or RFC3339", date)
+	}
+	return t.Unix(), nil
+}
+
 func listSwaps(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -268,7 +738,33 @@ func listSwaps(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	res, err := client.ListSwaps(context.Background(), &peerswaprpc.ListSwapsRequest{})
+	createdAfter, err := parseDate(ctx.String(startTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+	createdBefore, err := parseDate(ctx.String(endTimeFlag.Name))
+	if err != nil {
+		return err
+	}
+	var channelId string
+	if ctx.IsSet(channelFilterFlag.Name) {
+		channelId = lnwire.NewShortChanIDFromInt(ctx.Uint64(channelFilterFlag.Name)).String()
+	}
+
+	res, err := client.ListSwaps(context.Background(), &peerswaprpc.ListSwapsRequest{
+		PeerNodeId:    ctx.String(peerFlag.Name),
+		ChannelId:     channelId,
+		Asset:         ctx.String(assetFilterFlag.Name),
+		Type:          ctx.String(typeFlag.Name),
+		Role:          ctx.String(roleFlag.Name),
+		States:        ctx.StringSlice(stateFlag.Name),
+		Status:        ctx.String(statusFlag.Name),
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Descending:    ctx.Bool(descendingFlag.Name),
+		Cursor:        ctx.String(cursorFlag.Name),
+		Limit:         uint32(ctx.Uint(limitFlag.Name)),
+	})
 	if err != nil {
 		return err
 	}
@@ -291,6 +787,41 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+func getPeerReputation(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.GetPeerReputation(context.Background(), &peerswaprpc.GetPeerReputationRequest{
+		NodeId: ctx.String(pubkeyFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func getPeerStats(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.GetPeerStats(context.Background(), &peerswaprpc.GetPeerStatsRequest{
+		NodeId: ctx.String(pubkeyFlag.Name),
+		Swaps:  uint32(ctx.Uint(lastSwapsFlag.Name)),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
 func reloadPolicyFile(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -369,6 +900,54 @@ func liquidSendToAddress(ctx *cli.Context) error {
 	return nil
 }
 
+func btcGetAddress(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.BtcGetAddress(context.Background(), &peerswaprpc.GetAddressRequest{})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func btcGetBalance(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.BtcGetBalance(context.Background(), &peerswaprpc.GetBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func btcSendToAddress(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	res, err := client.BtcSendToAddress(context.Background(), &peerswaprpc.SendToAddressRequest{
+		Address:   ctx.String(btcAddressFlag.Name),
+		SatAmount: ctx.Uint64(satAmountFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
 func listActiveSwaps(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -450,6 +1029,45 @@ func addSusPeer(ctx *cli.Context) error {
 	return nil
 }
 
+func evaluatePolicy(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	res, err := client.EvaluatePolicy(context.Background(), &peerswaprpc.EvaluatePolicyRequest{
+		PeerPubkey: ctx.String(pubkeyFlag.Name),
+		Asset:      ctx.String(assetFlag.Name),
+		Type:       ctx.String(requestTypeFlag.Name),
+		AmountSat:  ctx.Uint64(satAmountFlag.Name),
+		ChannelId:  ctx.Uint64(evaluateChannelIdFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
+func quoteSwap(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	res, err := client.QuoteSwap(context.Background(), &peerswaprpc.QuoteSwapRequest{
+		ChannelId: ctx.Uint64(channelIdFlag.Name),
+		Asset:     ctx.String(assetFlag.Name),
+		Type:      ctx.String(quoteTypeFlag.Name),
+		AmountSat: ctx.Uint64(satAmountFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	printRespJSON(res)
+	return nil
+}
+
 func removeSusPeer(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -484,7 +1102,7 @@ func stopPeerswap(ctx *cli.Context) error {
 func getClient(ctx *cli.Context) (peerswaprpc.PeerSwapClient, func(), error) {
 	rpcServer := ctx.GlobalString("rpchost")
 
-	conn, err := getClientConn(rpcServer)
+	conn, err := getClientConn(rpcServer, ctx.GlobalString("tlscertpath"), ctx.GlobalString("macaroonpath"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -494,13 +1112,23 @@ func getClient(ctx *cli.Context) (peerswaprpc.PeerSwapClient, func(), error) {
 	return psClient, cleanup, nil
 }
 
-func getClientConn(address string) (*grpc.ClientConn,
+func getClientConn(address, tlsCertPath, tokenPath string) (*grpc.ClientConn,
 	error) {
 
+	creds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tls certificate: %v", err)
+	}
+	token, err := peerswaprpc.NewTokenCredentialFromFile(tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
 	maxMsgRecvSize := grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 200)
 	opts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
-		grpc.WithInsecure(),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(token),
 	}
 
 	conn, err := grpc.Dial(address, opts...)
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/elementsproject/peerswap/autoswap"
 	"github.com/elementsproject/peerswap/log"
 
 	"github.com/cenkalti/backoff/v4"
@@ -180,6 +181,23 @@ func (l *Client) ReceivableMsat(scid string) (uint64, error) {
 	return 0, fmt.Errorf("could not find a channel with scid: %s", scid)
 }
 
+// ListChannels returns the channels of the node for the autoswap manager.
+func (l *Client) ListChannels() ([]*autoswap.Channel, error) {
+	r, err := l.lndClient.ListChannels(l.ctx, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var channels []*autoswap.Channel
+	for _, ch := range r.Channels {
+		channels = append(channels, &autoswap.Channel{
+			ChannelId: lnwire.NewShortChanIDFromInt(ch.ChanId).String(),
+			PeerId:    ch.RemotePubkey,
+			Active:    ch.Active,
+		})
+	}
+	return channels, nil
+}
+
 // checkChannel checks that a channel channel peer is connected and that the
 // channel is active.
 func (l *Client) checkChannel(ch *lnrpc.Channel) error {
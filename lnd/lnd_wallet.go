@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -79,6 +80,89 @@ func (l *Client) CreateOpeningTransaction(swapParams *swap.OpeningParams) (rawTx
 	return rawTxHex, addr, openingTx.TxHash().String(), fee, vout, nil
 }
 
+// PrepareBatchOpeningTransaction creates and signs a transaction that funds
+// the opening outputs of several swaps. The inputs stay locked until the
+// transaction is published with PublishBatchOpeningTransaction.
+func (l *Client) PrepareBatchOpeningTransaction(swapParams []*swap.OpeningParams) (*swap.BatchOpeningTx, error) {
+	var addresses []string
+	outputs := make(map[string]uint64)
+	for _, params := range swapParams {
+		addr, err := l.bitcoinOnChain.CreateOpeningAddress(params, onchain.BitcoinCsv)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addr)
+		outputs[addr] = params.Amount
+	}
+
+	fundRes, err := l.walletClient.FundPsbt(l.ctx, &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{Raw: &walletrpc.TxTemplate{Outputs: outputs}},
+		Fees:     &walletrpc.FundPsbtRequest_TargetConf{TargetConf: 3},
+	})
+	if err != nil {
+		return nil, err
+	}
+	unsignedPacket, err := psbt.NewFromRawBytes(bytes.NewReader(fundRes.FundedPsbt), false)
+	if err != nil {
+		return nil, err
+	}
+
+	bytesBuffer := new(bytes.Buffer)
+	err = unsignedPacket.Serialize(bytesBuffer)
+	if err != nil {
+		return nil, err
+	}
+	finalizeRes, err := l.walletClient.FinalizePsbt(l.ctx, &walletrpc.FinalizePsbtRequest{
+		FundedPsbt: bytesBuffer.Bytes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	psbtString := base64.StdEncoding.EncodeToString(finalizeRes.SignedPsbt)
+	rawTxHex := hex.EncodeToString(finalizeRes.RawFinalTx)
+
+	fee, err := l.bitcoinOnChain.GetFeeSatsFromTx(psbtString, rawTxHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var vouts []uint32
+	for _, params := range swapParams {
+		_, vout, err := l.bitcoinOnChain.GetVoutAndVerify(rawTxHex, params)
+		if err != nil {
+			return nil, err
+		}
+		vouts = append(vouts, vout)
+	}
+	openingTx := wire.NewMsgTx(2)
+	err = openingTx.Deserialize(bytes.NewReader(finalizeRes.RawFinalTx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &swap.BatchOpeningTx{
+		TxId:      openingTx.TxHash().String(),
+		TxHex:     rawTxHex,
+		Fee:       fee,
+		Addresses: addresses,
+		Vouts:     vouts,
+	}, nil
+}
+
+// PublishBatchOpeningTransaction publishes a batch opening transaction that
+// was prepared by PrepareBatchOpeningTransaction.
+func (l *Client) PublishBatchOpeningTransaction(tx *swap.BatchOpeningTx) (string, error) {
+	rawTx, err := hex.DecodeString(tx.TxHex)
+	if err != nil {
+		return "", err
+	}
+	_, err = l.walletClient.PublishTransaction(l.ctx, &walletrpc.Transaction{TxHex: rawTx})
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHex, nil
+}
+
 func (l *Client) CreatePreimageSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams) (string, string, string, error) {
 	_, vout, err := l.bitcoinOnChain.GetVoutAndVerify(claimParams.OpeningTxHex, swapParams)
 	if err != nil {
@@ -90,6 +174,14 @@ func (l *Client) CreatePreimageSpendingTransaction(swapParams *swap.OpeningParam
 		return "", "", "", err
 	}
 
+	if swapParams.Taproot {
+		tx, err := l.bitcoinOnChain.CreateTaprootPreimageSpendingTransaction(swapParams, claimParams, newAddr, vout)
+		if err != nil {
+			return "", "", "", err
+		}
+		return l.publishSpendingTx(tx, newAddr)
+	}
+
 	tx, sigHash, redeemScript, err := l.bitcoinOnChain.PrepareSpendingTransaction(swapParams, claimParams, newAddr, vout, 0, 0)
 	if err != nil {
 		return "", "", "", err
@@ -131,6 +223,14 @@ func (l *Client) CreateCsvSpendingTransaction(swapParams *swap.OpeningParams, cl
 	if err != nil {
 		return "", "", "", err
 	}
+	if swapParams.Taproot {
+		tx, err := l.bitcoinOnChain.CreateTaprootCsvSpendingTransaction(swapParams, claimParams, newAddr, vout)
+		if err != nil {
+			return "", "", "", err
+		}
+		return l.publishSpendingTx(tx, newAddr)
+	}
+
 	tx, sigHash, redeemScript, err := l.bitcoinOnChain.PrepareSpendingTransaction(swapParams, claimParams, newAddr, vout, onchain.BitcoinCsv, 0)
 	if err != nil {
 		return "", "", "", err
@@ -172,6 +272,14 @@ func (l *Client) CreateCoopSpendingTransaction(swapParams *swap.OpeningParams, c
 	if err != nil {
 		return "", "", "", err
 	}
+	if swapParams.Taproot {
+		tx, err := l.bitcoinOnChain.CreateTaprootCoopSpendingTransaction(swapParams, claimParams, refundAddr, vout, refundFee, takerSigner)
+		if err != nil {
+			return "", "", "", err
+		}
+		return l.publishSpendingTx(tx, refundAddr)
+	}
+
 	spendingTx, sigHashBytes, redeemScript, err := l.bitcoinOnChain.PrepareSpendingTransaction(swapParams, claimParams, refundAddr, vout, 0, refundFee)
 	if err != nil {
 		return "", "", "", err
@@ -204,6 +312,22 @@ func (l *Client) CreateCoopSpendingTransaction(swapParams *swap.OpeningParams, c
 	return spendingTx.TxHash().String(), txHex, refundAddr, nil
 }
 
+// publishSpendingTx publishes a signed transaction that spends the opening
+// output to address.
+func (l *Client) publishSpendingTx(tx *wire.MsgTx, address string) (txId, txHex, addr string, err error) {
+	bytesBuffer := new(bytes.Buffer)
+	err = tx.Serialize(bytesBuffer)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	_, err = l.walletClient.PublishTransaction(l.ctx, &walletrpc.Transaction{TxHex: bytesBuffer.Bytes()})
+	if err != nil {
+		return "", "", "", err
+	}
+	return tx.TxHash().String(), hex.EncodeToString(bytesBuffer.Bytes()), address, nil
+}
+
 // SetLabel labels a transaction with a given label.
 // This makes it easier to audit the transactions from faraday.
 // This is performed by LND's LabelTransaction RPC.
@@ -253,6 +377,81 @@ func (l *Client) GetFlatOpeningTXFee() (uint64, error) {
 	return l.bitcoinOnChain.GetFee(onchain.EstimatedOpeningTxSize)
 }
 
+// GetFeeRate returns the estimated fee rate in sat/vb for a confirmation within
+// targetConf blocks.
+func (l *Client) GetFeeRate(targetConf uint32) (uint64, error) {
+	return l.bitcoinOnChain.GetFeeRate(targetConf)
+}
+
+func (l *Client) GetSpendingTxFee(swapParams *swap.OpeningParams, txHex string) (uint64, error) {
+	return l.bitcoinOnChain.GetSpendingTxFee(swapParams, txHex)
+}
+
+func (l *Client) GetTxConfirmations(txId string) (uint32, error) {
+	tx, err := l.getWalletTransaction(txId)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(tx.NumConfirmations), nil
+}
+
+// BumpOpeningTransaction hands the change output of the opening transaction to
+// the sweeper of lnd, which broadcasts a child transaction that pays for the
+// opening transaction (CPFP). The txid of the child is not known at this
+// point.
+func (l *Client) BumpOpeningTransaction(openingTxHex string, satPerVbyte uint64) (string, error) {
+	txId, err := l.bitcoinOnChain.TxIdFromHex(openingTxHex)
+	if err != nil {
+		return "", err
+	}
+	vsize, err := onchain.GetTxVsize(openingTxHex)
+	if err != nil {
+		return "", err
+	}
+	tx, err := l.getWalletTransaction(txId)
+	if err != nil {
+		return "", err
+	}
+
+	var change *lnrpc.OutputDetail
+	for _, out := range tx.OutputDetails {
+		if out.IsOurAddress {
+			change = out
+		}
+	}
+	if change == nil {
+		return "", fmt.Errorf("opening transaction %s has no change output to spend", txId)
+	}
+
+	_, err = l.walletClient.BumpFee(l.ctx, &walletrpc.BumpFeeRequest{
+		Outpoint: &lnrpc.OutPoint{
+			TxidStr:     txId,
+			OutputIndex: uint32(change.OutputIndex),
+		},
+		SatPerVbyte: onchain.GetCpfpFeeRate(vsize, uint64(tx.TotalFees), satPerVbyte),
+		Force:       true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// getWalletTransaction returns the transaction with txId from the wallet of
+// lnd.
+func (l *Client) getWalletTransaction(txId string) (*lnrpc.Transaction, error) {
+	res, err := l.lndClient.GetTransactions(l.ctx, &lnrpc.GetTransactionsRequest{EndHeight: -1})
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range res.Transactions {
+		if tx.TxHash == txId {
+			return tx, nil
+		}
+	}
+	return nil, fmt.Errorf("transaction %s not found in wallet", txId)
+}
+
 func (cl *Client) GetAsset() string {
 	return ""
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -42,6 +43,8 @@ type TxWatcher struct {
 
 	confirmationWatchers map[string]bool
 	waitForCsvWatchers   map[string]bool
+
+	processedHeight uint32
 }
 
 func NewTxWatcher(ctx context.Context, cc *grpc.ClientConn, network *chaincfg.Params, targetConfirmation, targetCsv uint32) (*TxWatcher, error) {
@@ -81,6 +84,45 @@ func (t *TxWatcher) StartWatchingTxs() error {
 	return nil
 }
 
+// WatchBlocks subscribes to the blocks of the chain notifier of lnd, so that
+// ProcessedBlockHeight follows the blocks that lnd notifies us about.
+func (t *TxWatcher) WatchBlocks() error {
+	stream, err := t.chainrpcClient.RegisterBlockEpochNtfn(t.ctx, &chainrpc.BlockEpoch{})
+	if err != nil {
+		return err
+	}
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		for {
+			be, err := stream.Recv()
+			if err == io.EOF {
+				log.Infof("[TxWatcher] Block stream closed by server")
+				return
+			}
+			if IsContextError(err) {
+				s := status.Convert(err)
+				log.Infof("[TxWatcher] Block stream closed by client: %s", s.Message())
+				return
+			}
+			if err != nil {
+				log.Infof("[TxWatcher] Block stream closed with err: %v", err)
+				return
+			}
+			atomic.StoreUint32(&t.processedHeight, be.Height)
+		}
+	}()
+	return nil
+}
+
+// ProcessedBlockHeight returns the height of the last block that the chain
+// notifier of lnd sent us, 0 if WatchBlocks was not called. Together with
+// GetBlockHeight it tells how far the watcher lags behind the tip.
+func (t *TxWatcher) ProcessedBlockHeight() uint32 {
+	return atomic.LoadUint32(&t.processedHeight)
+}
+
 func (t *TxWatcher) Stop() error {
 	t.cancel()
 	log.Infof("[TxWatcher] Canceled contexts, waiting for subscriptions to close")
@@ -16,6 +16,25 @@ type StoppableMessenger interface {
 	Stop()
 }
 
+// resends counts the messages that were sent again by a RedundantMessenger
+// by message type.
+var resends = struct {
+	sync.Mutex
+	counts map[MessageType]uint64
+}{counts: map[MessageType]uint64{}}
+
+// ResendCounts returns the number of messages that were sent again by a
+// RedundantMessenger by message type.
+func ResendCounts() map[MessageType]uint64 {
+	resends.Lock()
+	defer resends.Unlock()
+	counts := make(map[MessageType]uint64, len(resends.counts))
+	for t, c := range resends.counts {
+		counts[t] = c
+	}
+	return counts
+}
+
 type RedundantMessenger struct {
 	messenger Messenger
 	ticker    time.Ticker
@@ -43,6 +62,9 @@ func (s *RedundantMessenger) SendMessage(peerId string, message []byte, messageT
 		for {
 			select {
 			case <-s.ticker.C:
+				resends.Lock()
+				resends.counts[MessageType(messageType)]++
+				resends.Unlock()
 				err := s.messenger.SendMessage(peerId, message, messageType)
 				if err != nil {
 					log.Debugf("[RedundantSender] SendMessageWithRetry: %v", err)
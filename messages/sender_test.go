@@ -15,6 +15,7 @@ func TestRedundantSender_SendMessageWithRetry(t *testing.T) {
 
 	msgr := &MessengerStub{}
 	rs := NewRedundantMessenger(msgr, tRetry)
+	resendsBefore := ResendCounts()[MESSAGETYPE_CANCELED]
 
 	rs.SendMessage("peer_id", []byte("canceled"), int(MESSAGETYPE_CANCELED))
 	time.Sleep(tWait)
@@ -24,6 +25,7 @@ func TestRedundantSender_SendMessageWithRetry(t *testing.T) {
 	time.Sleep(tWait)
 	nMsgs := msgr.Called()
 	assert.Greater(t, nMsgs, 1)
+	assert.EqualValues(t, nMsgs-1, ResendCounts()[MESSAGETYPE_CANCELED]-resendsBefore)
 
 	// Check it is not sending anymore.
 	time.Sleep(tWait)
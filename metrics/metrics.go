@@ -0,0 +1,286 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "peerswap"
+
+type SwapService interface {
+	SubscribeSwapEvents(since int64) ([]*swap.SwapEvent, <-chan *swap.SwapEvent, func())
+	GetSwap(swapId string) (*swap.SwapStateMachine, error)
+}
+
+type PollService interface {
+	GetPolls() (map[string]poll.PollInfo, error)
+	GetCompatiblePolls() (map[string]poll.PollInfo, error)
+}
+
+// TxWatcher is a tx watcher that can tell how far it lags behind the tip.
+type TxWatcher interface {
+	GetBlockHeight() (uint32, error)
+	ProcessedBlockHeight() uint32
+}
+
+// Metrics collects the metrics of the swaps, the tx watchers, the messenger
+// and the poll service and serves them in the prometheus format.
+type Metrics struct {
+	sync.Mutex
+	registry *prometheus.Registry
+
+	swaps         *prometheus.CounterVec
+	swapAmount    *prometheus.HistogramVec
+	swapDuration  *prometheus.HistogramVec
+	stateDuration *prometheus.HistogramVec
+	openingTxFees *prometheus.CounterVec
+	claimTxFees   *prometheus.CounterVec
+	cancels       *prometheus.CounterVec
+
+	// stateEntered holds the time at which a swap entered its current
+	// state by swap id.
+	stateEntered map[string]time.Time
+}
+
+func NewMetrics() *Metrics {
+	swapLabels := []string{"type", "role", "asset"}
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		swaps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "swaps_total",
+			Help:      "Number of finished swaps by final state.",
+		}, append(swapLabels, "state")),
+		swapAmount: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "swap_amount_sat",
+			Help:      "Amount of the finished swaps in sat.",
+			Buckets:   prometheus.ExponentialBuckets(100000, 2, 10),
+		}, append(swapLabels, "state")),
+		swapDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "swap_duration_seconds",
+			Help:      "Time from the creation of a swap until it finished.",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 12),
+		}, append(swapLabels, "state")),
+		stateDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "swap_state_duration_seconds",
+			Help:      "Time that swaps spent in a state.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+		}, []string{"state"}),
+		openingTxFees: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "opening_tx_fees_sat_total",
+			Help:      "On-chain fees paid for opening transactions of finished swaps in sat.",
+		}, []string{"asset"}),
+		claimTxFees: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "claim_tx_fees_sat_total",
+			Help:      "On-chain fees paid for claim transactions of finished swaps in sat.",
+		}, []string{"asset"}),
+		cancels: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "swap_cancels_total",
+			Help:      "Number of canceled swaps by cancel code.",
+		}, append(swapLabels, "code")),
+		stateEntered: map[string]time.Time{},
+	}
+	m.registry.MustRegister(
+		m.swaps,
+		m.swapAmount,
+		m.swapDuration,
+		m.stateDuration,
+		m.openingTxFees,
+		m.claimTxFees,
+		m.cancels,
+		&resendCollector{
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "", "message_resends_total"),
+				"Number of messages that were sent again by the redundant messenger.",
+				[]string{"type"}, nil,
+			),
+		},
+	)
+	return m
+}
+
+// AddTxWatcher exports how many blocks the tx watcher of the asset lags
+// behind the tip.
+func (m *Metrics) AddTxWatcher(asset string, watcher TxWatcher) error {
+	return m.registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "txwatcher_lag_blocks",
+		Help:        "Number of blocks that the tx watcher lags behind the tip.",
+		ConstLabels: prometheus.Labels{"asset": asset},
+	}, func() float64 {
+		processed := watcher.ProcessedBlockHeight()
+		if processed == 0 {
+			return math.NaN()
+		}
+		tip, err := watcher.GetBlockHeight()
+		if err != nil {
+			return math.NaN()
+		}
+		if tip < processed {
+			return 0
+		}
+		return float64(tip - processed)
+	}))
+}
+
+// AddPollService exports the number of peers that the poll service knows.
+func (m *Metrics) AddPollService(polls PollService) error {
+	return m.registry.Register(&pollCollector{
+		polls: polls,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "peers"),
+			"Number of peers that sent a poll, that run a compatible version and that allow swaps with us.",
+			[]string{"state"}, nil,
+		),
+	})
+}
+
+// Run records the swap events until the context is done.
+func (m *Metrics) Run(ctx context.Context, swaps SwapService) {
+	_, events, cancel := swaps.SubscribeSwapEvents(0)
+	defer func() { cancel() }()
+
+	var replay []*swap.SwapEvent
+	var last int64
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				// We fell behind, resubscribe and replay the missed events.
+				replay, events, cancel = swaps.SubscribeSwapEvents(last)
+				for _, e := range replay {
+					m.observeSwapEvent(e, swaps)
+					last = e.Timestamp
+				}
+				continue
+			}
+			m.observeSwapEvent(event, swaps)
+			last = event.Timestamp
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Metrics) observeSwapEvent(event *swap.SwapEvent, swaps SwapService) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := time.Unix(0, event.Timestamp)
+	if entered, ok := m.stateEntered[event.SwapId]; ok {
+		m.stateDuration.WithLabelValues(string(event.OldState)).Observe(now.Sub(entered).Seconds())
+	}
+	if !swap.IsFinalState(event.NewState) {
+		m.stateEntered[event.SwapId] = now
+		return
+	}
+	delete(m.stateEntered, event.SwapId)
+
+	labels := []string{event.Type, event.Role, event.Asset, string(event.NewState)}
+	m.swaps.WithLabelValues(labels...).Inc()
+	m.swapAmount.WithLabelValues(labels...).Observe(float64(event.Amount))
+
+	s, err := swaps.GetSwap(event.SwapId)
+	if err != nil {
+		log.Debugf("[Metrics] could not get swap %s: %v", event.SwapId, err)
+		return
+	}
+	if s.Data.CreatedAt != 0 {
+		m.swapDuration.WithLabelValues(labels...).Observe(now.Sub(time.Unix(s.Data.CreatedAt, 0)).Seconds())
+	}
+	m.openingTxFees.WithLabelValues(event.Asset).Add(float64(s.Data.OpeningTxFee))
+	m.claimTxFees.WithLabelValues(event.Asset).Add(float64(s.Data.ClaimTxFee))
+
+	if event.NewState == swap.State_SwapCanceled || event.NewState == swap.State_ClaimedCoop {
+		code := s.Data.GetCancelCode()
+		if code == "" {
+			code = swap.CancelCode_Unknown
+		}
+		m.cancels.WithLabelValues(event.Type, event.Role, event.Asset, string(code)).Inc()
+	}
+}
+
+// Handler returns the http handler that serves the metrics.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ListenAndServe serves the metrics on /metrics until the context is done.
+func (m *Metrics) ListenAndServe(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	log.Infof("[Metrics] serving metrics on %s/metrics", addr)
+	err := server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+type resendCollector struct {
+	desc *prometheus.Desc
+}
+
+func (c *resendCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *resendCollector) Collect(ch chan<- prometheus.Metric) {
+	for msgType, count := range messages.ResendCounts() {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.CounterValue, float64(count), messages.MessageTypeToHexString(msgType))
+	}
+}
+
+type pollCollector struct {
+	polls PollService
+	desc  *prometheus.Desc
+}
+
+func (c *pollCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *pollCollector) Collect(ch chan<- prometheus.Metric) {
+	polls, err := c.polls.GetPolls()
+	if err != nil {
+		log.Debugf("[Metrics] could not get polls: %v", err)
+		return
+	}
+	compatible, err := c.polls.GetCompatiblePolls()
+	if err != nil {
+		log.Debugf("[Metrics] could not get compatible polls: %v", err)
+		return
+	}
+	var allowed int
+	for _, p := range compatible {
+		if p.PeerAllowed {
+			allowed++
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(len(polls)), "polled")
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(len(compatible)), "compatible")
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(allowed), "allowed")
+}
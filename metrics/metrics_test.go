@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ObserveSwapEvent(t *testing.T) {
+	m := NewMetrics()
+	swaps := &fakeSwaps{swaps: map[string]*swap.SwapStateMachine{
+		"swap": {Data: &swap.SwapData{
+			CreatedAt:    time.Now().Add(-10 * time.Minute).Unix(),
+			OpeningTxFee: 500,
+			ClaimTxFee:   300,
+		}},
+	}}
+	start := time.Now()
+	event := func(old, new swap.StateType, at time.Time) *swap.SwapEvent {
+		return &swap.SwapEvent{
+			Timestamp: at.UnixNano(),
+			SwapId:    "swap",
+			Type:      "swap-out",
+			Role:      "receiver",
+			Asset:     "btc",
+			Amount:    100000,
+			OldState:  old,
+			NewState:  new,
+		}
+	}
+
+	m.observeSwapEvent(event(swap.State_SwapOutReceiver_CreateSwap, swap.State_SwapOutReceiver_AwaitClaimInvoicePayment, start), swaps)
+	assert.Equal(t, 0, testutil.CollectAndCount(m.swaps))
+	assert.Contains(t, m.stateEntered, "swap")
+
+	m.observeSwapEvent(event(swap.State_SwapOutReceiver_AwaitClaimInvoicePayment, swap.State_ClaimedPreimage, start.Add(time.Minute)), swaps)
+	assert.NotContains(t, m.stateEntered, "swap")
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.swaps.WithLabelValues("swap-out", "receiver", "btc", string(swap.State_ClaimedPreimage))))
+	assert.Equal(t, 500.0, testutil.ToFloat64(m.openingTxFees.WithLabelValues("btc")))
+	assert.Equal(t, 300.0, testutil.ToFloat64(m.claimTxFees.WithLabelValues("btc")))
+
+	var metric dto.Metric
+	require.NoError(t, m.stateDuration.WithLabelValues(string(swap.State_SwapOutReceiver_AwaitClaimInvoicePayment)).(prometheus.Histogram).Write(&metric))
+	assert.EqualValues(t, 1, metric.Histogram.GetSampleCount())
+	assert.Equal(t, 60.0, metric.Histogram.GetSampleSum())
+}
+
+func Test_SwapCancels(t *testing.T) {
+	m := NewMetrics()
+	swaps := &fakeSwaps{swaps: map[string]*swap.SwapStateMachine{
+		"canceled": {Data: &swap.SwapData{Cancel: &swap.CancelMessage{Message: "premium too high", Code: swap.CancelCode_PremiumTooHigh}}},
+		"legacy":   {Data: &swap.SwapData{Cancel: &swap.CancelMessage{Message: "swaps are disabled"}}},
+		"claimed":  {Data: &swap.SwapData{}},
+	}}
+	for id, state := range map[string]swap.StateType{
+		"canceled": swap.State_SwapCanceled,
+		"legacy":   swap.State_SwapCanceled,
+		"claimed":  swap.State_ClaimedPreimage,
+	} {
+		m.observeSwapEvent(&swap.SwapEvent{
+			Timestamp: time.Now().UnixNano(),
+			SwapId:    id,
+			Type:      "swap-in",
+			Role:      "sender",
+			Asset:     "btc",
+			OldState:  swap.State_SwapInSender_AwaitAgreement,
+			NewState:  state,
+		}, swaps)
+	}
+
+	expected := `
+# HELP peerswap_swap_cancels_total Number of canceled swaps by cancel code.
+# TYPE peerswap_swap_cancels_total counter
+peerswap_swap_cancels_total{asset="btc",code="premium_too_high",role="sender",type="swap-in"} 1
+peerswap_swap_cancels_total{asset="btc",code="unknown",role="sender",type="swap-in"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "peerswap_swap_cancels_total"))
+}
+
+func Test_TxWatcherLag(t *testing.T) {
+	m := NewMetrics()
+	watcher := &fakeTxWatcher{tip: 105, processed: 100}
+	require.NoError(t, m.AddTxWatcher("btc", watcher))
+
+	expected := `
+# HELP peerswap_txwatcher_lag_blocks Number of blocks that the tx watcher lags behind the tip.
+# TYPE peerswap_txwatcher_lag_blocks gauge
+peerswap_txwatcher_lag_blocks{asset="btc"} 5
+`
+	require.NoError(t, testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "peerswap_txwatcher_lag_blocks"))
+}
+
+func Test_PollService(t *testing.T) {
+	m := NewMetrics()
+	require.NoError(t, m.AddPollService(&fakePolls{
+		polls: map[string]poll.PollInfo{
+			"a": {PeerAllowed: true},
+			"b": {PeerAllowed: false},
+			"c": {},
+		},
+		compatible: map[string]poll.PollInfo{
+			"a": {PeerAllowed: true},
+			"b": {PeerAllowed: false},
+		},
+	}))
+
+	expected := `
+# HELP peerswap_peers Number of peers that sent a poll, that run a compatible version and that allow swaps with us.
+# TYPE peerswap_peers gauge
+peerswap_peers{state="allowed"} 1
+peerswap_peers{state="compatible"} 2
+peerswap_peers{state="polled"} 3
+`
+	require.NoError(t, testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "peerswap_peers"))
+}
+
+type fakeSwaps struct {
+	swaps map[string]*swap.SwapStateMachine
+}
+
+func (f *fakeSwaps) SubscribeSwapEvents(since int64) ([]*swap.SwapEvent, <-chan *swap.SwapEvent, func()) {
+	return nil, make(chan *swap.SwapEvent), func() {}
+}
+
+func (f *fakeSwaps) GetSwap(swapId string) (*swap.SwapStateMachine, error) {
+	s, ok := f.swaps[swapId]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return s, nil
+}
+
+type fakeTxWatcher struct {
+	tip       uint32
+	processed uint32
+}
+
+func (f *fakeTxWatcher) GetBlockHeight() (uint32, error) {
+	return f.tip, nil
+}
+
+func (f *fakeTxWatcher) ProcessedBlockHeight() uint32 {
+	return f.processed
+}
+
+type fakePolls struct {
+	polls      map[string]poll.PollInfo
+	compatible map[string]poll.PollInfo
+}
+
+func (f *fakePolls) GetPolls() (map[string]poll.PollInfo, error) {
+	return f.polls, nil
+}
+
+func (f *fakePolls) GetCompatiblePolls() (map[string]poll.PollInfo, error) {
+	return f.compatible, nil
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -44,6 +46,11 @@ const (
 	// We add a security margin to this which leads to the size of 350 vByte.
 	EstimatedOpeningTxSize = 350
 
+	// EstimatedCpfpChildTxSize in vByte is the estimated size of a child
+	// transaction that spends the change output of an opening transaction to
+	// a single P2WPKH output, with a security margin.
+	EstimatedCpfpChildTxSize = 120
+
 	// This defines the absolute floor of the feerate. This will be the minimum
 	// feerate that will be used. The floor is set to 275 sat/kw so that we
 	// always have a minimum fee rate of 1.1 sat/vb.
@@ -103,16 +110,7 @@ func (b *BitcoinOnChain) ValidateTx(swapParams *swap.OpeningParams, openingTxHex
 		return false, nil
 	}
 
-	redeemScript, err := ParamsToTxScript(swapParams, BitcoinCsv)
-	if err != nil {
-		return false, err
-	}
-	witnessProgram := sha256.Sum256(redeemScript)
-	addr, err := btcutil.NewAddressWitnessScriptHash(witnessProgram[:], b.chain)
-	if err != nil {
-		return false, err
-	}
-	wantScript, err := txscript.NewScriptBuilder().AddData([]byte{0x00}).AddData(addr.ScriptAddress()).Script()
+	wantScript, err := b.GetOutputScript(swapParams)
 	if err != nil {
 		return false, err
 	}
@@ -177,6 +175,13 @@ func (b *BitcoinOnChain) GetVoutAndVerify(txHex string, params *swap.OpeningPara
 }
 
 func (b *BitcoinOnChain) GetOutputScript(params *swap.OpeningParams) ([]byte, error) {
+	if params.Taproot {
+		output, err := NewTaprootOutput(params, BitcoinCsv)
+		if err != nil {
+			return nil, err
+		}
+		return output.PkScript()
+	}
 	redeemScript, err := ParamsToTxScript(params, BitcoinCsv)
 	if err != nil {
 		return nil, err
@@ -194,14 +199,40 @@ func (b *BitcoinOnChain) GetOutputScript(params *swap.OpeningParams) ([]byte, er
 }
 
 func (b *BitcoinOnChain) PrepareSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams, spendingAddr string, vout uint32, csv uint32, preparedFee uint64) (tx *wire.MsgTx, sigHash, redeemScript []byte, err error) {
+	redeemScript, err = ParamsToTxScript(swapParams, BitcoinCsv)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	spendingTx, prevOut, err := b.prepareSpendingTx(claimParams, spendingAddr, vout, csv, preparedFee)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outputFetcher := txscript.NewCannedPrevOutputFetcher(spendingTx.TxOut[0].PkScript, prevOut.Value-200)
+	sigHashes := txscript.NewTxSigHashes(spendingTx, outputFetcher)
+	sigHash, err = txscript.CalcWitnessSigHash(redeemScript, sigHashes, txscript.SigHashAll, spendingTx, 0, int64(swapParams.Amount))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return spendingTx, sigHash, redeemScript, nil
+}
+
+// prepareSpendingTx returns the unsigned transaction that spends the opening
+// output to spendingAddr, and the opening output.
+func (b *BitcoinOnChain) prepareSpendingTx(claimParams *swap.ClaimParams, spendingAddr string, vout uint32, csv uint32, preparedFee uint64) (*wire.MsgTx, *wire.TxOut, error) {
 	openingMsgTx := wire.NewMsgTx(2)
 	txBytes, err := hex.DecodeString(claimParams.OpeningTxHex)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 	err = openingMsgTx.Deserialize(bytes.NewReader(txBytes))
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
+	}
+	if int(vout) >= len(openingMsgTx.TxOut) {
+		return nil, nil, errors.New("vout out of range")
 	}
 
 	// Add Input
@@ -213,48 +244,49 @@ func (b *BitcoinOnChain) PrepareSpendingTransaction(swapParams *swap.OpeningPara
 
 	scriptChangeAddr, err := btcutil.DecodeAddress(spendingAddr, b.chain)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 	scriptChangeAddrScript := scriptChangeAddr.ScriptAddress()
 	scriptChangeAddrScriptP2pkh, err := txscript.NewScriptBuilder().AddData([]byte{0x00}).AddData(scriptChangeAddrScript).Script()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	spendingTxOut := wire.NewTxOut(openingMsgTx.TxOut[vout].Value-200, scriptChangeAddrScriptP2pkh)
 	spendingTx.AddTxOut(spendingTxOut)
 
-	redeemScript, err = ParamsToTxScript(swapParams, BitcoinCsv)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
 	spendingTxInput := wire.NewTxIn(prevInput, nil, [][]byte{})
 	spendingTxInput.Sequence = 0 | csv
 	spendingTx.AddTxIn(spendingTxInput)
 
 	// assume largest witness
 	fee := preparedFee
-	if preparedFee == 0 {
+	if preparedFee == 0 && claimParams.SatPerVbyte != 0 {
+		fee = claimParams.SatPerVbyte * uint64(spendingTx.SerializeSizeStripped()+74)
+	} else if preparedFee == 0 {
 		fee, err = b.GetFee(int64(spendingTx.SerializeSizeStripped()) + 74)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, err
 		}
 	}
 
 	spendingTx.TxOut[0].Value = spendingTx.TxOut[0].Value - int64(fee)
 
-	outputFetcher := txscript.NewCannedPrevOutputFetcher(scriptChangeAddrScriptP2pkh, openingMsgTx.TxOut[vout].Value-200)
-	sigHashes := txscript.NewTxSigHashes(spendingTx, outputFetcher)
-	sigHash, err = txscript.CalcWitnessSigHash(redeemScript, sigHashes, txscript.SigHashAll, spendingTx, 0, int64(swapParams.Amount))
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	return spendingTx, sigHash, redeemScript, nil
+	return spendingTx, openingMsgTx.TxOut[vout], nil
 }
 
 func (b *BitcoinOnChain) CreateOpeningAddress(params *swap.OpeningParams, csv uint32) (string, error) {
+	if params.Taproot {
+		output, err := NewTaprootOutput(params, csv)
+		if err != nil {
+			return "", err
+		}
+		addr, err := output.Address(b.chain)
+		if err != nil {
+			return "", err
+		}
+		return addr.EncodeAddress(), nil
+	}
 	redeemScript, err := ParamsToTxScript(params, csv)
 	if err != nil {
 		return "", err
@@ -294,12 +326,58 @@ func (b *BitcoinOnChain) GetFeeSatsFromTx(psbtString, txHex string) (uint64, err
 	return uint64(inputSats - outputSats), nil
 }
 
+// GetSpendingTxFee returns the fee in sat of a transaction that spends the
+// opening output. The opening output is the only input and holds the swap
+// amount.
+func (b *BitcoinOnChain) GetSpendingTxFee(swapParams *swap.OpeningParams, txHex string) (uint64, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return 0, err
+	}
+	tx := wire.NewMsgTx(2)
+	err = tx.Deserialize(bytes.NewReader(txBytes))
+	if err != nil {
+		return 0, err
+	}
+	outputSats := int64(0)
+	for _, out := range tx.TxOut {
+		outputSats += out.Value
+	}
+	if outputSats > int64(swapParams.Amount) {
+		return 0, errors.New("outputs exceed the swap amount")
+	}
+	return swapParams.Amount - uint64(outputSats), nil
+}
+
 // GetFee returns the estimated fee in sat for a transaction of size txSize. It
 // fetches the fee estimation from the Estimator in sat/kw and converts the
 // returned fee estimation into sat/vb. The return value is in sat.
 func (b *BitcoinOnChain) GetFee(txSize int64) (uint64, error) {
+	// Convert to sat/vb. This operation is rounding down but should never be
+	// below 1.0 sat/vb if we set the fallback fee above 250 sat/kw. We can set
+	// this fallback fee in the fee estimator.
+	satPerKb := b.getFeeRatePerKw(BitcoinFeeTargetBlocks) * witnessScaleFactor
+	satPerVb := float64(satPerKb) / 1000
+
+	// assume largest witness
+	fee := uint64(satPerVb * float64(txSize))
+	log.Debugf("Using a fee rate of %.2f sat/vb for a total fee of %d", satPerVb, fee)
+	return fee, nil
+}
+
+// GetFeeRate returns the estimated fee rate in sat/vb for a confirmation
+// within targetBlocks. The fee rate is rounded up.
+func (b *BitcoinOnChain) GetFeeRate(targetBlocks uint32) (uint64, error) {
+	satPerKb := uint64(b.getFeeRatePerKw(targetBlocks) * witnessScaleFactor)
+	return (satPerKb + 999) / 1000, nil
+}
+
+// getFeeRatePerKw fetches the fee estimation from the Estimator in sat/kw. It
+// falls back to the fallback fee rate and never returns less than the fee
+// floor.
+func (b *BitcoinOnChain) getFeeRatePerKw(targetBlocks uint32) btcutil.Amount {
 	// EstimateFeePerKw returns an btcutil.Amount that is in sat/kw.
-	satPerKw, err := b.estimator.EstimateFeePerKW(BitcoinFeeTargetBlocks)
+	satPerKw, err := b.estimator.EstimateFeePerKW(targetBlocks)
 	switch {
 	case err != nil:
 		log.Debugf("Error fetching fee from estimator: %v", err)
@@ -316,15 +394,31 @@ func (b *BitcoinOnChain) GetFee(txSize int64) (uint64, error) {
 			"instead", floorFeeRateSatPerKw)
 		satPerKw = floorFeeRateSatPerKw
 	}
+	return satPerKw
+}
 
-	// Convert to sat/vb. This operation is rounding down but should never be
-	// below 1.0 sat/vb if we set the fallback fee above 250 sat/kw. We can set
-	// this fallback fee in the fee estimator.
-	satPerKb := satPerKw * witnessScaleFactor
-	satPerVb := float64(satPerKb) / 1000
+// GetTxVsize returns the virtual size in vByte of a transaction.
+func GetTxVsize(txHex string) (uint64, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return 0, err
+	}
+	tx := wire.NewMsgTx(2)
+	err = tx.Deserialize(bytes.NewReader(txBytes))
+	if err != nil {
+		return 0, err
+	}
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	return uint64(weight+witnessScaleFactor-1) / witnessScaleFactor, nil
+}
 
-	// assume largest witness
-	fee := uint64(satPerVb * float64(txSize))
-	log.Debugf("Using a fee rate of %.2f sat/vb for a total fee of %d", satPerVb, fee)
-	return fee, nil
+// GetCpfpFeeRate returns the fee rate in sat/vb that a child transaction of the
+// size EstimatedCpfpChildTxSize has to pay so that the parent with the given
+// size and fee and the child together pay satPerVbyte.
+func GetCpfpFeeRate(parentVsize, parentFee, satPerVbyte uint64) uint64 {
+	if parentFee >= satPerVbyte*parentVsize {
+		return satPerVbyte
+	}
+	childFee := satPerVbyte*(parentVsize+EstimatedCpfpChildTxSize) - parentFee
+	return (childFee + EstimatedCpfpChildTxSize - 1) / EstimatedCpfpChildTxSize
 }
@@ -1,10 +1,17 @@
 package onchain
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/elementsproject/peerswap/lightning"
+	"github.com/elementsproject/peerswap/swap"
 	"github.com/stretchr/testify/require"
 )
 
@@ -77,6 +84,53 @@ func TestBitcoinOnChain_GetFee_UseFloorFeeRate(t *testing.T) {
 	)
 }
 
+func TestBitcoinOnChain_GetFeeRate(t *testing.T) {
+	estimator := &EstimatorMock{}
+	btcOnChain := NewBitcoinOnChain(
+		estimator,
+		btcutil.Amount(300),
+		&chaincfg.Params{},
+	)
+
+	// 400 sat/kw correspond to 1.6 sat/vb which is rounded up.
+	estimator.EstimateFeePerKWReturn = btcutil.Amount(400)
+	feeRate, err := btcOnChain.GetFeeRate(2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, feeRate)
+
+	// 2500 sat/kw correspond to exactly 10 sat/vb.
+	estimator.EstimateFeePerKWReturn = btcutil.Amount(2500)
+	feeRate, err = btcOnChain.GetFeeRate(2)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, feeRate)
+}
+
+func TestGetCpfpFeeRate(t *testing.T) {
+	// The parent already pays the fee rate.
+	require.EqualValues(t, 10, GetCpfpFeeRate(200, 2000, 10))
+
+	// The child pays for the missing 1800 sat of the parent on top of its own
+	// fee.
+	require.EqualValues(t, 10+(1800+EstimatedCpfpChildTxSize-1)/EstimatedCpfpChildTxSize, GetCpfpFeeRate(200, 200, 10))
+}
+
+func TestBitcoinOnChain_GetSpendingTxFee(t *testing.T) {
+	btcOnChain := NewBitcoinOnChain(&EstimatorMock{}, 0, &chaincfg.RegressionNetParams)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(99000, []byte{0x00}))
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	fee, err := btcOnChain.GetSpendingTxFee(&swap.OpeningParams{Amount: 100000}, hex.EncodeToString(buf.Bytes()))
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, fee)
+
+	_, err = btcOnChain.GetSpendingTxFee(&swap.OpeningParams{Amount: 1000}, hex.EncodeToString(buf.Bytes()))
+	require.Error(t, err)
+}
+
 type EstimatorMock struct {
 	EstimateFeePerKWCalled int
 	EstimateFeePerKWReturn btcutil.Amount
@@ -94,3 +148,105 @@ func (e *EstimatorMock) EstimateFeePerKW(targetBlocks uint32) (btcutil.Amount, e
 func (e *EstimatorMock) Start() error {
 	panic("not implemented") // We dont need this function.
 }
+
+func TestBitcoinOnChain_TaprootSpends(t *testing.T) {
+	btcOnChain := NewBitcoinOnChain(&EstimatorMock{}, 0, &chaincfg.RegressionNetParams)
+
+	makerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	takerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	preimage, err := lightning.GetPreimage()
+	require.NoError(t, err)
+	pHash := preimage.Hash()
+
+	params := &swap.OpeningParams{
+		TakerPubkey:      hex.EncodeToString(takerKey.PubKey().SerializeCompressed()),
+		MakerPubkey:      hex.EncodeToString(makerKey.PubKey().SerializeCompressed()),
+		ClaimPaymentHash: hex.EncodeToString(pHash[:]),
+		Amount:           100000,
+		Taproot:          true,
+	}
+
+	address, err := btcOnChain.CreateOpeningAddress(params, BitcoinCsv)
+	require.NoError(t, err)
+	require.Contains(t, address, "bcrt1p")
+	pkScript, err := btcOnChain.GetOutputScript(params)
+	require.NoError(t, err)
+	require.True(t, txscript.IsPayToTaproot(pkScript))
+
+	openingTx := wire.NewMsgTx(2)
+	openingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	openingTx.AddTxOut(wire.NewTxOut(50000, []byte{0x00}))
+	openingTx.AddTxOut(wire.NewTxOut(int64(params.Amount), pkScript))
+	var buf bytes.Buffer
+	require.NoError(t, openingTx.Serialize(&buf))
+	openingTxHex := hex.EncodeToString(buf.Bytes())
+
+	ok, err := btcOnChain.ValidateTx(params, openingTxHex)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, vout, err := btcOnChain.GetVoutAndVerify(openingTxHex, params)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 1, vout)
+
+	// The P2WSH output of older protocol versions does not match.
+	ok, err = btcOnChain.ValidateTx(&swap.OpeningParams{
+		TakerPubkey:      params.TakerPubkey,
+		MakerPubkey:      params.MakerPubkey,
+		ClaimPaymentHash: params.ClaimPaymentHash,
+		Amount:           params.Amount,
+	}, openingTxHex)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	spendingAddr, err := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	verify := func(tx *wire.MsgTx) {
+		t.Helper()
+		fetcher := txscript.NewCannedPrevOutputFetcher(pkScript, int64(params.Amount))
+		engine, err := txscript.NewEngine(pkScript, tx, 0, txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(tx, fetcher), int64(params.Amount), fetcher)
+		require.NoError(t, err)
+		require.NoError(t, engine.Execute())
+	}
+
+	// The taker claims with the preimage on the script path.
+	tx, err := btcOnChain.CreateTaprootPreimageSpendingTransaction(params, &swap.ClaimParams{
+		Preimage:     preimage.String(),
+		Signer:       swap.NewSecp256k1Signer(takerKey),
+		OpeningTxHex: openingTxHex,
+		SatPerVbyte:  2,
+	}, spendingAddr.EncodeAddress(), vout)
+	require.NoError(t, err)
+	require.Len(t, tx.TxIn[0].Witness, 4)
+	verify(tx)
+
+	// The maker reclaims after the csv on the script path.
+	tx, err = btcOnChain.CreateTaprootCsvSpendingTransaction(params, &swap.ClaimParams{
+		Signer:       swap.NewSecp256k1Signer(makerKey),
+		OpeningTxHex: openingTxHex,
+		SatPerVbyte:  2,
+	}, spendingAddr.EncodeAddress(), vout)
+	require.NoError(t, err)
+	require.EqualValues(t, BitcoinCsv, tx.TxIn[0].Sequence)
+	verify(tx)
+
+	// The maker closes cooperatively on the key path with the key of the
+	// taker.
+	tx, err = btcOnChain.CreateTaprootCoopSpendingTransaction(params, &swap.ClaimParams{
+		Signer:       swap.NewSecp256k1Signer(makerKey),
+		OpeningTxHex: openingTxHex,
+	}, spendingAddr.EncodeAddress(), vout, 500, swap.NewSecp256k1Signer(takerKey))
+	require.NoError(t, err)
+	require.Len(t, tx.TxIn[0].Witness, 1)
+	verify(tx)
+
+	// The signers must hold the keys of the output.
+	_, err = btcOnChain.CreateTaprootCoopSpendingTransaction(params, &swap.ClaimParams{
+		Signer:       swap.NewSecp256k1Signer(takerKey),
+		OpeningTxHex: openingTxHex,
+	}, spendingAddr.EncodeAddress(), vout, 500, swap.NewSecp256k1Signer(makerKey))
+	require.Error(t, err)
+}
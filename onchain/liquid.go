@@ -220,6 +220,9 @@ func (l *LiquidOnChain) prepareSpendingTransaction(swapParams *swap.OpeningParam
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if preparedFee == 0 && claimParams.SatPerVbyte != 0 {
+		preparedFee = claimParams.SatPerVbyte * uint64(l.getClaimTxSize())
+	}
 	spendingTx, sigHash, err := l.createSpendingTransaction(claimParams.OpeningTxHex, swapParams.Amount, csv, l.asset, redeemScript, spendingAddr, preparedFee, swapParams.BlindingKey, claimParams.EphemeralKey, claimParams.OutputAssetBlindingFactor, claimParams.BlindingSeed)
 	if err != nil {
 		return nil, nil, nil, err
@@ -532,6 +535,31 @@ func (l *LiquidOnChain) GetRefundFee() (uint64, error) {
 	return l.liquidWallet.GetFee(int64(l.getClaimTxSize()))
 }
 
+// GetFeeRate returns the fee rate in sat/vb of the wallet rounded up. The
+// target is ignored as a liquid block is found every minute.
+func (l *LiquidOnChain) GetFeeRate(_ uint32) (uint64, error) {
+	fee, err := l.liquidWallet.GetFee(1000)
+	if err != nil {
+		return 0, err
+	}
+	return (fee + 999) / 1000, nil
+}
+
+// GetSpendingTxFee returns the fee in sat of a transaction that spends the
+// opening output. It is the value of the explicit fee output.
+func (l *LiquidOnChain) GetSpendingTxFee(_ *swap.OpeningParams, txHex string) (uint64, error) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		return 0, err
+	}
+	for _, out := range tx.Outputs {
+		if len(out.Script) == 0 {
+			return elementsutil.ValueFromBytes(out.Value)
+		}
+	}
+	return 0, errors.New("transaction has no fee output")
+}
+
 // GetFlatOpeningTXFee returns an estimate of the fee for the opening transaction.
 func (l *LiquidOnChain) GetFlatOpeningTXFee() (uint64, error) {
 	return l.liquidWallet.GetFee(EstimatedOpeningConfidentialTxSizeBytes)
@@ -0,0 +1,331 @@
+package onchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/elementsproject/peerswap/lightning"
+	"github.com/elementsproject/peerswap/swap"
+)
+
+var ErrNoTaprootSigner = errors.New("signer can not sign taproot spends")
+
+// GetTaprootPreimageScript returns the tapscript of the leaf that the taker
+// claims the opening output with by revealing the preimage of pHash.
+func GetTaprootPreimageScript(takerPubkey *btcec.PublicKey, pHash []byte) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_SIZE).
+		AddData(h2b("20")).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_SHA256).
+		AddData(pHash).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddData(schnorr.SerializePubKey(takerPubkey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// GetTaprootCsvScript returns the tapscript of the leaf that the maker
+// reclaims the opening output with after csv blocks.
+func GetTaprootCsvScript(makerPubkey *btcec.PublicKey, csv uint32) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddInt64(int64(csv)).
+		AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+		AddOp(txscript.OP_DROP).
+		AddData(schnorr.SerializePubKey(makerPubkey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// GetTaprootPreimageWitness returns the witness for spending the taproot
+// opening output with the preimage.
+func GetTaprootPreimageWitness(signature, preimage, script, controlBlock []byte) [][]byte {
+	return [][]byte{signature, preimage, script, controlBlock}
+}
+
+// GetTaprootCsvWitness returns the witness for spending the taproot opening
+// output with a passed csv.
+func GetTaprootCsvWitness(signature, script, controlBlock []byte) [][]byte {
+	return [][]byte{signature, script, controlBlock}
+}
+
+// GetTaprootCooperativeWitness returns the witness for spending the taproot
+// opening output on the key path with the MuSig2 signature of maker and
+// taker.
+func GetTaprootCooperativeWitness(signature []byte) [][]byte {
+	return [][]byte{signature}
+}
+
+// TaprootOutput is the taproot opening output of a swap. Its internal key is
+// the MuSig2 aggregate of the maker and the taker key, its script tree has a
+// preimage leaf for the taker and a csv leaf for the maker.
+type TaprootOutput struct {
+	MakerPubkey  *btcec.PublicKey
+	TakerPubkey  *btcec.PublicKey
+	InternalKey  *btcec.PublicKey
+	OutputKey    *btcec.PublicKey
+	PreimageLeaf txscript.TapLeaf
+	CsvLeaf      txscript.TapLeaf
+
+	tree *txscript.IndexedTapScriptTree
+}
+
+// NewTaprootOutput returns the taproot opening output of the swap with the
+// given params.
+func NewTaprootOutput(p *swap.OpeningParams, csv uint32) (*TaprootOutput, error) {
+	takerPubkey, err := parsePubkey(p.TakerPubkey)
+	if err != nil {
+		return nil, err
+	}
+	makerPubkey, err := parsePubkey(p.MakerPubkey)
+	if err != nil {
+		return nil, err
+	}
+	pHash, err := hex.DecodeString(p.ClaimPaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	preimageScript, err := GetTaprootPreimageScript(takerPubkey, pHash)
+	if err != nil {
+		return nil, err
+	}
+	csvScript, err := GetTaprootCsvScript(makerPubkey, csv)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &TaprootOutput{
+		MakerPubkey:  makerPubkey,
+		TakerPubkey:  takerPubkey,
+		PreimageLeaf: txscript.NewBaseTapLeaf(preimageScript),
+		CsvLeaf:      txscript.NewBaseTapLeaf(csvScript),
+	}
+	aggregateKey, _, _, err := musig2.AggregateKeys(o.muSig2Keys(), true)
+	if err != nil {
+		return nil, err
+	}
+	o.InternalKey = aggregateKey.PreTweakedKey
+	o.tree = txscript.AssembleTaprootScriptTree(o.PreimageLeaf, o.CsvLeaf)
+	o.OutputKey = txscript.ComputeTaprootOutputKey(o.InternalKey, o.RootHash())
+	return o, nil
+}
+
+// muSig2Keys returns the keys of maker and taker as they enter the MuSig2
+// key aggregation. The musig2 package works on x-only keys, so the keys are
+// lifted to their even y coordinate first.
+func (o *TaprootOutput) muSig2Keys() []*btcec.PublicKey {
+	keys := make([]*btcec.PublicKey, 0, 2)
+	for _, key := range []*btcec.PublicKey{o.MakerPubkey, o.TakerPubkey} {
+		xOnly, _ := schnorr.ParsePubKey(schnorr.SerializePubKey(key))
+		keys = append(keys, xOnly)
+	}
+	return keys
+}
+
+// RootHash returns the merkle root of the script tree.
+func (o *TaprootOutput) RootHash() []byte {
+	root := o.tree.RootNode.TapHash()
+	return root[:]
+}
+
+// Address returns the P2TR address of the output.
+func (o *TaprootOutput) Address(chain *chaincfg.Params) (*btcutil.AddressTaproot, error) {
+	return btcutil.NewAddressTaproot(schnorr.SerializePubKey(o.OutputKey), chain)
+}
+
+// PkScript returns the P2TR output script.
+func (o *TaprootOutput) PkScript() ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_1).
+		AddData(schnorr.SerializePubKey(o.OutputKey)).
+		Script()
+}
+
+// ControlBlock returns the serialized control block that proves the leaf to
+// be part of the script tree.
+func (o *TaprootOutput) ControlBlock(leaf txscript.TapLeaf) ([]byte, error) {
+	idx, ok := o.tree.LeafProofIndex[leaf.TapHash()]
+	if !ok {
+		return nil, errors.New("leaf is not part of the script tree")
+	}
+	controlBlock := o.tree.LeafMerkleProofs[idx].ToControlBlock(o.InternalKey)
+	return controlBlock.ToBytes()
+}
+
+// CreateTaprootPreimageSpendingTransaction returns the signed transaction
+// that claims the taproot opening output to spendingAddr with the preimage.
+func (b *BitcoinOnChain) CreateTaprootPreimageSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams, spendingAddr string, vout uint32) (*wire.MsgTx, error) {
+	output, err := NewTaprootOutput(swapParams, BitcoinCsv)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := claimParams.Signer.(swap.TaprootSigner)
+	if !ok {
+		return nil, ErrNoTaprootSigner
+	}
+	preimage, err := lightning.MakePreimageFromStr(claimParams.Preimage)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, prevOut, err := b.prepareSpendingTx(claimParams, spendingAddr, vout, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	sigHash, err := tapscriptSigHash(tx, prevOut, output.PreimageLeaf)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.SignSchnorr(sigHash)
+	if err != nil {
+		return nil, err
+	}
+	controlBlock, err := output.ControlBlock(output.PreimageLeaf)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.TxIn[0].Witness = GetTaprootPreimageWitness(sig.Serialize(), preimage[:], output.PreimageLeaf.Script, controlBlock)
+	return tx, nil
+}
+
+// CreateTaprootCsvSpendingTransaction returns the signed transaction that
+// reclaims the taproot opening output to spendingAddr after the csv passed.
+func (b *BitcoinOnChain) CreateTaprootCsvSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams, spendingAddr string, vout uint32) (*wire.MsgTx, error) {
+	output, err := NewTaprootOutput(swapParams, BitcoinCsv)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := claimParams.Signer.(swap.TaprootSigner)
+	if !ok {
+		return nil, ErrNoTaprootSigner
+	}
+
+	tx, prevOut, err := b.prepareSpendingTx(claimParams, spendingAddr, vout, BitcoinCsv, 0)
+	if err != nil {
+		return nil, err
+	}
+	sigHash, err := tapscriptSigHash(tx, prevOut, output.CsvLeaf)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.SignSchnorr(sigHash)
+	if err != nil {
+		return nil, err
+	}
+	controlBlock, err := output.ControlBlock(output.CsvLeaf)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.TxIn[0].Witness = GetTaprootCsvWitness(sig.Serialize(), output.CsvLeaf.Script, controlBlock)
+	return tx, nil
+}
+
+// CreateTaprootCoopSpendingTransaction returns the transaction that spends
+// the taproot opening output to spendingAddr on the key path. The maker
+// holds the key of the taker after a cooperative close, so both MuSig2
+// partial signatures are created locally and no further messages are needed.
+func (b *BitcoinOnChain) CreateTaprootCoopSpendingTransaction(swapParams *swap.OpeningParams, claimParams *swap.ClaimParams, spendingAddr string, vout uint32, fee uint64, takerSigner swap.Signer) (*wire.MsgTx, error) {
+	output, err := NewTaprootOutput(swapParams, BitcoinCsv)
+	if err != nil {
+		return nil, err
+	}
+	maker, ok := claimParams.Signer.(swap.TaprootSigner)
+	if !ok {
+		return nil, ErrNoTaprootSigner
+	}
+	taker, ok := takerSigner.(swap.TaprootSigner)
+	if !ok {
+		return nil, ErrNoTaprootSigner
+	}
+	if !bytes.Equal(maker.PubKey().SerializeCompressed(), output.MakerPubkey.SerializeCompressed()) ||
+		!bytes.Equal(taker.PubKey().SerializeCompressed(), output.TakerPubkey.SerializeCompressed()) {
+		return nil, errors.New("signers do not match the keys of the opening output")
+	}
+
+	tx, prevOut, err := b.prepareSpendingTx(claimParams, spendingAddr, vout, 0, fee)
+	if err != nil {
+		return nil, err
+	}
+	fetcher := txscript.NewCannedPrevOutputFetcher(prevOut.PkScript, prevOut.Value)
+	sigHash, err := txscript.CalcTaprootSignatureHash(txscript.NewTxSigHashes(tx, fetcher), txscript.SigHashDefault, tx, 0, fetcher)
+	if err != nil {
+		return nil, err
+	}
+	var msg [32]byte
+	copy(msg[:], sigHash)
+
+	sig, err := muSig2Sign(output, msg, maker, taker)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.TxIn[0].Witness = GetTaprootCooperativeWitness(sig.Serialize())
+	return tx, nil
+}
+
+// muSig2Sign returns the MuSig2 signature of the signers over msg that is
+// valid for the tweaked output key.
+func muSig2Sign(output *TaprootOutput, msg [32]byte, signers ...swap.TaprootSigner) (*schnorr.Signature, error) {
+	keys := output.muSig2Keys()
+	root := output.RootHash()
+
+	nonces := make([]*musig2.Nonces, len(signers))
+	pubNonces := make([][musig2.PubNonceSize]byte, len(signers))
+	for i, signer := range signers {
+		n, err := signer.MuSig2Nonces()
+		if err != nil {
+			return nil, err
+		}
+		nonces[i] = n
+		pubNonces[i] = n.PubNonce
+	}
+	combinedNonce, err := musig2.AggregateNonces(pubNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	partialSigs := make([]*musig2.PartialSignature, len(signers))
+	for i, signer := range signers {
+		partialSigs[i], err = signer.MuSig2Sign(nonces[i].SecNonce, combinedNonce, keys, msg, musig2.WithSortedKeys(), musig2.WithTaprootSignTweak(root))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sig := musig2.CombineSigs(partialSigs[0].R, partialSigs, musig2.WithTaprootTweakedCombine(msg, keys, root, true))
+	if !sig.Verify(msg[:], output.OutputKey) {
+		return nil, errors.New("invalid musig2 signature")
+	}
+	return sig, nil
+}
+
+// tapscriptSigHash returns the BIP-341 sighash of the first input of tx that
+// spends prevOut with the script of leaf.
+func tapscriptSigHash(tx *wire.MsgTx, prevOut *wire.TxOut, leaf txscript.TapLeaf) ([]byte, error) {
+	fetcher := txscript.NewCannedPrevOutputFetcher(prevOut.PkScript, prevOut.Value)
+	return txscript.CalcTapscriptSignaturehash(txscript.NewTxSigHashes(tx, fetcher), txscript.SigHashDefault, tx, 0, fetcher, leaf)
+}
+
+func parsePubkey(pubkeyHex string) (*btcec.PublicKey, error) {
+	pubkeyBytes, err := hex.DecodeString(pubkeyHex)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := btcec.ParsePubKey(pubkeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pubkey %s: %w", pubkeyHex, err)
+	}
+	return pubkey, nil
+}
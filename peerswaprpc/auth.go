@@ -0,0 +1,263 @@
+package peerswaprpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// Scope is a permission on the rpc interface that a token grants.
+type Scope string
+
+const (
+	// ScopeReadonly allows to read swaps, peers and the policy.
+	ScopeReadonly Scope = "readonly"
+	// ScopeSwap allows to start swaps and to change running swaps.
+	ScopeSwap Scope = "swap"
+	// ScopeWallet allows to receive and to send funds of the wallets.
+	ScopeWallet Scope = "wallet"
+	// ScopeAdmin allows every call, including changes of the policy and the
+	// shutdown of the daemon.
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes are the scopes that a token file is written for.
+var Scopes = []Scope{ScopeReadonly, ScopeSwap, ScopeWallet, ScopeAdmin}
+
+// tokenScopes are the scopes that the token of a scope grants. The swap and
+// the wallet tokens can also read.
+var tokenScopes = map[Scope][]Scope{
+	ScopeReadonly: {ScopeReadonly},
+	ScopeSwap:     {ScopeReadonly, ScopeSwap},
+	ScopeWallet:   {ScopeReadonly, ScopeWallet},
+	ScopeAdmin:    {ScopeAdmin},
+}
+
+// methodScopes maps every rpc to the scope that it requires. A call of a
+// method that is not listed is denied.
+var methodScopes = map[string]Scope{
+	"/peerswap.PeerSwap/SwapOut":             ScopeSwap,
+	"/peerswap.PeerSwap/BatchSwapOut":        ScopeSwap,
+	"/peerswap.PeerSwap/SwapIn":              ScopeSwap,
+	"/peerswap.PeerSwap/QuoteSwap":           ScopeReadonly,
+	"/peerswap.PeerSwap/GetSwap":             ScopeReadonly,
+	"/peerswap.PeerSwap/ListSwaps":           ScopeReadonly,
+	"/peerswap.PeerSwap/ListPeers":           ScopeReadonly,
+	"/peerswap.PeerSwap/GetPeerReputation":   ScopeReadonly,
+	"/peerswap.PeerSwap/GetPeerStats":        ScopeReadonly,
+	"/peerswap.PeerSwap/ListRequestedSwaps":  ScopeReadonly,
+	"/peerswap.PeerSwap/ListActiveSwaps":     ScopeReadonly,
+	"/peerswap.PeerSwap/BumpSwapFee":         ScopeSwap,
+	"/peerswap.PeerSwap/CancelSwap":          ScopeSwap,
+	"/peerswap.PeerSwap/SubscribeSwapEvents": ScopeReadonly,
+	"/peerswap.PeerSwap/ExportSwaps":         ScopeReadonly,
+	"/peerswap.PeerSwap/ArchiveSwaps":        ScopeSwap,
+	"/peerswap.PeerSwap/ListArchivedSwaps":   ScopeReadonly,
+	"/peerswap.PeerSwap/AllowSwapRequests":   ScopeAdmin,
+	"/peerswap.PeerSwap/ReloadPolicyFile":    ScopeAdmin,
+	"/peerswap.PeerSwap/AddPeer":             ScopeAdmin,
+	"/peerswap.PeerSwap/RemovePeer":          ScopeAdmin,
+	"/peerswap.PeerSwap/AddSusPeer":          ScopeAdmin,
+	"/peerswap.PeerSwap/RemoveSusPeer":       ScopeAdmin,
+	"/peerswap.PeerSwap/EvaluatePolicy":      ScopeReadonly,
+	"/peerswap.PeerSwap/LiquidGetAddress":    ScopeWallet,
+	"/peerswap.PeerSwap/LiquidGetBalance":    ScopeReadonly,
+	"/peerswap.PeerSwap/LiquidSendToAddress": ScopeWallet,
+	"/peerswap.PeerSwap/BtcGetAddress":       ScopeWallet,
+	"/peerswap.PeerSwap/BtcGetBalance":       ScopeReadonly,
+	"/peerswap.PeerSwap/BtcSendToAddress":    ScopeWallet,
+	"/peerswap.PeerSwap/Stop":                ScopeAdmin,
+}
+
+const (
+	// RootKeyFile is the name of the file that holds the key that the
+	// tokens are signed with. Removing it revokes all tokens, new ones are
+	// written on the next start.
+	RootKeyFile = "macaroons.key"
+
+	tokenLocation     = "peerswap"
+	scopeCaveatPrefix = "scopes="
+	authorizationKey  = "authorization"
+	bearerPrefix      = "Bearer "
+)
+
+// TokenFile returns the path of the token file of the scope in dir.
+func TokenFile(dir string, scope Scope) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.macaroon", scope))
+}
+
+// Authenticator checks the bearer tokens of the rpc calls. A token is a
+// macaroon that carries the scopes it grants as a caveat. Holders of a token
+// can attenuate it by adding further scope caveats, a call is allowed if
+// every caveat grants its scope.
+type Authenticator struct {
+	rootKey []byte
+}
+
+// NewAuthenticator loads the root key from dir and writes a token file for
+// every scope that has none. If there is no root key a new one is generated
+// and all token files are rewritten.
+func NewAuthenticator(dir string) (*Authenticator, error) {
+	rootKeyPath := filepath.Join(dir, RootKeyFile)
+	rootKey, err := os.ReadFile(rootKeyPath)
+	if errors.Is(err, os.ErrNotExist) {
+		rootKey = make([]byte, 32)
+		if _, err = rand.Read(rootKey); err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(rootKeyPath, rootKey, 0600); err != nil {
+			return nil, err
+		}
+		// Tokens of an old root key are of no use.
+		for _, scope := range Scopes {
+			err = os.Remove(TokenFile(dir, scope))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if len(rootKey) != 32 {
+		return nil, fmt.Errorf("invalid root key in %s", rootKeyPath)
+	}
+
+	a := &Authenticator{rootKey: rootKey}
+	for _, scope := range Scopes {
+		path := TokenFile(dir, scope)
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+		token, err := a.NewToken(tokenScopes[scope]...)
+		if err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(path, token, 0600); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// NewToken returns a serialized token that grants the scopes.
+func (a *Authenticator) NewToken(scopes ...Scope) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	mac, err := macaroon.New(a.rootKey, id, tokenLocation, macaroon.LatestVersion)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, scope := range scopes {
+		names = append(names, string(scope))
+	}
+	err = mac.AddFirstPartyCaveat([]byte(scopeCaveatPrefix + strings.Join(names, ",")))
+	if err != nil {
+		return nil, err
+	}
+	return mac.MarshalBinary()
+}
+
+// Authorize returns an error if the token does not grant the scope that the
+// method requires.
+func (a *Authenticator) Authorize(token []byte, method string) error {
+	required, ok := methodScopes[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(token); err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	var denied bool
+	err := mac.Verify(a.rootKey, func(caveat string) error {
+		if !strings.HasPrefix(caveat, scopeCaveatPrefix) {
+			return fmt.Errorf("unknown caveat %s", caveat)
+		}
+		for _, scope := range strings.Split(strings.TrimPrefix(caveat, scopeCaveatPrefix), ",") {
+			if Scope(scope) == required || Scope(scope) == ScopeAdmin {
+				return nil
+			}
+		}
+		denied = true
+		return fmt.Errorf("scope %s is not granted", required)
+	}, nil)
+	if denied {
+		return status.Errorf(codes.PermissionDenied, "token does not grant the %s scope", required)
+	}
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+// authorizeContext checks the bearer token in the metadata of the call.
+func (a *Authenticator) authorizeContext(ctx context.Context, method string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) != 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token, err := hex.DecodeString(strings.TrimPrefix(values[0], bearerPrefix))
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return a.Authorize(token, method)
+}
+
+// UnaryServerInterceptor denies unary calls without a sufficient token.
+func (a *Authenticator) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorizeContext(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor denies streaming calls without a sufficient token.
+func (a *Authenticator) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorizeContext(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// TokenCredential sends a token as bearer token with every call.
+type TokenCredential string
+
+// NewTokenCredentialFromFile reads the token file at path.
+func NewTokenCredentialFromFile(path string) (TokenCredential, error) {
+	token, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read token file: %w", err)
+	}
+	return TokenCredential(hex.EncodeToString(token)), nil
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (t TokenCredential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: bearerPrefix + string(t)}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials. The
+// token is only sent over tls.
+func (t TokenCredential) RequireTransportSecurity() bool {
+	return true
+}
+
+var _ credentials.PerRPCCredentials = TokenCredential("")
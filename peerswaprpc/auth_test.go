@@ -0,0 +1,142 @@
+package peerswaprpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+func Test_MethodScopes(t *testing.T) {
+	methods := map[string]bool{}
+	for _, m := range PeerSwap_ServiceDesc.Methods {
+		methods["/peerswap.PeerSwap/"+m.MethodName] = true
+	}
+	for _, s := range PeerSwap_ServiceDesc.Streams {
+		methods["/peerswap.PeerSwap/"+s.StreamName] = true
+	}
+
+	// Every rpc needs a scope, otherwise it can not be called.
+	for method := range methods {
+		assert.Contains(t, methodScopes, method)
+	}
+	for method := range methodScopes {
+		assert.Contains(t, methods, method)
+	}
+}
+
+func Test_Authenticator(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewAuthenticator(dir)
+	require.NoError(t, err)
+
+	token := func(scope Scope) []byte {
+		b, err := os.ReadFile(TokenFile(dir, scope))
+		require.NoError(t, err)
+		return b
+	}
+	code := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	assert.NoError(t, a.Authorize(token(ScopeReadonly), "/peerswap.PeerSwap/ListSwaps"))
+	assert.Equal(t, codes.PermissionDenied, code(a.Authorize(token(ScopeReadonly), "/peerswap.PeerSwap/SwapOut")))
+	assert.NoError(t, a.Authorize(token(ScopeSwap), "/peerswap.PeerSwap/SwapOut"))
+	assert.NoError(t, a.Authorize(token(ScopeSwap), "/peerswap.PeerSwap/ListSwaps"))
+	assert.Equal(t, codes.PermissionDenied, code(a.Authorize(token(ScopeSwap), "/peerswap.PeerSwap/LiquidSendToAddress")))
+	assert.NoError(t, a.Authorize(token(ScopeWallet), "/peerswap.PeerSwap/LiquidSendToAddress"))
+	assert.Equal(t, codes.PermissionDenied, code(a.Authorize(token(ScopeWallet), "/peerswap.PeerSwap/Stop")))
+	assert.NoError(t, a.Authorize(token(ScopeAdmin), "/peerswap.PeerSwap/Stop"))
+	assert.Equal(t, codes.PermissionDenied, code(a.Authorize(token(ScopeAdmin), "/peerswap.PeerSwap/Unknown")))
+	assert.Equal(t, codes.Unauthenticated, code(a.Authorize([]byte("invalid"), "/peerswap.PeerSwap/ListSwaps")))
+
+	// An attenuated admin token only grants the scopes of all caveats.
+	mac := &macaroon.Macaroon{}
+	require.NoError(t, mac.UnmarshalBinary(token(ScopeAdmin)))
+	require.NoError(t, mac.AddFirstPartyCaveat([]byte("scopes=readonly")))
+	attenuated, err := mac.MarshalBinary()
+	require.NoError(t, err)
+	assert.NoError(t, a.Authorize(attenuated, "/peerswap.PeerSwap/ListSwaps"))
+	assert.Equal(t, codes.PermissionDenied, code(a.Authorize(attenuated, "/peerswap.PeerSwap/Stop")))
+
+	// The tokens stay valid on a restart.
+	a, err = NewAuthenticator(dir)
+	require.NoError(t, err)
+	admin := token(ScopeAdmin)
+	assert.NoError(t, a.Authorize(admin, "/peerswap.PeerSwap/Stop"))
+
+	// Removing the root key revokes the tokens.
+	require.NoError(t, os.Remove(filepath.Join(dir, RootKeyFile)))
+	a, err = NewAuthenticator(dir)
+	require.NoError(t, err)
+	assert.Equal(t, codes.Unauthenticated, code(a.Authorize(admin, "/peerswap.PeerSwap/Stop")))
+	assert.NoError(t, a.Authorize(token(ScopeAdmin), "/peerswap.PeerSwap/Stop"))
+}
+
+type testPeerSwapServer struct {
+	UnimplementedPeerSwapServer
+}
+
+func (s *testPeerSwapServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
+	return &ListSwapsResponse{}, nil
+}
+
+func Test_AuthenticatedServer(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "tls.cert")
+	keyPath := filepath.Join(dir, "tls.key")
+	cert, err := LoadTLSCertificate(certPath, keyPath, nil)
+	require.NoError(t, err)
+	a, err := NewAuthenticator(dir)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
+		grpc.UnaryInterceptor(a.UnaryServerInterceptor),
+		grpc.StreamInterceptor(a.StreamServerInterceptor),
+	)
+	RegisterPeerSwapServer(srv, &testPeerSwapServer{})
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	// The certificate is loaded again on a restart.
+	reloaded, err := LoadTLSCertificate(certPath, keyPath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cert.Certificate, reloaded.Certificate)
+
+	creds, err := credentials.NewClientTLSFromFile(certPath, "")
+	require.NoError(t, err)
+	client := func(opts ...grpc.DialOption) PeerSwapClient {
+		conn, err := grpc.Dial(lis.Addr().String(), append(opts, grpc.WithTransportCredentials(creds))...)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return NewPeerSwapClient(conn)
+	}
+
+	_, err = client().ListSwaps(context.Background(), &ListSwapsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	readonly, err := NewTokenCredentialFromFile(TokenFile(dir, ScopeReadonly))
+	require.NoError(t, err)
+	c := client(grpc.WithPerRPCCredentials(readonly))
+	_, err = c.ListSwaps(context.Background(), &ListSwapsRequest{})
+	assert.NoError(t, err)
+	_, err = c.Stop(context.Background(), &Empty{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	stream, err := c.SubscribeSwapEvents(context.Background(), &SubscribeSwapEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -2,17 +2,72 @@ package peerswaprpc
 
 import (
 	"github.com/elementsproject/peerswap/policy"
+	"github.com/elementsproject/peerswap/swap"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func GetPolicyMessage(p policy.Policy) *Policy {
+	var peers []*PeerPolicy
+	for _, pp := range p.Peers {
+		peers = append(peers, GetPeerPolicyMessage(pp))
+	}
 	return &Policy{
-		ReserveOnchainMsat: p.ReserveOnchainMsat,
-		MinSwapAmountMsat:  p.MinSwapAmountMsat,
-		AcceptAllPeers:     p.AcceptAllPeers,
-		AllowNewSwaps:      p.AllowNewSwaps,
-		AllowlistedPeers:   p.PeerAllowlist,
-		SuspiciousPeerList: p.SuspiciousPeerList,
+		ReserveOnchainMsat:  p.ReserveOnchainMsat,
+		MinSwapAmountMsat:   p.MinSwapAmountMsat,
+		AcceptAllPeers:      p.AcceptAllPeers,
+		AllowNewSwaps:       p.AllowNewSwaps,
+		AllowlistedPeers:    p.PeerAllowlist,
+		SuspiciousPeerList:  p.SuspiciousPeerList,
+		BtcPremiumRatePpm:   p.BtcPremiumRatePpm,
+		BtcPremiumFixedSat:  p.BtcPremiumFixedSat,
+		LbtcPremiumRatePpm:  p.LbtcPremiumRatePpm,
+		LbtcPremiumFixedSat: p.LbtcPremiumFixedSat,
+		Peers:               peers,
+
+		MaxConcurrentSwaps: p.MaxConcurrentSwaps,
+		MaxSwapsPerHour:    p.MaxSwapsPerHour,
+		MaxSwapsPerDay:     p.MaxSwapsPerDay,
+		BtcMaxSatPerDay:    p.BtcMaxSatPerDay,
+		LbtcMaxSatPerDay:   p.LbtcMaxSatPerDay,
+
+		PeerMaxConcurrentSwaps: p.PeerMaxConcurrentSwaps,
+		PeerMaxSwapsPerHour:    p.PeerMaxSwapsPerHour,
+		PeerMaxSwapsPerDay:     p.PeerMaxSwapsPerDay,
+		PeerBtcMaxSatPerDay:    p.PeerBtcMaxSatPerDay,
+		PeerLbtcMaxSatPerDay:   p.PeerLbtcMaxSatPerDay,
+
+		ReputationWindowDays:            p.ReputationWindowDays,
+		ReputationLimitScore:            p.ReputationLimitScore,
+		ReputationLimitedMaxSwapsPerDay: p.ReputationLimitedMaxSwapsPerDay,
+		ReputationSuspiciousScore:       p.ReputationSuspiciousScore,
+
+		BtcReserveOnchainMsat:  p.BtcReserveOnchainMsat,
+		LbtcReserveOnchainMsat: p.LbtcReserveOnchainMsat,
+		ExcludedScids:          p.ExcludedScids,
+	}
+}
+
+// GetPeerPolicyMessage returns the message of the overrides of a peer, nil if
+// the peer has none.
+func GetPeerPolicyMessage(p *policy.PeerPolicy) *PeerPolicy {
+	if p == nil {
+		return nil
+	}
+	return &PeerPolicy{
+		Pubkey:              p.Pubkey,
+		AllowedAssets:       p.AllowedAssets,
+		AllowedSwapTypes:    p.AllowedSwapTypes,
+		MinSwapAmountMsat:   p.MinSwapAmountMsat,
+		MaxSwapAmountMsat:   p.MaxSwapAmountMsat,
+		BtcPremiumRatePpm:   p.BtcPremiumRatePpm,
+		BtcPremiumFixedSat:  p.BtcPremiumFixedSat,
+		LbtcPremiumRatePpm:  p.LbtcPremiumRatePpm,
+		LbtcPremiumFixedSat: p.LbtcPremiumFixedSat,
+		MaxConcurrentSwaps:  p.MaxConcurrentSwaps,
+		MaxSwapsPerHour:     p.MaxSwapsPerHour,
+		MaxSwapsPerDay:      p.MaxSwapsPerDay,
+		BtcMaxSatPerDay:     p.BtcMaxSatPerDay,
+		LbtcMaxSatPerDay:    p.LbtcMaxSatPerDay,
 	}
 }
 
@@ -27,3 +82,17 @@ func (p *Policy) MarshalJSON() ([]byte, error) {
 		Resolver:        nil,
 	}.Marshal(p)
 }
+
+// getPolicyCheckMessages returns the messages of the results of checks.
+func getPolicyCheckMessages(checks []*swap.PolicyCheck) []*PolicyCheck {
+	var res []*PolicyCheck
+	for _, c := range checks {
+		res = append(res, &PolicyCheck{
+			Name:    c.Name,
+			Passed:  c.Passed,
+			Skipped: c.Skipped,
+			Reason:  c.Reason,
+		})
+	}
+	return res
+}
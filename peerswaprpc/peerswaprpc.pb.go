@@ -63,7 +63,7 @@ func (x RequestedSwap_SwapType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RequestedSwap_SwapType.Descriptor instead.
 func (RequestedSwap_SwapType) EnumDescriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{21, 0}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type GetAddressRequest struct {
@@ -236,6 +236,73 @@ func (x *GetBalanceResponse) GetSatAmount() uint64 {
 	return 0
 }
 
+// BtcGetBalanceResponse is the balance of the bitcoin wallet that funds the
+// swaps.
+type BtcGetBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfirmedSatAmount   uint64 `protobuf:"varint,1,opt,name=confirmed_sat_amount,json=confirmedSatAmount,proto3" json:"confirmed_sat_amount,omitempty"`
+	UnconfirmedSatAmount uint64 `protobuf:"varint,2,opt,name=unconfirmed_sat_amount,json=unconfirmedSatAmount,proto3" json:"unconfirmed_sat_amount,omitempty"`
+	// reserved_sat_amount is the part of the confirmed balance that pending
+	// swaps will spend on their opening transactions.
+	ReservedSatAmount uint64 `protobuf:"varint,3,opt,name=reserved_sat_amount,json=reservedSatAmount,proto3" json:"reserved_sat_amount,omitempty"`
+}
+
+func (x *BtcGetBalanceResponse) Reset() {
+	*x = BtcGetBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BtcGetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BtcGetBalanceResponse) ProtoMessage() {}
+
+func (x *BtcGetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BtcGetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*BtcGetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BtcGetBalanceResponse) GetConfirmedSatAmount() uint64 {
+	if x != nil {
+		return x.ConfirmedSatAmount
+	}
+	return 0
+}
+
+func (x *BtcGetBalanceResponse) GetUnconfirmedSatAmount() uint64 {
+	if x != nil {
+		return x.UnconfirmedSatAmount
+	}
+	return 0
+}
+
+func (x *BtcGetBalanceResponse) GetReservedSatAmount() uint64 {
+	if x != nil {
+		return x.ReservedSatAmount
+	}
+	return 0
+}
+
 type SendToAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -248,7 +315,7 @@ type SendToAddressRequest struct {
 func (x *SendToAddressRequest) Reset() {
 	*x = SendToAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[4]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -261,7 +328,7 @@ func (x *SendToAddressRequest) String() string {
 func (*SendToAddressRequest) ProtoMessage() {}
 
 func (x *SendToAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[4]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +341,7 @@ func (x *SendToAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendToAddressRequest.ProtoReflect.Descriptor instead.
 func (*SendToAddressRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{4}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SendToAddressRequest) GetAddress() string {
@@ -302,7 +369,7 @@ type SendToAddressResponse struct {
 func (x *SendToAddressResponse) Reset() {
 	*x = SendToAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[5]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +382,7 @@ func (x *SendToAddressResponse) String() string {
 func (*SendToAddressResponse) ProtoMessage() {}
 
 func (x *SendToAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[5]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +395,7 @@ func (x *SendToAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendToAddressResponse.ProtoReflect.Descriptor instead.
 func (*SendToAddressResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{5}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SendToAddressResponse) GetTxId() string {
@@ -347,12 +414,14 @@ type SwapOutRequest struct {
 	SwapAmount uint64 `protobuf:"varint,2,opt,name=swap_amount,json=swapAmount,proto3" json:"swap_amount,omitempty"`
 	Asset      string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
 	Force      bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// max_premium is the highest premium in sat that we accept to pay.
+	MaxPremium uint64 `protobuf:"varint,5,opt,name=max_premium,json=maxPremium,proto3" json:"max_premium,omitempty"`
 }
 
 func (x *SwapOutRequest) Reset() {
 	*x = SwapOutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[6]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -365,7 +434,7 @@ func (x *SwapOutRequest) String() string {
 func (*SwapOutRequest) ProtoMessage() {}
 
 func (x *SwapOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[6]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +447,7 @@ func (x *SwapOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapOutRequest.ProtoReflect.Descriptor instead.
 func (*SwapOutRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{6}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SwapOutRequest) GetChannelId() uint64 {
@@ -409,6 +478,13 @@ func (x *SwapOutRequest) GetForce() bool {
 	return false
 }
 
+func (x *SwapOutRequest) GetMaxPremium() uint64 {
+	if x != nil {
+		return x.MaxPremium
+	}
+	return 0
+}
+
 type SwapOutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -420,7 +496,7 @@ type SwapOutResponse struct {
 func (x *SwapOutResponse) Reset() {
 	*x = SwapOutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[7]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -433,7 +509,7 @@ func (x *SwapOutResponse) String() string {
 func (*SwapOutResponse) ProtoMessage() {}
 
 func (x *SwapOutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[7]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +522,7 @@ func (x *SwapOutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapOutResponse.ProtoReflect.Descriptor instead.
 func (*SwapOutResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{7}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SwapOutResponse) GetSwap() *PrettyPrintSwap {
@@ -456,6 +532,182 @@ func (x *SwapOutResponse) GetSwap() *PrettyPrintSwap {
 	return nil
 }
 
+type BatchSwapOutEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId  uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	SwapAmount uint64 `protobuf:"varint,2,opt,name=swap_amount,json=swapAmount,proto3" json:"swap_amount,omitempty"`
+}
+
+func (x *BatchSwapOutEntry) Reset() {
+	*x = BatchSwapOutEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSwapOutEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSwapOutEntry) ProtoMessage() {}
+
+func (x *BatchSwapOutEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSwapOutEntry.ProtoReflect.Descriptor instead.
+func (*BatchSwapOutEntry) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchSwapOutEntry) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *BatchSwapOutEntry) GetSwapAmount() uint64 {
+	if x != nil {
+		return x.SwapAmount
+	}
+	return 0
+}
+
+// BatchSwapOutRequest starts a swap-out for every entry. Swap-outs with the
+// same peer are funded by the peer in a single opening transaction.
+type BatchSwapOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*BatchSwapOutEntry `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	Asset string               `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Force bool                 `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// max_premium is the highest premium in sat that we accept to pay per swap.
+	MaxPremium uint64 `protobuf:"varint,4,opt,name=max_premium,json=maxPremium,proto3" json:"max_premium,omitempty"`
+}
+
+func (x *BatchSwapOutRequest) Reset() {
+	*x = BatchSwapOutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSwapOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSwapOutRequest) ProtoMessage() {}
+
+func (x *BatchSwapOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSwapOutRequest.ProtoReflect.Descriptor instead.
+func (*BatchSwapOutRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchSwapOutRequest) GetSwaps() []*BatchSwapOutEntry {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *BatchSwapOutRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *BatchSwapOutRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *BatchSwapOutRequest) GetMaxPremium() uint64 {
+	if x != nil {
+		return x.MaxPremium
+	}
+	return 0
+}
+
+type BatchSwapOutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*PrettyPrintSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *BatchSwapOutResponse) Reset() {
+	*x = BatchSwapOutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSwapOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSwapOutResponse) ProtoMessage() {}
+
+func (x *BatchSwapOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSwapOutResponse.ProtoReflect.Descriptor instead.
+func (*BatchSwapOutResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchSwapOutResponse) GetSwaps() []*PrettyPrintSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
 type SwapInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -465,12 +717,14 @@ type SwapInRequest struct {
 	SwapAmount uint64 `protobuf:"varint,2,opt,name=swap_amount,json=swapAmount,proto3" json:"swap_amount,omitempty"`
 	Asset      string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
 	Force      bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// max_premium is the highest premium in sat that we accept to pay.
+	MaxPremium uint64 `protobuf:"varint,5,opt,name=max_premium,json=maxPremium,proto3" json:"max_premium,omitempty"`
 }
 
 func (x *SwapInRequest) Reset() {
 	*x = SwapInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[8]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +737,7 @@ func (x *SwapInRequest) String() string {
 func (*SwapInRequest) ProtoMessage() {}
 
 func (x *SwapInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[8]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +750,7 @@ func (x *SwapInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapInRequest.ProtoReflect.Descriptor instead.
 func (*SwapInRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{8}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SwapInRequest) GetChannelId() uint64 {
@@ -527,6 +781,13 @@ func (x *SwapInRequest) GetForce() bool {
 	return false
 }
 
+func (x *SwapInRequest) GetMaxPremium() uint64 {
+	if x != nil {
+		return x.MaxPremium
+	}
+	return 0
+}
+
 type SwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -538,7 +799,7 @@ type SwapResponse struct {
 func (x *SwapResponse) Reset() {
 	*x = SwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[9]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +812,7 @@ func (x *SwapResponse) String() string {
 func (*SwapResponse) ProtoMessage() {}
 
 func (x *SwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[9]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +825,7 @@ func (x *SwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
 func (*SwapResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{9}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SwapResponse) GetSwap() *PrettyPrintSwap {
@@ -585,7 +846,7 @@ type GetSwapRequest struct {
 func (x *GetSwapRequest) Reset() {
 	*x = GetSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[10]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -598,7 +859,7 @@ func (x *GetSwapRequest) String() string {
 func (*GetSwapRequest) ProtoMessage() {}
 
 func (x *GetSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[10]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +872,7 @@ func (x *GetSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSwapRequest.ProtoReflect.Descriptor instead.
 func (*GetSwapRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{10}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSwapRequest) GetSwapId() string {
@@ -621,29 +882,34 @@ func (x *GetSwapRequest) GetSwapId() string {
 	return ""
 }
 
-type ListSwapsRequest struct {
+// CancelSwapRequest cancels a swap that we started while it waits for the
+// agreement of the peer. The reason is sent to the peer.
+type CancelSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SwapId string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
+func (x *CancelSwapRequest) Reset() {
+	*x = CancelSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[11]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsRequest) String() string {
+func (x *CancelSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
+func (*CancelSwapRequest) ProtoMessage() {}
 
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[11]
+func (x *CancelSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,36 +920,54 @@ func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CancelSwapRequest.ProtoReflect.Descriptor instead.
+func (*CancelSwapRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{15}
 }
 
-type ListSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Swaps []*PrettyPrintSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+func (x *CancelSwapRequest) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
 }
 
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
+func (x *CancelSwapRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BumpSwapFeeRequest raises the fee of the unconfirmed opening or claim
+// transaction of a swap. Only one of target_conf and sat_per_vbyte can be set.
+type BumpSwapFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapId      string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	TargetConf  uint32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	SatPerVbyte uint64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *BumpSwapFeeRequest) Reset() {
+	*x = BumpSwapFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[12]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsResponse) String() string {
+func (x *BumpSwapFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsResponse) ProtoMessage() {}
+func (*BumpSwapFeeRequest) ProtoMessage() {}
 
-func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[12]
+func (x *BumpSwapFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,41 +978,64 @@ func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BumpSwapFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpSwapFeeRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListSwapsResponse) GetSwaps() []*PrettyPrintSwap {
+func (x *BumpSwapFeeRequest) GetSwapId() string {
 	if x != nil {
-		return x.Swaps
+		return x.SwapId
 	}
-	return nil
+	return ""
 }
 
-type ListPeersRequest struct {
+func (x *BumpSwapFeeRequest) GetTargetConf() uint32 {
+	if x != nil {
+		return x.TargetConf
+	}
+	return 0
+}
+
+func (x *BumpSwapFeeRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+type BumpSwapFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SwapId string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	// tx is the bumped transaction: opening or claim.
+	Tx string `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	// method is cpfp for the opening and rbf for the claim transaction.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// txid is the txid of the child or the replacement transaction.
+	Txid        string `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	SatPerVbyte uint64 `protobuf:"varint,5,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *ListPeersRequest) Reset() {
-	*x = ListPeersRequest{}
+func (x *BumpSwapFeeResponse) Reset() {
+	*x = BumpSwapFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[13]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPeersRequest) String() string {
+func (x *BumpSwapFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPeersRequest) ProtoMessage() {}
+func (*BumpSwapFeeResponse) ProtoMessage() {}
 
-func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[13]
+func (x *BumpSwapFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,36 +1046,75 @@ func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPeersRequest.ProtoReflect.Descriptor instead.
-func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BumpSwapFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpSwapFeeResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{17}
 }
 
-type ListPeersResponse struct {
+func (x *BumpSwapFeeResponse) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *BumpSwapFeeResponse) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *BumpSwapFeeResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BumpSwapFeeResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BumpSwapFeeResponse) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+// SubscribeSwapEventsRequest subscribes to the state changes of all swaps.
+type SubscribeSwapEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*PeerSwapPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// replay_since replays the events after this unix timestamp in
+	// nanoseconds. It is set to the timestamp of the last received event to
+	// resume a subscription. 0 skips the replay.
+	ReplaySince int64 `protobuf:"varint,1,opt,name=replay_since,json=replaySince,proto3" json:"replay_since,omitempty"`
 }
 
-func (x *ListPeersResponse) Reset() {
-	*x = ListPeersResponse{}
+func (x *SubscribeSwapEventsRequest) Reset() {
+	*x = SubscribeSwapEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[14]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPeersResponse) String() string {
+func (x *SubscribeSwapEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPeersResponse) ProtoMessage() {}
+func (*SubscribeSwapEventsRequest) ProtoMessage() {}
 
-func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[14]
+func (x *SubscribeSwapEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,41 +1125,55 @@ func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPeersResponse.ProtoReflect.Descriptor instead.
-func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SubscribeSwapEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSwapEventsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListPeersResponse) GetPeers() []*PeerSwapPeer {
+func (x *SubscribeSwapEventsRequest) GetReplaySince() int64 {
 	if x != nil {
-		return x.Peers
+		return x.ReplaySince
 	}
-	return nil
+	return 0
 }
 
-type ReloadPolicyFileRequest struct {
+type SwapEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ReloadPolicyFileRequest) Reset() {
-	*x = ReloadPolicyFileRequest{}
+	// timestamp is the time of the state change as unix nanoseconds.
+	Timestamp   int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SwapId      string `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	PeerNodeId  string `protobuf:"bytes,3,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	Type        string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Role        string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Asset       string `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount      uint64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	OldState    string `protobuf:"bytes,8,opt,name=old_state,json=oldState,proto3" json:"old_state,omitempty"`
+	NewState    string `protobuf:"bytes,9,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	OpeningTxId string `protobuf:"bytes,10,opt,name=opening_tx_id,json=openingTxId,proto3" json:"opening_tx_id,omitempty"`
+	ClaimTxId   string `protobuf:"bytes,11,opt,name=claim_tx_id,json=claimTxId,proto3" json:"claim_tx_id,omitempty"`
+	Error       string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[15]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReloadPolicyFileRequest) String() string {
+func (x *SwapEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReloadPolicyFileRequest) ProtoMessage() {}
+func (*SwapEvent) ProtoMessage() {}
 
-func (x *ReloadPolicyFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[15]
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,36 +1184,128 @@ func (x *ReloadPolicyFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReloadPolicyFileRequest.ProtoReflect.Descriptor instead.
-func (*ReloadPolicyFileRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{19}
 }
 
-type AddPeerRequest struct {
+func (x *SwapEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetOldState() string {
+	if x != nil {
+		return x.OldState
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetNewState() string {
+	if x != nil {
+		return x.NewState
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetOpeningTxId() string {
+	if x != nil {
+		return x.OpeningTxId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetClaimTxId() string {
+	if x != nil {
+		return x.ClaimTxId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ExportSwapsRequest exports the finished swaps for accounting.
+type ExportSwapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	// start_time and end_time select the swaps that were created in
+	// [start_time, end_time) as unix timestamps in seconds. 0 does not filter.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// peer_node_id selects the swaps with this peer.
+	PeerNodeId string `protobuf:"bytes,3,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	// format is json (default) or csv. For csv the rows are returned in csv.
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (x *AddPeerRequest) Reset() {
-	*x = AddPeerRequest{}
+func (x *ExportSwapsRequest) Reset() {
+	*x = ExportSwapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[16]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerRequest) String() string {
+func (x *ExportSwapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerRequest) ProtoMessage() {}
+func (*ExportSwapsRequest) ProtoMessage() {}
 
-func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[16]
+func (x *ExportSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,43 +1316,65 @@ func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
-func (*AddPeerRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExportSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AddPeerRequest) GetPeerPubkey() string {
+func (x *ExportSwapsRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.PeerPubkey
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportSwapsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ExportSwapsRequest) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
 	}
 	return ""
 }
 
-type RemovePeerRequest struct {
+func (x *ExportSwapsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	Swaps []*SwapExport `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	Csv   string        `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
 }
 
-func (x *RemovePeerRequest) Reset() {
-	*x = RemovePeerRequest{}
+func (x *ExportSwapsResponse) Reset() {
+	*x = ExportSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[17]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemovePeerRequest) String() string {
+func (x *ExportSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePeerRequest) ProtoMessage() {}
+func (*ExportSwapsResponse) ProtoMessage() {}
 
-func (x *RemovePeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[17]
+func (x *ExportSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,41 +1385,81 @@ func (x *RemovePeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePeerRequest.ProtoReflect.Descriptor instead.
-func (*RemovePeerRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExportSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ExportSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RemovePeerRequest) GetPeerPubkey() string {
+func (x *ExportSwapsResponse) GetSwaps() []*SwapExport {
 	if x != nil {
-		return x.PeerPubkey
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *ExportSwapsResponse) GetCsv() string {
+	if x != nil {
+		return x.Csv
 	}
 	return ""
 }
 
-type ListRequestedSwapsRequest struct {
+// SwapExport holds the amounts of a swap in sat and msat. net is the gain
+// (positive) or the cost (negative) of the swap for this node.
+type SwapExport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ListRequestedSwapsRequest) Reset() {
-	*x = ListRequestedSwapsRequest{}
+	SwapId                  string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	CreatedAt               int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt              int64  `protobuf:"varint,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Type                    string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Role                    string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Asset                   string `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
+	State                   string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	PeerNodeId              string `protobuf:"bytes,8,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	InitiatorNodeId         string `protobuf:"bytes,9,opt,name=initiator_node_id,json=initiatorNodeId,proto3" json:"initiator_node_id,omitempty"`
+	ChannelId               string `protobuf:"bytes,10,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	AmountSat               uint64 `protobuf:"varint,11,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	AmountMsat              uint64 `protobuf:"varint,12,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	PremiumSat              uint64 `protobuf:"varint,13,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	PremiumMsat             uint64 `protobuf:"varint,14,opt,name=premium_msat,json=premiumMsat,proto3" json:"premium_msat,omitempty"`
+	FeeInvoicePaymentHash   string `protobuf:"bytes,15,opt,name=fee_invoice_payment_hash,json=feeInvoicePaymentHash,proto3" json:"fee_invoice_payment_hash,omitempty"`
+	FeeInvoiceSat           uint64 `protobuf:"varint,16,opt,name=fee_invoice_sat,json=feeInvoiceSat,proto3" json:"fee_invoice_sat,omitempty"`
+	FeeInvoiceMsat          uint64 `protobuf:"varint,17,opt,name=fee_invoice_msat,json=feeInvoiceMsat,proto3" json:"fee_invoice_msat,omitempty"`
+	FeeInvoicePaid          bool   `protobuf:"varint,18,opt,name=fee_invoice_paid,json=feeInvoicePaid,proto3" json:"fee_invoice_paid,omitempty"`
+	ClaimInvoicePaymentHash string `protobuf:"bytes,19,opt,name=claim_invoice_payment_hash,json=claimInvoicePaymentHash,proto3" json:"claim_invoice_payment_hash,omitempty"`
+	ClaimInvoiceSat         uint64 `protobuf:"varint,20,opt,name=claim_invoice_sat,json=claimInvoiceSat,proto3" json:"claim_invoice_sat,omitempty"`
+	ClaimInvoiceMsat        uint64 `protobuf:"varint,21,opt,name=claim_invoice_msat,json=claimInvoiceMsat,proto3" json:"claim_invoice_msat,omitempty"`
+	ClaimInvoicePaid        bool   `protobuf:"varint,22,opt,name=claim_invoice_paid,json=claimInvoicePaid,proto3" json:"claim_invoice_paid,omitempty"`
+	OpeningTxId             string `protobuf:"bytes,23,opt,name=opening_tx_id,json=openingTxId,proto3" json:"opening_tx_id,omitempty"`
+	OpeningTxFeeSat         uint64 `protobuf:"varint,24,opt,name=opening_tx_fee_sat,json=openingTxFeeSat,proto3" json:"opening_tx_fee_sat,omitempty"`
+	OpeningTxFeeMsat        uint64 `protobuf:"varint,25,opt,name=opening_tx_fee_msat,json=openingTxFeeMsat,proto3" json:"opening_tx_fee_msat,omitempty"`
+	ClaimTxId               string `protobuf:"bytes,26,opt,name=claim_tx_id,json=claimTxId,proto3" json:"claim_tx_id,omitempty"`
+	ClaimTxFeeSat           uint64 `protobuf:"varint,27,opt,name=claim_tx_fee_sat,json=claimTxFeeSat,proto3" json:"claim_tx_fee_sat,omitempty"`
+	ClaimTxFeeMsat          uint64 `protobuf:"varint,28,opt,name=claim_tx_fee_msat,json=claimTxFeeMsat,proto3" json:"claim_tx_fee_msat,omitempty"`
+	NetSat                  int64  `protobuf:"varint,29,opt,name=net_sat,json=netSat,proto3" json:"net_sat,omitempty"`
+	NetMsat                 int64  `protobuf:"varint,30,opt,name=net_msat,json=netMsat,proto3" json:"net_msat,omitempty"`
+}
+
+func (x *SwapExport) Reset() {
+	*x = SwapExport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[18]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRequestedSwapsRequest) String() string {
+func (x *SwapExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequestedSwapsRequest) ProtoMessage() {}
+func (*SwapExport) ProtoMessage() {}
 
-func (x *ListRequestedSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[18]
+func (x *SwapExport) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,36 +1470,2099 @@ func (x *ListRequestedSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequestedSwapsRequest.ProtoReflect.Descriptor instead.
+// Deprecated: Use SwapExport.ProtoReflect.Descriptor instead.
+func (*SwapExport) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SwapExport) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SwapExport) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *SwapExport) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SwapExport) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SwapExport) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SwapExport) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SwapExport) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetInitiatorNodeId() string {
+	if x != nil {
+		return x.InitiatorNodeId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetPremiumMsat() uint64 {
+	if x != nil {
+		return x.PremiumMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetFeeInvoicePaymentHash() string {
+	if x != nil {
+		return x.FeeInvoicePaymentHash
+	}
+	return ""
+}
+
+func (x *SwapExport) GetFeeInvoiceSat() uint64 {
+	if x != nil {
+		return x.FeeInvoiceSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetFeeInvoiceMsat() uint64 {
+	if x != nil {
+		return x.FeeInvoiceMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetFeeInvoicePaid() bool {
+	if x != nil {
+		return x.FeeInvoicePaid
+	}
+	return false
+}
+
+func (x *SwapExport) GetClaimInvoicePaymentHash() string {
+	if x != nil {
+		return x.ClaimInvoicePaymentHash
+	}
+	return ""
+}
+
+func (x *SwapExport) GetClaimInvoiceSat() uint64 {
+	if x != nil {
+		return x.ClaimInvoiceSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetClaimInvoiceMsat() uint64 {
+	if x != nil {
+		return x.ClaimInvoiceMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetClaimInvoicePaid() bool {
+	if x != nil {
+		return x.ClaimInvoicePaid
+	}
+	return false
+}
+
+func (x *SwapExport) GetOpeningTxId() string {
+	if x != nil {
+		return x.OpeningTxId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetOpeningTxFeeSat() uint64 {
+	if x != nil {
+		return x.OpeningTxFeeSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetOpeningTxFeeMsat() uint64 {
+	if x != nil {
+		return x.OpeningTxFeeMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetClaimTxId() string {
+	if x != nil {
+		return x.ClaimTxId
+	}
+	return ""
+}
+
+func (x *SwapExport) GetClaimTxFeeSat() uint64 {
+	if x != nil {
+		return x.ClaimTxFeeSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetClaimTxFeeMsat() uint64 {
+	if x != nil {
+		return x.ClaimTxFeeMsat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetNetSat() int64 {
+	if x != nil {
+		return x.NetSat
+	}
+	return 0
+}
+
+func (x *SwapExport) GetNetMsat() int64 {
+	if x != nil {
+		return x.NetMsat
+	}
+	return 0
+}
+
+// ArchiveSwapsRequest archives the swaps that finished more than
+// older_than_days ago and whose claim is deeply confirmed.
+type ArchiveSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OlderThanDays uint32 `protobuf:"varint,1,opt,name=older_than_days,json=olderThanDays,proto3" json:"older_than_days,omitempty"`
+}
+
+func (x *ArchiveSwapsRequest) Reset() {
+	*x = ArchiveSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSwapsRequest) ProtoMessage() {}
+
+func (x *ArchiveSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArchiveSwapsRequest) GetOlderThanDays() uint32 {
+	if x != nil {
+		return x.OlderThanDays
+	}
+	return 0
+}
+
+type ArchiveSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*ArchivedSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ArchiveSwapsResponse) Reset() {
+	*x = ArchiveSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSwapsResponse) ProtoMessage() {}
+
+func (x *ArchiveSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ArchiveSwapsResponse) GetSwaps() []*ArchivedSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+// ListArchivedSwapsRequest selects the archived swaps like
+// ExportSwapsRequest.
+type ListArchivedSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime  int64  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    int64  `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PeerNodeId string `protobuf:"bytes,3,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+}
+
+func (x *ListArchivedSwapsRequest) Reset() {
+	*x = ListArchivedSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivedSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSwapsRequest) ProtoMessage() {}
+
+func (x *ListArchivedSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListArchivedSwapsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListArchivedSwapsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListArchivedSwapsRequest) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+type ListArchivedSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*ArchivedSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ListArchivedSwapsResponse) Reset() {
+	*x = ListArchivedSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivedSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSwapsResponse) ProtoMessage() {}
+
+func (x *ListArchivedSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListArchivedSwapsResponse) GetSwaps() []*ArchivedSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+// ArchivedSwap is the record that is kept of an archived swap. It holds no
+// key material.
+type ArchivedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swap          *SwapExport `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	CancelMessage string      `protobuf:"bytes,2,opt,name=cancel_message,json=cancelMessage,proto3" json:"cancel_message,omitempty"`
+	ArchivedAt    int64       `protobuf:"varint,3,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	CancelCode    string      `protobuf:"bytes,4,opt,name=cancel_code,json=cancelCode,proto3" json:"cancel_code,omitempty"`
+}
+
+func (x *ArchivedSwap) Reset() {
+	*x = ArchivedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedSwap) ProtoMessage() {}
+
+func (x *ArchivedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedSwap.ProtoReflect.Descriptor instead.
+func (*ArchivedSwap) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ArchivedSwap) GetSwap() *SwapExport {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *ArchivedSwap) GetCancelMessage() string {
+	if x != nil {
+		return x.CancelMessage
+	}
+	return ""
+}
+
+func (x *ArchivedSwap) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+func (x *ArchivedSwap) GetCancelCode() string {
+	if x != nil {
+		return x.CancelCode
+	}
+	return ""
+}
+
+// ListSwapsRequest filters and pages the swaps. Unset fields do not filter.
+// ListActiveSwaps ignores the fields.
+type ListSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerNodeId string `protobuf:"bytes,1,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	// channel_id is the short channel id as 1x2x3 or 1:2:3.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// asset is btc or lbtc.
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// type is swap-out or swap-in.
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// role is sender or receiver.
+	Role string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	// states selects the swaps that are in one of the states.
+	States []string `protobuf:"bytes,6,rep,name=states,proto3" json:"states,omitempty"`
+	// status is active or finished.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// created_after and created_before select the swaps that were created in
+	// [created_after, created_before) as unix timestamps in seconds.
+	CreatedAfter  int64 `protobuf:"varint,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64 `protobuf:"varint,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// descending lists the newest swaps first.
+	Descending bool `protobuf:"varint,10,opt,name=descending,proto3" json:"descending,omitempty"`
+	// cursor is the next_cursor of the previous page.
+	Cursor string `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// limit is the size of a page, 0 lists all swaps.
+	Limit uint32 `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapsRequest) ProtoMessage() {}
+
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSwapsRequest) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListSwapsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListSwapsRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListSwapsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSwapsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*PrettyPrintSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// next_cursor is set if there are more swaps.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapsResponse) ProtoMessage() {}
+
+func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSwapsResponse) GetSwaps() []*PrettyPrintSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *ListSwapsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListPeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPeersRequest) Reset() {
+	*x = ListPeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeersRequest) ProtoMessage() {}
+
+func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeersRequest.ProtoReflect.Descriptor instead.
+func (*ListPeersRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{30}
+}
+
+type ListPeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*PeerSwapPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *ListPeersResponse) Reset() {
+	*x = ListPeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeersResponse) ProtoMessage() {}
+
+func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeersResponse.ProtoReflect.Descriptor instead.
+func (*ListPeersResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPeersResponse) GetPeers() []*PeerSwapPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type ReloadPolicyFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadPolicyFileRequest) Reset() {
+	*x = ReloadPolicyFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadPolicyFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadPolicyFileRequest) ProtoMessage() {}
+
+func (x *ReloadPolicyFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadPolicyFileRequest.ProtoReflect.Descriptor instead.
+func (*ReloadPolicyFileRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{32}
+}
+
+type AddPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+}
+
+func (x *AddPeerRequest) Reset() {
+	*x = AddPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPeerRequest) ProtoMessage() {}
+
+func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
+func (*AddPeerRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddPeerRequest) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+type RemovePeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+}
+
+func (x *RemovePeerRequest) Reset() {
+	*x = RemovePeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePeerRequest) ProtoMessage() {}
+
+func (x *RemovePeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePeerRequest.ProtoReflect.Descriptor instead.
+func (*RemovePeerRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemovePeerRequest) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+// EvaluatePolicyRequest describes a swap request of a peer that is checked
+// against the policy without creating a swap.
+type EvaluatePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	// asset is btc or lbtc.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// type is the swap-out or swap-in that the peer requests.
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// channel_id is optional, the channel balance is only checked if it is
+	// set.
+	ChannelId uint64 `protobuf:"varint,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *EvaluatePolicyRequest) Reset() {
+	*x = EvaluatePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePolicyRequest) ProtoMessage() {}
+
+func (x *EvaluatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*EvaluatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EvaluatePolicyRequest) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+func (x *EvaluatePolicyRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *EvaluatePolicyRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvaluatePolicyRequest) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *EvaluatePolicyRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+type EvaluatePolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// accepted is set if all checks passed.
+	Accepted   bool           `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	PremiumSat uint64         `protobuf:"varint,2,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	Checks     []*PolicyCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *EvaluatePolicyResponse) Reset() {
+	*x = EvaluatePolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePolicyResponse) ProtoMessage() {}
+
+func (x *EvaluatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*EvaluatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EvaluatePolicyResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *EvaluatePolicyResponse) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *EvaluatePolicyResponse) GetChecks() []*PolicyCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// QuoteSwapRequest describes a swap that we would start on the channel.
+type QuoteSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Asset     string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// type is the type of the swap, "swap-out" or "swap-in".
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+}
+
+func (x *QuoteSwapRequest) Reset() {
+	*x = QuoteSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSwapRequest) ProtoMessage() {}
+
+func (x *QuoteSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSwapRequest.ProtoReflect.Descriptor instead.
+func (*QuoteSwapRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QuoteSwapRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *QuoteSwapRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QuoteSwapRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuoteSwapRequest) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+type QuoteSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerPubkey string `protobuf:"bytes,1,opt,name=peer_pubkey,json=peerPubkey,proto3" json:"peer_pubkey,omitempty"`
+	// opening_tx_fee_sat is paid by us, on a swap-out with the fee invoice.
+	OpeningTxFeeSat uint64 `protobuf:"varint,2,opt,name=opening_tx_fee_sat,json=openingTxFeeSat,proto3" json:"opening_tx_fee_sat,omitempty"`
+	// claim_tx_fee_sat is paid by us on a swap-out and by the peer on a
+	// swap-in.
+	ClaimTxFeeSat uint64 `protobuf:"varint,3,opt,name=claim_tx_fee_sat,json=claimTxFeeSat,proto3" json:"claim_tx_fee_sat,omitempty"`
+	// premium_sat is only known if the peer announces its premiums in its
+	// poll.
+	PremiumSat       uint64         `protobuf:"varint,4,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	PremiumKnown     bool           `protobuf:"varint,5,opt,name=premium_known,json=premiumKnown,proto3" json:"premium_known,omitempty"`
+	TotalCostSat     uint64         `protobuf:"varint,6,opt,name=total_cost_sat,json=totalCostSat,proto3" json:"total_cost_sat,omitempty"`
+	MaxSwapAmountSat uint64         `protobuf:"varint,7,opt,name=max_swap_amount_sat,json=maxSwapAmountSat,proto3" json:"max_swap_amount_sat,omitempty"`
+	LocalAccepted    bool           `protobuf:"varint,8,opt,name=local_accepted,json=localAccepted,proto3" json:"local_accepted,omitempty"`
+	LocalChecks      []*PolicyCheck `protobuf:"bytes,9,rep,name=local_checks,json=localChecks,proto3" json:"local_checks,omitempty"`
+	RemoteAccepted   bool           `protobuf:"varint,10,opt,name=remote_accepted,json=remoteAccepted,proto3" json:"remote_accepted,omitempty"`
+	RemoteChecks     []*PolicyCheck `protobuf:"bytes,11,rep,name=remote_checks,json=remoteChecks,proto3" json:"remote_checks,omitempty"`
+}
+
+func (x *QuoteSwapResponse) Reset() {
+	*x = QuoteSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSwapResponse) ProtoMessage() {}
+
+func (x *QuoteSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSwapResponse.ProtoReflect.Descriptor instead.
+func (*QuoteSwapResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QuoteSwapResponse) GetPeerPubkey() string {
+	if x != nil {
+		return x.PeerPubkey
+	}
+	return ""
+}
+
+func (x *QuoteSwapResponse) GetOpeningTxFeeSat() uint64 {
+	if x != nil {
+		return x.OpeningTxFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteSwapResponse) GetClaimTxFeeSat() uint64 {
+	if x != nil {
+		return x.ClaimTxFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteSwapResponse) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *QuoteSwapResponse) GetPremiumKnown() bool {
+	if x != nil {
+		return x.PremiumKnown
+	}
+	return false
+}
+
+func (x *QuoteSwapResponse) GetTotalCostSat() uint64 {
+	if x != nil {
+		return x.TotalCostSat
+	}
+	return 0
+}
+
+func (x *QuoteSwapResponse) GetMaxSwapAmountSat() uint64 {
+	if x != nil {
+		return x.MaxSwapAmountSat
+	}
+	return 0
+}
+
+func (x *QuoteSwapResponse) GetLocalAccepted() bool {
+	if x != nil {
+		return x.LocalAccepted
+	}
+	return false
+}
+
+func (x *QuoteSwapResponse) GetLocalChecks() []*PolicyCheck {
+	if x != nil {
+		return x.LocalChecks
+	}
+	return nil
+}
+
+func (x *QuoteSwapResponse) GetRemoteAccepted() bool {
+	if x != nil {
+		return x.RemoteAccepted
+	}
+	return false
+}
+
+func (x *QuoteSwapResponse) GetRemoteChecks() []*PolicyCheck {
+	if x != nil {
+		return x.RemoteChecks
+	}
+	return nil
+}
+
+type PolicyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// skipped is set if the check could not be run.
+	Skipped bool   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PolicyCheck) Reset() {
+	*x = PolicyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyCheck) ProtoMessage() {}
+
+func (x *PolicyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyCheck.ProtoReflect.Descriptor instead.
+func (*PolicyCheck) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PolicyCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *PolicyCheck) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *PolicyCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListRequestedSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRequestedSwapsRequest) Reset() {
+	*x = ListRequestedSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRequestedSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequestedSwapsRequest) ProtoMessage() {}
+
+func (x *ListRequestedSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequestedSwapsRequest.ProtoReflect.Descriptor instead.
 func (*ListRequestedSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{18}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{40}
+}
+
+type ListRequestedSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedSwaps map[string]*RequestSwapList `protobuf:"bytes,1,rep,name=requested_swaps,json=requestedSwaps,proto3" json:"requested_swaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListRequestedSwapsResponse) Reset() {
+	*x = ListRequestedSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRequestedSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequestedSwapsResponse) ProtoMessage() {}
+
+func (x *ListRequestedSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequestedSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListRequestedSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListRequestedSwapsResponse) GetRequestedSwaps() map[string]*RequestSwapList {
+	if x != nil {
+		return x.RequestedSwaps
+	}
+	return nil
+}
+
+type RequestSwapList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedSwaps []*RequestedSwap `protobuf:"bytes,1,rep,name=requested_swaps,json=requestedSwaps,proto3" json:"requested_swaps,omitempty"`
+}
+
+func (x *RequestSwapList) Reset() {
+	*x = RequestSwapList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestSwapList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSwapList) ProtoMessage() {}
+
+func (x *RequestSwapList) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSwapList.ProtoReflect.Descriptor instead.
+func (*RequestSwapList) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RequestSwapList) GetRequestedSwaps() []*RequestedSwap {
+	if x != nil {
+		return x.RequestedSwaps
+	}
+	return nil
+}
+
+type RequestedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset           string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	AmountSat       uint64                 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	SwapType        RequestedSwap_SwapType `protobuf:"varint,3,opt,name=swap_type,json=swapType,proto3,enum=peerswap.RequestedSwap_SwapType" json:"swap_type,omitempty"`
+	RejectionReason string                 `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+}
+
+func (x *RequestedSwap) Reset() {
+	*x = RequestedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestedSwap) ProtoMessage() {}
+
+func (x *RequestedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestedSwap.ProtoReflect.Descriptor instead.
+func (*RequestedSwap) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RequestedSwap) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *RequestedSwap) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *RequestedSwap) GetSwapType() RequestedSwap_SwapType {
+	if x != nil {
+		return x.SwapType
+	}
+	return RequestedSwap_SWAP_IN
+}
+
+func (x *RequestedSwap) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type PrettyPrintSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Asset           string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Type            string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Role            string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	State           string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	InitiatorNodeId string `protobuf:"bytes,7,opt,name=initiator_node_id,json=initiatorNodeId,proto3" json:"initiator_node_id,omitempty"`
+	PeerNodeId      string `protobuf:"bytes,8,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	Amount          uint64 `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	ChannelId       string `protobuf:"bytes,10,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OpeningTxId     string `protobuf:"bytes,11,opt,name=opening_tx_id,json=openingTxId,proto3" json:"opening_tx_id,omitempty"`
+	ClaimTxId       string `protobuf:"bytes,12,opt,name=claim_tx_id,json=claimTxId,proto3" json:"claim_tx_id,omitempty"`
+	CancelMessage   string `protobuf:"bytes,13,opt,name=cancel_message,json=cancelMessage,proto3" json:"cancel_message,omitempty"`
+	LndChanId       uint64 `protobuf:"varint,14,opt,name=lnd_chan_id,json=lndChanId,proto3" json:"lnd_chan_id,omitempty"`
+	Premium         uint64 `protobuf:"varint,15,opt,name=premium,proto3" json:"premium,omitempty"`
+	// cancel_code is the machine readable reason of the cancel_message.
+	CancelCode string `protobuf:"bytes,16,opt,name=cancel_code,json=cancelCode,proto3" json:"cancel_code,omitempty"`
+}
+
+func (x *PrettyPrintSwap) Reset() {
+	*x = PrettyPrintSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrettyPrintSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrettyPrintSwap) ProtoMessage() {}
+
+func (x *PrettyPrintSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrettyPrintSwap.ProtoReflect.Descriptor instead.
+func (*PrettyPrintSwap) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PrettyPrintSwap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PrettyPrintSwap) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetInitiatorNodeId() string {
+	if x != nil {
+		return x.InitiatorNodeId
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PrettyPrintSwap) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetOpeningTxId() string {
+	if x != nil {
+		return x.OpeningTxId
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetClaimTxId() string {
+	if x != nil {
+		return x.ClaimTxId
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetCancelMessage() string {
+	if x != nil {
+		return x.CancelMessage
+	}
+	return ""
+}
+
+func (x *PrettyPrintSwap) GetLndChanId() uint64 {
+	if x != nil {
+		return x.LndChanId
+	}
+	return 0
+}
+
+func (x *PrettyPrintSwap) GetPremium() uint64 {
+	if x != nil {
+		return x.Premium
+	}
+	return 0
+}
+
+func (x *PrettyPrintSwap) GetCancelCode() string {
+	if x != nil {
+		return x.CancelCode
+	}
+	return ""
+}
+
+type PeerSwapPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId          string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SwapsAllowed    bool                   `protobuf:"varint,2,opt,name=swaps_allowed,json=swapsAllowed,proto3" json:"swaps_allowed,omitempty"`
+	SupportedAssets []string               `protobuf:"bytes,3,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty"`
+	Channels        []*PeerSwapPeerChannel `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	AsSender        *SwapStats             `protobuf:"bytes,5,opt,name=as_sender,json=asSender,proto3" json:"as_sender,omitempty"`
+	AsReceiver      *SwapStats             `protobuf:"bytes,6,opt,name=as_receiver,json=asReceiver,proto3" json:"as_receiver,omitempty"`
+	PaidFee         uint64                 `protobuf:"varint,7,opt,name=paid_fee,json=paidFee,proto3" json:"paid_fee,omitempty"`
+	// policy holds the policy overrides of the peer, unset if it has none.
+	Policy     *PeerPolicy     `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	Reputation *PeerReputation `protobuf:"bytes,9,opt,name=reputation,proto3" json:"reputation,omitempty"`
+}
+
+func (x *PeerSwapPeer) Reset() {
+	*x = PeerSwapPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerSwapPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSwapPeer) ProtoMessage() {}
+
+func (x *PeerSwapPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSwapPeer.ProtoReflect.Descriptor instead.
+func (*PeerSwapPeer) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PeerSwapPeer) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PeerSwapPeer) GetSwapsAllowed() bool {
+	if x != nil {
+		return x.SwapsAllowed
+	}
+	return false
+}
+
+func (x *PeerSwapPeer) GetSupportedAssets() []string {
+	if x != nil {
+		return x.SupportedAssets
+	}
+	return nil
+}
+
+func (x *PeerSwapPeer) GetChannels() []*PeerSwapPeerChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *PeerSwapPeer) GetAsSender() *SwapStats {
+	if x != nil {
+		return x.AsSender
+	}
+	return nil
+}
+
+func (x *PeerSwapPeer) GetAsReceiver() *SwapStats {
+	if x != nil {
+		return x.AsReceiver
+	}
+	return nil
+}
+
+func (x *PeerSwapPeer) GetPaidFee() uint64 {
+	if x != nil {
+		return x.PaidFee
+	}
+	return 0
+}
+
+func (x *PeerSwapPeer) GetPolicy() *PeerPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *PeerSwapPeer) GetReputation() *PeerReputation {
+	if x != nil {
+		return x.Reputation
+	}
+	return nil
+}
+
+type GetPeerReputationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *GetPeerReputationRequest) Reset() {
+	*x = GetPeerReputationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerReputationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerReputationRequest) ProtoMessage() {}
+
+func (x *GetPeerReputationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerReputationRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerReputationRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPeerReputationRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+// PeerReputation is computed from the outcomes of the finished swaps with a
+// peer. The score starts at 100 and every bad outcome in the reputation window
+// subtracts its penalty. The counts cover the window, the history holds all
+// outcomes, latest first.
+type PeerReputation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Score  uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// limited is set if the score is below the limit score of the policy.
+	Limited                 bool           `protobuf:"varint,3,opt,name=limited,proto3" json:"limited,omitempty"`
+	Successes               uint64         `protobuf:"varint,4,opt,name=successes,proto3" json:"successes,omitempty"`
+	CsvClaims               uint64         `protobuf:"varint,5,opt,name=csv_claims,json=csvClaims,proto3" json:"csv_claims,omitempty"`
+	UnpaidClaimInvoices     uint64         `protobuf:"varint,6,opt,name=unpaid_claim_invoices,json=unpaidClaimInvoices,proto3" json:"unpaid_claim_invoices,omitempty"`
+	CanceledAfterFeeInvoice uint64         `protobuf:"varint,7,opt,name=canceled_after_fee_invoice,json=canceledAfterFeeInvoice,proto3" json:"canceled_after_fee_invoice,omitempty"`
+	Timeouts                uint64         `protobuf:"varint,8,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	History                 []*PeerOutcome `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *PeerReputation) Reset() {
+	*x = PeerReputation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReputation) ProtoMessage() {}
+
+func (x *PeerReputation) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReputation.ProtoReflect.Descriptor instead.
+func (*PeerReputation) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PeerReputation) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PeerReputation) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetLimited() bool {
+	if x != nil {
+		return x.Limited
+	}
+	return false
+}
+
+func (x *PeerReputation) GetSuccesses() uint64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetCsvClaims() uint64 {
+	if x != nil {
+		return x.CsvClaims
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetUnpaidClaimInvoices() uint64 {
+	if x != nil {
+		return x.UnpaidClaimInvoices
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetCanceledAfterFeeInvoice() uint64 {
+	if x != nil {
+		return x.CanceledAfterFeeInvoice
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetTimeouts() uint64 {
+	if x != nil {
+		return x.Timeouts
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetHistory() []*PeerOutcome {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// GetPeerStatsRequest returns the stats of a peer with the given number of
+// the last swaps, 10 if 0.
+type GetPeerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Swaps  uint32 `protobuf:"varint,2,opt,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *GetPeerStatsRequest) Reset() {
+	*x = GetPeerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ListRequestedSwapsResponse struct {
+func (x *GetPeerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerStatsRequest) ProtoMessage() {}
+
+func (x *GetPeerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPeerStatsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetPeerStatsRequest) GetSwaps() uint32 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+// PeerStats are the stats of the finished swaps with a peer.
+type PeerStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestedSwaps map[string]*RequestSwapList `protobuf:"bytes,1,rep,name=requested_swaps,json=requestedSwaps,proto3" json:"requested_swaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *ListRequestedSwapsResponse) Reset() {
-	*x = ListRequestedSwapsResponse{}
+	NodeId    string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Swaps     uint64 `protobuf:"varint,2,opt,name=swaps,proto3" json:"swaps,omitempty"`
+	Successes uint64 `protobuf:"varint,3,opt,name=successes,proto3" json:"successes,omitempty"`
+	// success_rate is the share of the swaps that were claimed with the
+	// preimage, from 0 to 1.
+	SuccessRate float64 `protobuf:"fixed64,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// median_completion_seconds is the median time from the creation of a
+	// successful swap until it finished.
+	MedianCompletionSeconds int64  `protobuf:"varint,5,opt,name=median_completion_seconds,json=medianCompletionSeconds,proto3" json:"median_completion_seconds,omitempty"`
+	CsvClaims               uint64 `protobuf:"varint,6,opt,name=csv_claims,json=csvClaims,proto3" json:"csv_claims,omitempty"`
+	// cancel_reasons counts the last distinct cancel messages of the
+	// canceled swaps.
+	CancelReasons map[string]uint64 `protobuf:"bytes,7,rep,name=cancel_reasons,json=cancelReasons,proto3" json:"cancel_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Volumes       []*PeerVolume     `protobuf:"bytes,8,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// fees_paid_sat are the on-chain fees, fee invoices and premiums that we
+	// paid, fees_earned_sat the fee invoices and premiums that we received.
+	FeesPaidSat   uint64 `protobuf:"varint,9,opt,name=fees_paid_sat,json=feesPaidSat,proto3" json:"fees_paid_sat,omitempty"`
+	FeesEarnedSat uint64 `protobuf:"varint,10,opt,name=fees_earned_sat,json=feesEarnedSat,proto3" json:"fees_earned_sat,omitempty"`
+	// last_swaps holds the last finished swaps, latest first.
+	LastSwaps []*SwapExport `protobuf:"bytes,11,rep,name=last_swaps,json=lastSwaps,proto3" json:"last_swaps,omitempty"`
+	// cancel_codes counts the cancel codes of the canceled swaps.
+	CancelCodes map[string]uint64 `protobuf:"bytes,12,rep,name=cancel_codes,json=cancelCodes,proto3" json:"cancel_codes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *PeerStats) Reset() {
+	*x = PeerStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[19]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRequestedSwapsResponse) String() string {
+func (x *PeerStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequestedSwapsResponse) ProtoMessage() {}
+func (*PeerStats) ProtoMessage() {}
 
-func (x *ListRequestedSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[19]
+func (x *PeerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,43 +3573,126 @@ func (x *ListRequestedSwapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequestedSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListRequestedSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PeerStats.ProtoReflect.Descriptor instead.
+func (*PeerStats) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListRequestedSwapsResponse) GetRequestedSwaps() map[string]*RequestSwapList {
+func (x *PeerStats) GetNodeId() string {
 	if x != nil {
-		return x.RequestedSwaps
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PeerStats) GetSwaps() uint64 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+func (x *PeerStats) GetSuccesses() uint64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *PeerStats) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *PeerStats) GetMedianCompletionSeconds() int64 {
+	if x != nil {
+		return x.MedianCompletionSeconds
+	}
+	return 0
+}
+
+func (x *PeerStats) GetCsvClaims() uint64 {
+	if x != nil {
+		return x.CsvClaims
+	}
+	return 0
+}
+
+func (x *PeerStats) GetCancelReasons() map[string]uint64 {
+	if x != nil {
+		return x.CancelReasons
 	}
 	return nil
 }
 
-type RequestSwapList struct {
+func (x *PeerStats) GetVolumes() []*PeerVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *PeerStats) GetFeesPaidSat() uint64 {
+	if x != nil {
+		return x.FeesPaidSat
+	}
+	return 0
+}
+
+func (x *PeerStats) GetFeesEarnedSat() uint64 {
+	if x != nil {
+		return x.FeesEarnedSat
+	}
+	return 0
+}
+
+func (x *PeerStats) GetLastSwaps() []*SwapExport {
+	if x != nil {
+		return x.LastSwaps
+	}
+	return nil
+}
+
+func (x *PeerStats) GetCancelCodes() map[string]uint64 {
+	if x != nil {
+		return x.CancelCodes
+	}
+	return nil
+}
+
+// PeerVolume is the volume of the successful swaps of one asset, type and
+// role.
+type PeerVolume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestedSwaps []*RequestedSwap `protobuf:"bytes,1,rep,name=requested_swaps,json=requestedSwaps,proto3" json:"requested_swaps,omitempty"`
+	Asset     string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Role      string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Swaps     uint64 `protobuf:"varint,4,opt,name=swaps,proto3" json:"swaps,omitempty"`
+	AmountSat uint64 `protobuf:"varint,5,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
 }
 
-func (x *RequestSwapList) Reset() {
-	*x = RequestSwapList{}
+func (x *PeerVolume) Reset() {
+	*x = PeerVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[20]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestSwapList) String() string {
+func (x *PeerVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSwapList) ProtoMessage() {}
+func (*PeerVolume) ProtoMessage() {}
 
-func (x *RequestSwapList) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[20]
+func (x *PeerVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,46 +3703,76 @@ func (x *RequestSwapList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSwapList.ProtoReflect.Descriptor instead.
-func (*RequestSwapList) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PeerVolume.ProtoReflect.Descriptor instead.
+func (*PeerVolume) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RequestSwapList) GetRequestedSwaps() []*RequestedSwap {
+func (x *PeerVolume) GetAsset() string {
 	if x != nil {
-		return x.RequestedSwaps
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-type RequestedSwap struct {
+func (x *PeerVolume) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PeerVolume) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PeerVolume) GetSwaps() uint64 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+func (x *PeerVolume) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+type PeerOutcome struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asset           string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	AmountSat       uint64                 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
-	SwapType        RequestedSwap_SwapType `protobuf:"varint,3,opt,name=swap_type,json=swapType,proto3,enum=peerswap.RequestedSwap_SwapType" json:"swap_type,omitempty"`
-	RejectionReason string                 `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	SwapId string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	// outcome is one of success, csv_claim, unpaid_claim_invoice,
+	// canceled_after_fee_invoice and timeout.
+	Outcome    string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	FinishedAt int64  `protobuf:"varint,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Penalty    uint64 `protobuf:"varint,4,opt,name=penalty,proto3" json:"penalty,omitempty"`
 }
 
-func (x *RequestedSwap) Reset() {
-	*x = RequestedSwap{}
+func (x *PeerOutcome) Reset() {
+	*x = PeerOutcome{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[21]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestedSwap) String() string {
+func (x *PeerOutcome) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestedSwap) ProtoMessage() {}
+func (*PeerOutcome) ProtoMessage() {}
 
-func (x *RequestedSwap) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[21]
+func (x *PeerOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,77 +3783,138 @@ func (x *RequestedSwap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestedSwap.ProtoReflect.Descriptor instead.
-func (*RequestedSwap) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PeerOutcome.ProtoReflect.Descriptor instead.
+func (*PeerOutcome) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RequestedSwap) GetAsset() string {
+func (x *PeerOutcome) GetSwapId() string {
 	if x != nil {
-		return x.Asset
+		return x.SwapId
 	}
 	return ""
 }
 
-func (x *RequestedSwap) GetAmountSat() uint64 {
+func (x *PeerOutcome) GetOutcome() string {
 	if x != nil {
-		return x.AmountSat
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *PeerOutcome) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
 	}
 	return 0
 }
 
-func (x *RequestedSwap) GetSwapType() RequestedSwap_SwapType {
+func (x *PeerOutcome) GetPenalty() uint64 {
 	if x != nil {
-		return x.SwapType
+		return x.Penalty
 	}
-	return RequestedSwap_SWAP_IN
+	return 0
 }
 
-func (x *RequestedSwap) GetRejectionReason() string {
+type PeerSwapPeerChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId     uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LocalBalance  uint64 `protobuf:"varint,2,opt,name=local_balance,json=localBalance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance uint64 `protobuf:"varint,3,opt,name=remote_balance,json=remoteBalance,proto3" json:"remote_balance,omitempty"`
+	Active        bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *PeerSwapPeerChannel) Reset() {
+	*x = PeerSwapPeerChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerSwapPeerChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSwapPeerChannel) ProtoMessage() {}
+
+func (x *PeerSwapPeerChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSwapPeerChannel.ProtoReflect.Descriptor instead.
+func (*PeerSwapPeerChannel) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PeerSwapPeerChannel) GetChannelId() uint64 {
 	if x != nil {
-		return x.RejectionReason
+		return x.ChannelId
 	}
-	return ""
+	return 0
 }
 
-type PrettyPrintSwap struct {
+func (x *PeerSwapPeerChannel) GetLocalBalance() uint64 {
+	if x != nil {
+		return x.LocalBalance
+	}
+	return 0
+}
+
+func (x *PeerSwapPeerChannel) GetRemoteBalance() uint64 {
+	if x != nil {
+		return x.RemoteBalance
+	}
+	return 0
+}
+
+func (x *PeerSwapPeerChannel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SwapStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt       int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Asset           string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Type            string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Role            string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	State           string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	InitiatorNodeId string `protobuf:"bytes,7,opt,name=initiator_node_id,json=initiatorNodeId,proto3" json:"initiator_node_id,omitempty"`
-	PeerNodeId      string `protobuf:"bytes,8,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
-	Amount          uint64 `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
-	ChannelId       string `protobuf:"bytes,10,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	OpeningTxId     string `protobuf:"bytes,11,opt,name=opening_tx_id,json=openingTxId,proto3" json:"opening_tx_id,omitempty"`
-	ClaimTxId       string `protobuf:"bytes,12,opt,name=claim_tx_id,json=claimTxId,proto3" json:"claim_tx_id,omitempty"`
-	CancelMessage   string `protobuf:"bytes,13,opt,name=cancel_message,json=cancelMessage,proto3" json:"cancel_message,omitempty"`
-	LndChanId       uint64 `protobuf:"varint,14,opt,name=lnd_chan_id,json=lndChanId,proto3" json:"lnd_chan_id,omitempty"`
+	SwapsOut uint64 `protobuf:"varint,1,opt,name=swaps_out,json=swapsOut,proto3" json:"swaps_out,omitempty"`
+	SwapsIn  uint64 `protobuf:"varint,2,opt,name=swaps_in,json=swapsIn,proto3" json:"swaps_in,omitempty"`
+	SatsOut  uint64 `protobuf:"varint,3,opt,name=sats_out,json=satsOut,proto3" json:"sats_out,omitempty"`
+	SatsIn   uint64 `protobuf:"varint,4,opt,name=sats_in,json=satsIn,proto3" json:"sats_in,omitempty"`
 }
 
-func (x *PrettyPrintSwap) Reset() {
-	*x = PrettyPrintSwap{}
+func (x *SwapStats) Reset() {
+	*x = SwapStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[22]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrettyPrintSwap) String() string {
+func (x *SwapStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrettyPrintSwap) ProtoMessage() {}
+func (*SwapStats) ProtoMessage() {}
 
-func (x *PrettyPrintSwap) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[22]
+func (x *SwapStats) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,140 +3925,138 @@ func (x *PrettyPrintSwap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrettyPrintSwap.ProtoReflect.Descriptor instead.
-func (*PrettyPrintSwap) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *PrettyPrintSwap) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
+func (*SwapStats) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *PrettyPrintSwap) GetCreatedAt() int64 {
+func (x *SwapStats) GetSwapsOut() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.SwapsOut
 	}
 	return 0
 }
 
-func (x *PrettyPrintSwap) GetAsset() string {
+func (x *SwapStats) GetSwapsIn() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.SwapsIn
 	}
-	return ""
+	return 0
 }
 
-func (x *PrettyPrintSwap) GetType() string {
+func (x *SwapStats) GetSatsOut() uint64 {
 	if x != nil {
-		return x.Type
+		return x.SatsOut
 	}
-	return ""
+	return 0
 }
 
-func (x *PrettyPrintSwap) GetRole() string {
+func (x *SwapStats) GetSatsIn() uint64 {
 	if x != nil {
-		return x.Role
+		return x.SatsIn
 	}
-	return ""
+	return 0
 }
 
-func (x *PrettyPrintSwap) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
+type PeerSwapNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *PrettyPrintSwap) GetInitiatorNodeId() string {
-	if x != nil {
-		return x.InitiatorNodeId
-	}
-	return ""
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
-func (x *PrettyPrintSwap) GetPeerNodeId() string {
-	if x != nil {
-		return x.PeerNodeId
+func (x *PeerSwapNodes) Reset() {
+	*x = PeerSwapNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PrettyPrintSwap) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *PeerSwapNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PrettyPrintSwap) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
+func (*PeerSwapNodes) ProtoMessage() {}
 
-func (x *PrettyPrintSwap) GetOpeningTxId() string {
-	if x != nil {
-		return x.OpeningTxId
+func (x *PeerSwapNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PrettyPrintSwap) GetClaimTxId() string {
-	if x != nil {
-		return x.ClaimTxId
-	}
-	return ""
+// Deprecated: Use PeerSwapNodes.ProtoReflect.Descriptor instead.
+func (*PeerSwapNodes) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *PrettyPrintSwap) GetCancelMessage() string {
+func (x *PeerSwapNodes) GetNodeId() string {
 	if x != nil {
-		return x.CancelMessage
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *PrettyPrintSwap) GetLndChanId() uint64 {
-	if x != nil {
-		return x.LndChanId
-	}
-	return 0
-}
-
-type PeerSwapPeer struct {
+type Policy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeId          string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SwapsAllowed    bool                   `protobuf:"varint,2,opt,name=swaps_allowed,json=swapsAllowed,proto3" json:"swaps_allowed,omitempty"`
-	SupportedAssets []string               `protobuf:"bytes,3,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty"`
-	Channels        []*PeerSwapPeerChannel `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
-	AsSender        *SwapStats             `protobuf:"bytes,5,opt,name=as_sender,json=asSender,proto3" json:"as_sender,omitempty"`
-	AsReceiver      *SwapStats             `protobuf:"bytes,6,opt,name=as_receiver,json=asReceiver,proto3" json:"as_receiver,omitempty"`
-	PaidFee         uint64                 `protobuf:"varint,7,opt,name=paid_fee,json=paidFee,proto3" json:"paid_fee,omitempty"`
+	ReserveOnchainMsat              uint64        `protobuf:"varint,1,opt,name=reserve_onchain_msat,json=reserveOnchainMsat,proto3" json:"reserve_onchain_msat,omitempty"`
+	MinSwapAmountMsat               uint64        `protobuf:"varint,2,opt,name=min_swap_amount_msat,json=minSwapAmountMsat,proto3" json:"min_swap_amount_msat,omitempty"`
+	AcceptAllPeers                  bool          `protobuf:"varint,3,opt,name=accept_all_peers,json=acceptAllPeers,proto3" json:"accept_all_peers,omitempty"`
+	AllowNewSwaps                   bool          `protobuf:"varint,4,opt,name=allow_new_swaps,json=allowNewSwaps,proto3" json:"allow_new_swaps,omitempty"`
+	AllowlistedPeers                []string      `protobuf:"bytes,5,rep,name=allowlisted_peers,json=allowlistedPeers,proto3" json:"allowlisted_peers,omitempty"`
+	SuspiciousPeerList              []string      `protobuf:"bytes,6,rep,name=suspicious_peer_list,json=suspiciousPeerList,proto3" json:"suspicious_peer_list,omitempty"`
+	BtcPremiumRatePpm               uint64        `protobuf:"varint,7,opt,name=btc_premium_rate_ppm,json=btcPremiumRatePpm,proto3" json:"btc_premium_rate_ppm,omitempty"`
+	BtcPremiumFixedSat              uint64        `protobuf:"varint,8,opt,name=btc_premium_fixed_sat,json=btcPremiumFixedSat,proto3" json:"btc_premium_fixed_sat,omitempty"`
+	LbtcPremiumRatePpm              uint64        `protobuf:"varint,9,opt,name=lbtc_premium_rate_ppm,json=lbtcPremiumRatePpm,proto3" json:"lbtc_premium_rate_ppm,omitempty"`
+	LbtcPremiumFixedSat             uint64        `protobuf:"varint,10,opt,name=lbtc_premium_fixed_sat,json=lbtcPremiumFixedSat,proto3" json:"lbtc_premium_fixed_sat,omitempty"`
+	Peers                           []*PeerPolicy `protobuf:"bytes,11,rep,name=peers,proto3" json:"peers,omitempty"`
+	MaxConcurrentSwaps              uint64        `protobuf:"varint,12,opt,name=max_concurrent_swaps,json=maxConcurrentSwaps,proto3" json:"max_concurrent_swaps,omitempty"`
+	MaxSwapsPerHour                 uint64        `protobuf:"varint,13,opt,name=max_swaps_per_hour,json=maxSwapsPerHour,proto3" json:"max_swaps_per_hour,omitempty"`
+	MaxSwapsPerDay                  uint64        `protobuf:"varint,14,opt,name=max_swaps_per_day,json=maxSwapsPerDay,proto3" json:"max_swaps_per_day,omitempty"`
+	BtcMaxSatPerDay                 uint64        `protobuf:"varint,15,opt,name=btc_max_sat_per_day,json=btcMaxSatPerDay,proto3" json:"btc_max_sat_per_day,omitempty"`
+	LbtcMaxSatPerDay                uint64        `protobuf:"varint,16,opt,name=lbtc_max_sat_per_day,json=lbtcMaxSatPerDay,proto3" json:"lbtc_max_sat_per_day,omitempty"`
+	PeerMaxConcurrentSwaps          uint64        `protobuf:"varint,17,opt,name=peer_max_concurrent_swaps,json=peerMaxConcurrentSwaps,proto3" json:"peer_max_concurrent_swaps,omitempty"`
+	PeerMaxSwapsPerHour             uint64        `protobuf:"varint,18,opt,name=peer_max_swaps_per_hour,json=peerMaxSwapsPerHour,proto3" json:"peer_max_swaps_per_hour,omitempty"`
+	PeerMaxSwapsPerDay              uint64        `protobuf:"varint,19,opt,name=peer_max_swaps_per_day,json=peerMaxSwapsPerDay,proto3" json:"peer_max_swaps_per_day,omitempty"`
+	PeerBtcMaxSatPerDay             uint64        `protobuf:"varint,20,opt,name=peer_btc_max_sat_per_day,json=peerBtcMaxSatPerDay,proto3" json:"peer_btc_max_sat_per_day,omitempty"`
+	PeerLbtcMaxSatPerDay            uint64        `protobuf:"varint,21,opt,name=peer_lbtc_max_sat_per_day,json=peerLbtcMaxSatPerDay,proto3" json:"peer_lbtc_max_sat_per_day,omitempty"`
+	ReputationWindowDays            uint64        `protobuf:"varint,22,opt,name=reputation_window_days,json=reputationWindowDays,proto3" json:"reputation_window_days,omitempty"`
+	ReputationLimitScore            uint64        `protobuf:"varint,23,opt,name=reputation_limit_score,json=reputationLimitScore,proto3" json:"reputation_limit_score,omitempty"`
+	ReputationLimitedMaxSwapsPerDay uint64        `protobuf:"varint,24,opt,name=reputation_limited_max_swaps_per_day,json=reputationLimitedMaxSwapsPerDay,proto3" json:"reputation_limited_max_swaps_per_day,omitempty"`
+	ReputationSuspiciousScore       uint64        `protobuf:"varint,25,opt,name=reputation_suspicious_score,json=reputationSuspiciousScore,proto3" json:"reputation_suspicious_score,omitempty"`
+	BtcReserveOnchainMsat           uint64        `protobuf:"varint,26,opt,name=btc_reserve_onchain_msat,json=btcReserveOnchainMsat,proto3" json:"btc_reserve_onchain_msat,omitempty"`
+	LbtcReserveOnchainMsat          uint64        `protobuf:"varint,27,opt,name=lbtc_reserve_onchain_msat,json=lbtcReserveOnchainMsat,proto3" json:"lbtc_reserve_onchain_msat,omitempty"`
+	ExcludedScids                   []string      `protobuf:"bytes,28,rep,name=excluded_scids,json=excludedScids,proto3" json:"excluded_scids,omitempty"`
 }
 
-func (x *PeerSwapPeer) Reset() {
-	*x = PeerSwapPeer{}
+func (x *Policy) Reset() {
+	*x = Policy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[23]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeerSwapPeer) String() string {
+func (x *Policy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerSwapPeer) ProtoMessage() {}
+func (*Policy) ProtoMessage() {}
 
-func (x *PeerSwapPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[23]
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,227 +4067,247 @@ func (x *PeerSwapPeer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerSwapPeer.ProtoReflect.Descriptor instead.
-func (*PeerSwapPeer) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *PeerSwapPeer) GetNodeId() string {
+func (x *Policy) GetReserveOnchainMsat() uint64 {
 	if x != nil {
-		return x.NodeId
+		return x.ReserveOnchainMsat
 	}
-	return ""
+	return 0
 }
 
-func (x *PeerSwapPeer) GetSwapsAllowed() bool {
+func (x *Policy) GetMinSwapAmountMsat() uint64 {
 	if x != nil {
-		return x.SwapsAllowed
+		return x.MinSwapAmountMsat
 	}
-	return false
+	return 0
 }
 
-func (x *PeerSwapPeer) GetSupportedAssets() []string {
+func (x *Policy) GetAcceptAllPeers() bool {
 	if x != nil {
-		return x.SupportedAssets
+		return x.AcceptAllPeers
 	}
-	return nil
+	return false
 }
 
-func (x *PeerSwapPeer) GetChannels() []*PeerSwapPeerChannel {
+func (x *Policy) GetAllowNewSwaps() bool {
 	if x != nil {
-		return x.Channels
+		return x.AllowNewSwaps
 	}
-	return nil
+	return false
 }
 
-func (x *PeerSwapPeer) GetAsSender() *SwapStats {
+func (x *Policy) GetAllowlistedPeers() []string {
 	if x != nil {
-		return x.AsSender
+		return x.AllowlistedPeers
 	}
 	return nil
 }
 
-func (x *PeerSwapPeer) GetAsReceiver() *SwapStats {
+func (x *Policy) GetSuspiciousPeerList() []string {
 	if x != nil {
-		return x.AsReceiver
+		return x.SuspiciousPeerList
 	}
 	return nil
 }
 
-func (x *PeerSwapPeer) GetPaidFee() uint64 {
+func (x *Policy) GetBtcPremiumRatePpm() uint64 {
 	if x != nil {
-		return x.PaidFee
+		return x.BtcPremiumRatePpm
 	}
 	return 0
 }
 
-type PeerSwapPeerChannel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ChannelId     uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	LocalBalance  uint64 `protobuf:"varint,2,opt,name=local_balance,json=localBalance,proto3" json:"local_balance,omitempty"`
-	RemoteBalance uint64 `protobuf:"varint,3,opt,name=remote_balance,json=remoteBalance,proto3" json:"remote_balance,omitempty"`
-	Active        bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+func (x *Policy) GetBtcPremiumFixedSat() uint64 {
+	if x != nil {
+		return x.BtcPremiumFixedSat
+	}
+	return 0
 }
 
-func (x *PeerSwapPeerChannel) Reset() {
-	*x = PeerSwapPeerChannel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Policy) GetLbtcPremiumRatePpm() uint64 {
+	if x != nil {
+		return x.LbtcPremiumRatePpm
 	}
+	return 0
 }
 
-func (x *PeerSwapPeerChannel) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Policy) GetLbtcPremiumFixedSat() uint64 {
+	if x != nil {
+		return x.LbtcPremiumFixedSat
+	}
+	return 0
 }
 
-func (*PeerSwapPeerChannel) ProtoMessage() {}
-
-func (x *PeerSwapPeerChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Policy) GetPeers() []*PeerPolicy {
+	if x != nil {
+		return x.Peers
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PeerSwapPeerChannel.ProtoReflect.Descriptor instead.
-func (*PeerSwapPeerChannel) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{24}
+func (x *Policy) GetMaxConcurrentSwaps() uint64 {
+	if x != nil {
+		return x.MaxConcurrentSwaps
+	}
+	return 0
 }
 
-func (x *PeerSwapPeerChannel) GetChannelId() uint64 {
+func (x *Policy) GetMaxSwapsPerHour() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.MaxSwapsPerHour
 	}
 	return 0
 }
 
-func (x *PeerSwapPeerChannel) GetLocalBalance() uint64 {
+func (x *Policy) GetMaxSwapsPerDay() uint64 {
 	if x != nil {
-		return x.LocalBalance
+		return x.MaxSwapsPerDay
 	}
 	return 0
 }
 
-func (x *PeerSwapPeerChannel) GetRemoteBalance() uint64 {
+func (x *Policy) GetBtcMaxSatPerDay() uint64 {
 	if x != nil {
-		return x.RemoteBalance
+		return x.BtcMaxSatPerDay
 	}
 	return 0
 }
 
-func (x *PeerSwapPeerChannel) GetActive() bool {
+func (x *Policy) GetLbtcMaxSatPerDay() uint64 {
 	if x != nil {
-		return x.Active
+		return x.LbtcMaxSatPerDay
 	}
-	return false
+	return 0
 }
 
-type SwapStats struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SwapsOut uint64 `protobuf:"varint,1,opt,name=swaps_out,json=swapsOut,proto3" json:"swaps_out,omitempty"`
-	SwapsIn  uint64 `protobuf:"varint,2,opt,name=swaps_in,json=swapsIn,proto3" json:"swaps_in,omitempty"`
-	SatsOut  uint64 `protobuf:"varint,3,opt,name=sats_out,json=satsOut,proto3" json:"sats_out,omitempty"`
-	SatsIn   uint64 `protobuf:"varint,4,opt,name=sats_in,json=satsIn,proto3" json:"sats_in,omitempty"`
+func (x *Policy) GetPeerMaxConcurrentSwaps() uint64 {
+	if x != nil {
+		return x.PeerMaxConcurrentSwaps
+	}
+	return 0
 }
 
-func (x *SwapStats) Reset() {
-	*x = SwapStats{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Policy) GetPeerMaxSwapsPerHour() uint64 {
+	if x != nil {
+		return x.PeerMaxSwapsPerHour
+	}
+	return 0
+}
+
+func (x *Policy) GetPeerMaxSwapsPerDay() uint64 {
+	if x != nil {
+		return x.PeerMaxSwapsPerDay
 	}
+	return 0
 }
 
-func (x *SwapStats) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Policy) GetPeerBtcMaxSatPerDay() uint64 {
+	if x != nil {
+		return x.PeerBtcMaxSatPerDay
+	}
+	return 0
 }
 
-func (*SwapStats) ProtoMessage() {}
+func (x *Policy) GetPeerLbtcMaxSatPerDay() uint64 {
+	if x != nil {
+		return x.PeerLbtcMaxSatPerDay
+	}
+	return 0
+}
 
-func (x *SwapStats) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Policy) GetReputationWindowDays() uint64 {
+	if x != nil {
+		return x.ReputationWindowDays
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
-func (*SwapStats) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{25}
+func (x *Policy) GetReputationLimitScore() uint64 {
+	if x != nil {
+		return x.ReputationLimitScore
+	}
+	return 0
 }
 
-func (x *SwapStats) GetSwapsOut() uint64 {
+func (x *Policy) GetReputationLimitedMaxSwapsPerDay() uint64 {
 	if x != nil {
-		return x.SwapsOut
+		return x.ReputationLimitedMaxSwapsPerDay
 	}
 	return 0
 }
 
-func (x *SwapStats) GetSwapsIn() uint64 {
+func (x *Policy) GetReputationSuspiciousScore() uint64 {
 	if x != nil {
-		return x.SwapsIn
+		return x.ReputationSuspiciousScore
 	}
 	return 0
 }
 
-func (x *SwapStats) GetSatsOut() uint64 {
+func (x *Policy) GetBtcReserveOnchainMsat() uint64 {
 	if x != nil {
-		return x.SatsOut
+		return x.BtcReserveOnchainMsat
 	}
 	return 0
 }
 
-func (x *SwapStats) GetSatsIn() uint64 {
+func (x *Policy) GetLbtcReserveOnchainMsat() uint64 {
 	if x != nil {
-		return x.SatsIn
+		return x.LbtcReserveOnchainMsat
 	}
 	return 0
 }
 
-type PeerSwapNodes struct {
+func (x *Policy) GetExcludedScids() []string {
+	if x != nil {
+		return x.ExcludedScids
+	}
+	return nil
+}
+
+// PeerPolicy overrides the policy for the swaps with a peer. Unset fields
+// fall back to the global policy.
+type PeerPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-}
-
-func (x *PeerSwapNodes) Reset() {
-	*x = PeerSwapNodes{}
+	Pubkey              string   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	AllowedAssets       []string `protobuf:"bytes,2,rep,name=allowed_assets,json=allowedAssets,proto3" json:"allowed_assets,omitempty"`
+	AllowedSwapTypes    []string `protobuf:"bytes,3,rep,name=allowed_swap_types,json=allowedSwapTypes,proto3" json:"allowed_swap_types,omitempty"`
+	MinSwapAmountMsat   *uint64  `protobuf:"varint,4,opt,name=min_swap_amount_msat,json=minSwapAmountMsat,proto3,oneof" json:"min_swap_amount_msat,omitempty"`
+	MaxSwapAmountMsat   uint64   `protobuf:"varint,5,opt,name=max_swap_amount_msat,json=maxSwapAmountMsat,proto3" json:"max_swap_amount_msat,omitempty"`
+	BtcPremiumRatePpm   *uint64  `protobuf:"varint,6,opt,name=btc_premium_rate_ppm,json=btcPremiumRatePpm,proto3,oneof" json:"btc_premium_rate_ppm,omitempty"`
+	BtcPremiumFixedSat  *uint64  `protobuf:"varint,7,opt,name=btc_premium_fixed_sat,json=btcPremiumFixedSat,proto3,oneof" json:"btc_premium_fixed_sat,omitempty"`
+	LbtcPremiumRatePpm  *uint64  `protobuf:"varint,8,opt,name=lbtc_premium_rate_ppm,json=lbtcPremiumRatePpm,proto3,oneof" json:"lbtc_premium_rate_ppm,omitempty"`
+	LbtcPremiumFixedSat *uint64  `protobuf:"varint,9,opt,name=lbtc_premium_fixed_sat,json=lbtcPremiumFixedSat,proto3,oneof" json:"lbtc_premium_fixed_sat,omitempty"`
+	MaxConcurrentSwaps  *uint64  `protobuf:"varint,10,opt,name=max_concurrent_swaps,json=maxConcurrentSwaps,proto3,oneof" json:"max_concurrent_swaps,omitempty"`
+	MaxSwapsPerHour     *uint64  `protobuf:"varint,11,opt,name=max_swaps_per_hour,json=maxSwapsPerHour,proto3,oneof" json:"max_swaps_per_hour,omitempty"`
+	MaxSwapsPerDay      *uint64  `protobuf:"varint,12,opt,name=max_swaps_per_day,json=maxSwapsPerDay,proto3,oneof" json:"max_swaps_per_day,omitempty"`
+	BtcMaxSatPerDay     *uint64  `protobuf:"varint,13,opt,name=btc_max_sat_per_day,json=btcMaxSatPerDay,proto3,oneof" json:"btc_max_sat_per_day,omitempty"`
+	LbtcMaxSatPerDay    *uint64  `protobuf:"varint,14,opt,name=lbtc_max_sat_per_day,json=lbtcMaxSatPerDay,proto3,oneof" json:"lbtc_max_sat_per_day,omitempty"`
+}
+
+func (x *PeerPolicy) Reset() {
+	*x = PeerPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[26]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeerSwapNodes) String() string {
+func (x *PeerPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerSwapNodes) ProtoMessage() {}
+func (*PeerPolicy) ProtoMessage() {}
 
-func (x *PeerSwapNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[26]
+func (x *PeerPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,103 +4318,107 @@ func (x *PeerSwapNodes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerSwapNodes.ProtoReflect.Descriptor instead.
-func (*PeerSwapNodes) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PeerPolicy.ProtoReflect.Descriptor instead.
+func (*PeerPolicy) Descriptor() ([]byte, []int) {
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *PeerSwapNodes) GetNodeId() string {
+func (x *PeerPolicy) GetPubkey() string {
 	if x != nil {
-		return x.NodeId
+		return x.Pubkey
 	}
 	return ""
 }
 
-type Policy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PeerPolicy) GetAllowedAssets() []string {
+	if x != nil {
+		return x.AllowedAssets
+	}
+	return nil
+}
 
-	ReserveOnchainMsat uint64   `protobuf:"varint,1,opt,name=reserve_onchain_msat,json=reserveOnchainMsat,proto3" json:"reserve_onchain_msat,omitempty"`
-	MinSwapAmountMsat  uint64   `protobuf:"varint,2,opt,name=min_swap_amount_msat,json=minSwapAmountMsat,proto3" json:"min_swap_amount_msat,omitempty"`
-	AcceptAllPeers     bool     `protobuf:"varint,3,opt,name=accept_all_peers,json=acceptAllPeers,proto3" json:"accept_all_peers,omitempty"`
-	AllowNewSwaps      bool     `protobuf:"varint,4,opt,name=allow_new_swaps,json=allowNewSwaps,proto3" json:"allow_new_swaps,omitempty"`
-	AllowlistedPeers   []string `protobuf:"bytes,5,rep,name=allowlisted_peers,json=allowlistedPeers,proto3" json:"allowlisted_peers,omitempty"`
-	SuspiciousPeerList []string `protobuf:"bytes,6,rep,name=suspicious_peer_list,json=suspiciousPeerList,proto3" json:"suspicious_peer_list,omitempty"`
+func (x *PeerPolicy) GetAllowedSwapTypes() []string {
+	if x != nil {
+		return x.AllowedSwapTypes
+	}
+	return nil
 }
 
-func (x *Policy) Reset() {
-	*x = Policy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PeerPolicy) GetMinSwapAmountMsat() uint64 {
+	if x != nil && x.MinSwapAmountMsat != nil {
+		return *x.MinSwapAmountMsat
 	}
+	return 0
 }
 
-func (x *Policy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PeerPolicy) GetMaxSwapAmountMsat() uint64 {
+	if x != nil {
+		return x.MaxSwapAmountMsat
+	}
+	return 0
 }
 
-func (*Policy) ProtoMessage() {}
+func (x *PeerPolicy) GetBtcPremiumRatePpm() uint64 {
+	if x != nil && x.BtcPremiumRatePpm != nil {
+		return *x.BtcPremiumRatePpm
+	}
+	return 0
+}
 
-func (x *Policy) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PeerPolicy) GetBtcPremiumFixedSat() uint64 {
+	if x != nil && x.BtcPremiumFixedSat != nil {
+		return *x.BtcPremiumFixedSat
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
-func (*Policy) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{27}
+func (x *PeerPolicy) GetLbtcPremiumRatePpm() uint64 {
+	if x != nil && x.LbtcPremiumRatePpm != nil {
+		return *x.LbtcPremiumRatePpm
+	}
+	return 0
 }
 
-func (x *Policy) GetReserveOnchainMsat() uint64 {
-	if x != nil {
-		return x.ReserveOnchainMsat
+func (x *PeerPolicy) GetLbtcPremiumFixedSat() uint64 {
+	if x != nil && x.LbtcPremiumFixedSat != nil {
+		return *x.LbtcPremiumFixedSat
 	}
 	return 0
 }
 
-func (x *Policy) GetMinSwapAmountMsat() uint64 {
-	if x != nil {
-		return x.MinSwapAmountMsat
+func (x *PeerPolicy) GetMaxConcurrentSwaps() uint64 {
+	if x != nil && x.MaxConcurrentSwaps != nil {
+		return *x.MaxConcurrentSwaps
 	}
 	return 0
 }
 
-func (x *Policy) GetAcceptAllPeers() bool {
-	if x != nil {
-		return x.AcceptAllPeers
+func (x *PeerPolicy) GetMaxSwapsPerHour() uint64 {
+	if x != nil && x.MaxSwapsPerHour != nil {
+		return *x.MaxSwapsPerHour
 	}
-	return false
+	return 0
 }
 
-func (x *Policy) GetAllowNewSwaps() bool {
-	if x != nil {
-		return x.AllowNewSwaps
+func (x *PeerPolicy) GetMaxSwapsPerDay() uint64 {
+	if x != nil && x.MaxSwapsPerDay != nil {
+		return *x.MaxSwapsPerDay
 	}
-	return false
+	return 0
 }
 
-func (x *Policy) GetAllowlistedPeers() []string {
-	if x != nil {
-		return x.AllowlistedPeers
+func (x *PeerPolicy) GetBtcMaxSatPerDay() uint64 {
+	if x != nil && x.BtcMaxSatPerDay != nil {
+		return *x.BtcMaxSatPerDay
 	}
-	return nil
+	return 0
 }
 
-func (x *Policy) GetSuspiciousPeerList() []string {
-	if x != nil {
-		return x.SuspiciousPeerList
+func (x *PeerPolicy) GetLbtcMaxSatPerDay() uint64 {
+	if x != nil && x.LbtcMaxSatPerDay != nil {
+		return *x.LbtcMaxSatPerDay
 	}
-	return nil
+	return 0
 }
 
 type AllowSwapRequestsRequest struct {
@@ -1659,7 +4432,7 @@ type AllowSwapRequestsRequest struct {
 func (x *AllowSwapRequestsRequest) Reset() {
 	*x = AllowSwapRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[28]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1672,7 +4445,7 @@ func (x *AllowSwapRequestsRequest) String() string {
 func (*AllowSwapRequestsRequest) ProtoMessage() {}
 
 func (x *AllowSwapRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[28]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,7 +4458,7 @@ func (x *AllowSwapRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowSwapRequestsRequest.ProtoReflect.Descriptor instead.
 func (*AllowSwapRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{28}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AllowSwapRequestsRequest) GetAllow() bool {
@@ -1706,7 +4479,7 @@ type AllowSwapRequestsResponse struct {
 func (x *AllowSwapRequestsResponse) Reset() {
 	*x = AllowSwapRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[29]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1719,7 +4492,7 @@ func (x *AllowSwapRequestsResponse) String() string {
 func (*AllowSwapRequestsResponse) ProtoMessage() {}
 
 func (x *AllowSwapRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[29]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,7 +4505,7 @@ func (x *AllowSwapRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowSwapRequestsResponse.ProtoReflect.Descriptor instead.
 func (*AllowSwapRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{29}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AllowSwapRequestsResponse) GetAllow() bool {
@@ -1751,7 +4524,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[30]
+		mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1764,7 +4537,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[30]
+	mi := &file_peerswaprpc_peerswaprpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +4550,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{30}
+	return file_peerswaprpc_peerswaprpc_proto_rawDescGZIP(), []int{59}
 }
 
 var File_peerswaprpc_peerswaprpc_proto protoreflect.FileDescriptor
@@ -1794,61 +4567,342 @@ var file_peerswaprpc_peerswaprpc_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x74,
 	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
-	0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61,
-	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x73, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x15, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x0e, 0x53, 0x77, 0x61, 0x70, 0x4f,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
-	0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x7b, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x49,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77,
-	0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x72,
-	0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73,
-	0x77, 0x61, 0x70, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x22, 0x12,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x53, 0x77, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22,
-	0x19, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x0e, 0x41, 0x64,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x15, 0x42, 0x74, 0x63,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x53, 0x61, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x53, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x53, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x14, 0x53, 0x65,
+	0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x15, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x53, 0x77,
+	0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x77, 0x61,
+	0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x53, 0x0a, 0x11, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x95, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x22, 0x47, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x74, 0x74,
+	0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x22, 0x3d, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79,
+	0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22,
+	0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x72, 0x0a, 0x12, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56,
+	0x62, 0x79, 0x74, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x77, 0x61,
+	0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xce, 0x02, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x53, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0xcc, 0x08, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a,
+	0x0f, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70,
+	0x61, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x2d, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1e,
+	0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54,
+	0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x46, 0x65, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x65, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61,
+	0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x76, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0xa1,
+	0x01, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12,
+	0x28, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0xdb, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x65, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x77, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x19,
+	0x0a, 0x17, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x11,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a,
-	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x7a, 0x0a, 0x10,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x22, 0xeb, 0x03, 0x0a, 0x11, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x2b, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x10,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x61,
+	0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x6b, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x22, 0xdd, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
@@ -1882,7 +4936,7 @@ var file_peerswaprpc_peerswaprpc_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x00, 0x12,
-	0x0c, 0x0a, 0x08, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x22, 0xa4, 0x03,
+	0x0c, 0x0a, 0x08, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x22, 0xdf, 0x03,
 	0x0a, 0x0f, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x77, 0x61,
 	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
@@ -1909,148 +4963,447 @@ var file_peerswaprpc_peerswaprpc_proto_rawDesc = []byte{
 	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6e, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x49, 0x64, 0x22, 0xb5, 0x02, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61,
-	0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x39,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x53, 0x77, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x73, 0x5f,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x08, 0x61, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x61,
-	0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x61, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x22, 0x98, 0x01, 0x0a,
-	0x13, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x77, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x6f, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x73, 0x4f, 0x75,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x73, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08,
-	0x73, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x73, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x74, 0x73, 0x5f,
-	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x61, 0x74, 0x73, 0x49, 0x6e,
-	0x22, 0x28, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x9c, 0x02, 0x0a, 0x06, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4f, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x9d, 0x03, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x50,
+	0x65, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x61, 0x73,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0a, 0x61, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x22, 0xd4, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x73, 0x76, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x63, 0x73, 0x76, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x6e,
+	0x70, 0x61, 0x69, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x22, 0xa1, 0x05, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x3a, 0x0a, 0x19, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x17, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x73, 0x76, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x73, 0x76, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x66, 0x65, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x73, 0x45, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x22, 0x7b, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70,
+	0x50, 0x65, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x77,
+	0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x22, 0x28, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x77, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0xa7, 0x0b, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x77, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x73, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75,
+	0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x14, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70,
+	0x6d, 0x12, 0x31, 0x0a, 0x15, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x53, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x52, 0x61, 0x74, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x62, 0x74, 0x63, 0x5f,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x62, 0x74, 0x63, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x46, 0x69, 0x78, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x44,
+	0x61, 0x79, 0x12, 0x2c, 0x0a, 0x13, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x62, 0x74, 0x63, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x2e, 0x0a, 0x14, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6c, 0x62, 0x74, 0x63, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x17, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x65,
+	0x65, 0x72, 0x4d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75,
+	0x72, 0x12, 0x32, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x18, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x62, 0x74,
+	0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x65, 0x65, 0x72, 0x42, 0x74, 0x63,
+	0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x19,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x62, 0x74, 0x63, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x4d, 0x0a, 0x24, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x3e, 0x0a, 0x1b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x37, 0x0a, 0x18, 0x62, 0x74, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
+	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x62, 0x74, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6c, 0x62, 0x74,
+	0x63, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6c, 0x62,
+	0x74, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x5f, 0x73, 0x63, 0x69, 0x64, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x53, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb9, 0x07, 0x0a, 0x0a,
+	0x50, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
 	0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x65,
-	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x77, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x4e, 0x65, 0x77, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x75, 0x73, 0x70, 0x69,
-	0x63, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75,
-	0x73, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x18, 0x41, 0x6c, 0x6c,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x34,
+	0x0a, 0x14, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x11,
+	0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x15, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x12, 0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x46, 0x69, 0x78, 0x65, 0x64, 0x53, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x15,
+	0x6c, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x12, 0x6c,
+	0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x13, 0x6c, 0x62, 0x74, 0x63, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x46, 0x69, 0x78, 0x65, 0x64, 0x53, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x06, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x72,
+	0x48, 0x6f, 0x75, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x07, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65,
+	0x72, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x13, 0x62, 0x74, 0x63, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x0f, 0x62, 0x74, 0x63, 0x4d, 0x61, 0x78, 0x53, 0x61,
+	0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x14, 0x6c, 0x62,
+	0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x48, 0x09, 0x52, 0x10, 0x6c, 0x62, 0x74, 0x63,
+	0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x62, 0x74, 0x63,
+	0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70,
+	0x6d, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x6c, 0x62, 0x74, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x70, 0x6d, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x62, 0x74, 0x63, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x6c, 0x62, 0x74, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x22, 0x30, 0x0a, 0x18, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x31, 0x0a, 0x19, 0x41, 0x6c, 0x6c,
 	0x6f, 0x77, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x31, 0x0a, 0x19, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x07,
-	0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x9a, 0x09, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72,
-	0x53, 0x77, 0x61, 0x70, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x12,
-	0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4f,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x07, 0x0a, 0x05,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xe7, 0x11, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x12,
+	0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x06, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72,
 	0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70,
 	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x77, 0x61, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a,
-	0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x21, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x64, 0x64,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a,
-	0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x65,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
+	0x42, 0x75, 0x6d, 0x70, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x77, 0x61, 0x70, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x4a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x22, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x75, 0x73, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x73, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x65,
 	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65,
 	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64,
-	0x64, 0x53, 0x75, 0x73, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75,
-	0x73, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x4d, 0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x53, 0x74,
-	0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x53, 0x0a, 0x0e, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x13, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x42, 0x74, 0x63, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x42, 0x74, 0x63, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x74, 0x63, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x42, 0x74, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2066,93 +5419,169 @@ func file_peerswaprpc_peerswaprpc_proto_rawDescGZIP() []byte {
 }
 
 var file_peerswaprpc_peerswaprpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_peerswaprpc_peerswaprpc_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_peerswaprpc_peerswaprpc_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_peerswaprpc_peerswaprpc_proto_goTypes = []interface{}{
 	(RequestedSwap_SwapType)(0),        // 0: peerswap.RequestedSwap.SwapType
 	(*GetAddressRequest)(nil),          // 1: peerswap.GetAddressRequest
 	(*GetAddressResponse)(nil),         // 2: peerswap.GetAddressResponse
 	(*GetBalanceRequest)(nil),          // 3: peerswap.GetBalanceRequest
 	(*GetBalanceResponse)(nil),         // 4: peerswap.GetBalanceResponse
-	(*SendToAddressRequest)(nil),       // 5: peerswap.SendToAddressRequest
-	(*SendToAddressResponse)(nil),      // 6: peerswap.SendToAddressResponse
-	(*SwapOutRequest)(nil),             // 7: peerswap.SwapOutRequest
-	(*SwapOutResponse)(nil),            // 8: peerswap.SwapOutResponse
-	(*SwapInRequest)(nil),              // 9: peerswap.SwapInRequest
-	(*SwapResponse)(nil),               // 10: peerswap.SwapResponse
-	(*GetSwapRequest)(nil),             // 11: peerswap.GetSwapRequest
-	(*ListSwapsRequest)(nil),           // 12: peerswap.ListSwapsRequest
-	(*ListSwapsResponse)(nil),          // 13: peerswap.ListSwapsResponse
-	(*ListPeersRequest)(nil),           // 14: peerswap.ListPeersRequest
-	(*ListPeersResponse)(nil),          // 15: peerswap.ListPeersResponse
-	(*ReloadPolicyFileRequest)(nil),    // 16: peerswap.ReloadPolicyFileRequest
-	(*AddPeerRequest)(nil),             // 17: peerswap.AddPeerRequest
-	(*RemovePeerRequest)(nil),          // 18: peerswap.RemovePeerRequest
-	(*ListRequestedSwapsRequest)(nil),  // 19: peerswap.ListRequestedSwapsRequest
-	(*ListRequestedSwapsResponse)(nil), // 20: peerswap.ListRequestedSwapsResponse
-	(*RequestSwapList)(nil),            // 21: peerswap.RequestSwapList
-	(*RequestedSwap)(nil),              // 22: peerswap.RequestedSwap
-	(*PrettyPrintSwap)(nil),            // 23: peerswap.PrettyPrintSwap
-	(*PeerSwapPeer)(nil),               // 24: peerswap.PeerSwapPeer
-	(*PeerSwapPeerChannel)(nil),        // 25: peerswap.PeerSwapPeerChannel
-	(*SwapStats)(nil),                  // 26: peerswap.SwapStats
-	(*PeerSwapNodes)(nil),              // 27: peerswap.PeerSwapNodes
-	(*Policy)(nil),                     // 28: peerswap.Policy
-	(*AllowSwapRequestsRequest)(nil),   // 29: peerswap.AllowSwapRequestsRequest
-	(*AllowSwapRequestsResponse)(nil),  // 30: peerswap.AllowSwapRequestsResponse
-	(*Empty)(nil),                      // 31: peerswap.Empty
-	nil,                                // 32: peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry
+	(*BtcGetBalanceResponse)(nil),      // 5: peerswap.BtcGetBalanceResponse
+	(*SendToAddressRequest)(nil),       // 6: peerswap.SendToAddressRequest
+	(*SendToAddressResponse)(nil),      // 7: peerswap.SendToAddressResponse
+	(*SwapOutRequest)(nil),             // 8: peerswap.SwapOutRequest
+	(*SwapOutResponse)(nil),            // 9: peerswap.SwapOutResponse
+	(*BatchSwapOutEntry)(nil),          // 10: peerswap.BatchSwapOutEntry
+	(*BatchSwapOutRequest)(nil),        // 11: peerswap.BatchSwapOutRequest
+	(*BatchSwapOutResponse)(nil),       // 12: peerswap.BatchSwapOutResponse
+	(*SwapInRequest)(nil),              // 13: peerswap.SwapInRequest
+	(*SwapResponse)(nil),               // 14: peerswap.SwapResponse
+	(*GetSwapRequest)(nil),             // 15: peerswap.GetSwapRequest
+	(*CancelSwapRequest)(nil),          // 16: peerswap.CancelSwapRequest
+	(*BumpSwapFeeRequest)(nil),         // 17: peerswap.BumpSwapFeeRequest
+	(*BumpSwapFeeResponse)(nil),        // 18: peerswap.BumpSwapFeeResponse
+	(*SubscribeSwapEventsRequest)(nil), // 19: peerswap.SubscribeSwapEventsRequest
+	(*SwapEvent)(nil),                  // 20: peerswap.SwapEvent
+	(*ExportSwapsRequest)(nil),         // 21: peerswap.ExportSwapsRequest
+	(*ExportSwapsResponse)(nil),        // 22: peerswap.ExportSwapsResponse
+	(*SwapExport)(nil),                 // 23: peerswap.SwapExport
+	(*ArchiveSwapsRequest)(nil),        // 24: peerswap.ArchiveSwapsRequest
+	(*ArchiveSwapsResponse)(nil),       // 25: peerswap.ArchiveSwapsResponse
+	(*ListArchivedSwapsRequest)(nil),   // 26: peerswap.ListArchivedSwapsRequest
+	(*ListArchivedSwapsResponse)(nil),  // 27: peerswap.ListArchivedSwapsResponse
+	(*ArchivedSwap)(nil),               // 28: peerswap.ArchivedSwap
+	(*ListSwapsRequest)(nil),           // 29: peerswap.ListSwapsRequest
+	(*ListSwapsResponse)(nil),          // 30: peerswap.ListSwapsResponse
+	(*ListPeersRequest)(nil),           // 31: peerswap.ListPeersRequest
+	(*ListPeersResponse)(nil),          // 32: peerswap.ListPeersResponse
+	(*ReloadPolicyFileRequest)(nil),    // 33: peerswap.ReloadPolicyFileRequest
+	(*AddPeerRequest)(nil),             // 34: peerswap.AddPeerRequest
+	(*RemovePeerRequest)(nil),          // 35: peerswap.RemovePeerRequest
+	(*EvaluatePolicyRequest)(nil),      // 36: peerswap.EvaluatePolicyRequest
+	(*EvaluatePolicyResponse)(nil),     // 37: peerswap.EvaluatePolicyResponse
+	(*QuoteSwapRequest)(nil),           // 38: peerswap.QuoteSwapRequest
+	(*QuoteSwapResponse)(nil),          // 39: peerswap.QuoteSwapResponse
+	(*PolicyCheck)(nil),                // 40: peerswap.PolicyCheck
+	(*ListRequestedSwapsRequest)(nil),  // 41: peerswap.ListRequestedSwapsRequest
+	(*ListRequestedSwapsResponse)(nil), // 42: peerswap.ListRequestedSwapsResponse
+	(*RequestSwapList)(nil),            // 43: peerswap.RequestSwapList
+	(*RequestedSwap)(nil),              // 44: peerswap.RequestedSwap
+	(*PrettyPrintSwap)(nil),            // 45: peerswap.PrettyPrintSwap
+	(*PeerSwapPeer)(nil),               // 46: peerswap.PeerSwapPeer
+	(*GetPeerReputationRequest)(nil),   // 47: peerswap.GetPeerReputationRequest
+	(*PeerReputation)(nil),             // 48: peerswap.PeerReputation
+	(*GetPeerStatsRequest)(nil),        // 49: peerswap.GetPeerStatsRequest
+	(*PeerStats)(nil),                  // 50: peerswap.PeerStats
+	(*PeerVolume)(nil),                 // 51: peerswap.PeerVolume
+	(*PeerOutcome)(nil),                // 52: peerswap.PeerOutcome
+	(*PeerSwapPeerChannel)(nil),        // 53: peerswap.PeerSwapPeerChannel
+	(*SwapStats)(nil),                  // 54: peerswap.SwapStats
+	(*PeerSwapNodes)(nil),              // 55: peerswap.PeerSwapNodes
+	(*Policy)(nil),                     // 56: peerswap.Policy
+	(*PeerPolicy)(nil),                 // 57: peerswap.PeerPolicy
+	(*AllowSwapRequestsRequest)(nil),   // 58: peerswap.AllowSwapRequestsRequest
+	(*AllowSwapRequestsResponse)(nil),  // 59: peerswap.AllowSwapRequestsResponse
+	(*Empty)(nil),                      // 60: peerswap.Empty
+	nil,                                // 61: peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry
+	nil,                                // 62: peerswap.PeerStats.CancelReasonsEntry
+	nil,                                // 63: peerswap.PeerStats.CancelCodesEntry
 }
 var file_peerswaprpc_peerswaprpc_proto_depIdxs = []int32{
-	23, // 0: peerswap.SwapOutResponse.swap:type_name -> peerswap.PrettyPrintSwap
-	23, // 1: peerswap.SwapResponse.swap:type_name -> peerswap.PrettyPrintSwap
-	23, // 2: peerswap.ListSwapsResponse.swaps:type_name -> peerswap.PrettyPrintSwap
-	24, // 3: peerswap.ListPeersResponse.peers:type_name -> peerswap.PeerSwapPeer
-	32, // 4: peerswap.ListRequestedSwapsResponse.requested_swaps:type_name -> peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry
-	22, // 5: peerswap.RequestSwapList.requested_swaps:type_name -> peerswap.RequestedSwap
-	0,  // 6: peerswap.RequestedSwap.swap_type:type_name -> peerswap.RequestedSwap.SwapType
-	25, // 7: peerswap.PeerSwapPeer.channels:type_name -> peerswap.PeerSwapPeerChannel
-	26, // 8: peerswap.PeerSwapPeer.as_sender:type_name -> peerswap.SwapStats
-	26, // 9: peerswap.PeerSwapPeer.as_receiver:type_name -> peerswap.SwapStats
-	21, // 10: peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry.value:type_name -> peerswap.RequestSwapList
-	7,  // 11: peerswap.PeerSwap.SwapOut:input_type -> peerswap.SwapOutRequest
-	9,  // 12: peerswap.PeerSwap.SwapIn:input_type -> peerswap.SwapInRequest
-	11, // 13: peerswap.PeerSwap.GetSwap:input_type -> peerswap.GetSwapRequest
-	12, // 14: peerswap.PeerSwap.ListSwaps:input_type -> peerswap.ListSwapsRequest
-	14, // 15: peerswap.PeerSwap.ListPeers:input_type -> peerswap.ListPeersRequest
-	19, // 16: peerswap.PeerSwap.ListRequestedSwaps:input_type -> peerswap.ListRequestedSwapsRequest
-	12, // 17: peerswap.PeerSwap.ListActiveSwaps:input_type -> peerswap.ListSwapsRequest
-	29, // 18: peerswap.PeerSwap.AllowSwapRequests:input_type -> peerswap.AllowSwapRequestsRequest
-	16, // 19: peerswap.PeerSwap.ReloadPolicyFile:input_type -> peerswap.ReloadPolicyFileRequest
-	17, // 20: peerswap.PeerSwap.AddPeer:input_type -> peerswap.AddPeerRequest
-	18, // 21: peerswap.PeerSwap.RemovePeer:input_type -> peerswap.RemovePeerRequest
-	17, // 22: peerswap.PeerSwap.AddSusPeer:input_type -> peerswap.AddPeerRequest
-	18, // 23: peerswap.PeerSwap.RemoveSusPeer:input_type -> peerswap.RemovePeerRequest
-	1,  // 24: peerswap.PeerSwap.LiquidGetAddress:input_type -> peerswap.GetAddressRequest
-	3,  // 25: peerswap.PeerSwap.LiquidGetBalance:input_type -> peerswap.GetBalanceRequest
-	5,  // 26: peerswap.PeerSwap.LiquidSendToAddress:input_type -> peerswap.SendToAddressRequest
-	31, // 27: peerswap.PeerSwap.Stop:input_type -> peerswap.Empty
-	10, // 28: peerswap.PeerSwap.SwapOut:output_type -> peerswap.SwapResponse
-	10, // 29: peerswap.PeerSwap.SwapIn:output_type -> peerswap.SwapResponse
-	10, // 30: peerswap.PeerSwap.GetSwap:output_type -> peerswap.SwapResponse
-	13, // 31: peerswap.PeerSwap.ListSwaps:output_type -> peerswap.ListSwapsResponse
-	15, // 32: peerswap.PeerSwap.ListPeers:output_type -> peerswap.ListPeersResponse
-	20, // 33: peerswap.PeerSwap.ListRequestedSwaps:output_type -> peerswap.ListRequestedSwapsResponse
-	13, // 34: peerswap.PeerSwap.ListActiveSwaps:output_type -> peerswap.ListSwapsResponse
-	28, // 35: peerswap.PeerSwap.AllowSwapRequests:output_type -> peerswap.Policy
-	28, // 36: peerswap.PeerSwap.ReloadPolicyFile:output_type -> peerswap.Policy
-	28, // 37: peerswap.PeerSwap.AddPeer:output_type -> peerswap.Policy
-	28, // 38: peerswap.PeerSwap.RemovePeer:output_type -> peerswap.Policy
-	28, // 39: peerswap.PeerSwap.AddSusPeer:output_type -> peerswap.Policy
-	28, // 40: peerswap.PeerSwap.RemoveSusPeer:output_type -> peerswap.Policy
-	2,  // 41: peerswap.PeerSwap.LiquidGetAddress:output_type -> peerswap.GetAddressResponse
-	4,  // 42: peerswap.PeerSwap.LiquidGetBalance:output_type -> peerswap.GetBalanceResponse
-	6,  // 43: peerswap.PeerSwap.LiquidSendToAddress:output_type -> peerswap.SendToAddressResponse
-	31, // 44: peerswap.PeerSwap.Stop:output_type -> peerswap.Empty
-	28, // [28:45] is the sub-list for method output_type
-	11, // [11:28] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	45, // 0: peerswap.SwapOutResponse.swap:type_name -> peerswap.PrettyPrintSwap
+	10, // 1: peerswap.BatchSwapOutRequest.swaps:type_name -> peerswap.BatchSwapOutEntry
+	45, // 2: peerswap.BatchSwapOutResponse.swaps:type_name -> peerswap.PrettyPrintSwap
+	45, // 3: peerswap.SwapResponse.swap:type_name -> peerswap.PrettyPrintSwap
+	23, // 4: peerswap.ExportSwapsResponse.swaps:type_name -> peerswap.SwapExport
+	28, // 5: peerswap.ArchiveSwapsResponse.swaps:type_name -> peerswap.ArchivedSwap
+	28, // 6: peerswap.ListArchivedSwapsResponse.swaps:type_name -> peerswap.ArchivedSwap
+	23, // 7: peerswap.ArchivedSwap.swap:type_name -> peerswap.SwapExport
+	45, // 8: peerswap.ListSwapsResponse.swaps:type_name -> peerswap.PrettyPrintSwap
+	46, // 9: peerswap.ListPeersResponse.peers:type_name -> peerswap.PeerSwapPeer
+	40, // 10: peerswap.EvaluatePolicyResponse.checks:type_name -> peerswap.PolicyCheck
+	40, // 11: peerswap.QuoteSwapResponse.local_checks:type_name -> peerswap.PolicyCheck
+	40, // 12: peerswap.QuoteSwapResponse.remote_checks:type_name -> peerswap.PolicyCheck
+	61, // 13: peerswap.ListRequestedSwapsResponse.requested_swaps:type_name -> peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry
+	44, // 14: peerswap.RequestSwapList.requested_swaps:type_name -> peerswap.RequestedSwap
+	0,  // 15: peerswap.RequestedSwap.swap_type:type_name -> peerswap.RequestedSwap.SwapType
+	53, // 16: peerswap.PeerSwapPeer.channels:type_name -> peerswap.PeerSwapPeerChannel
+	54, // 17: peerswap.PeerSwapPeer.as_sender:type_name -> peerswap.SwapStats
+	54, // 18: peerswap.PeerSwapPeer.as_receiver:type_name -> peerswap.SwapStats
+	57, // 19: peerswap.PeerSwapPeer.policy:type_name -> peerswap.PeerPolicy
+	48, // 20: peerswap.PeerSwapPeer.reputation:type_name -> peerswap.PeerReputation
+	52, // 21: peerswap.PeerReputation.history:type_name -> peerswap.PeerOutcome
+	62, // 22: peerswap.PeerStats.cancel_reasons:type_name -> peerswap.PeerStats.CancelReasonsEntry
+	51, // 23: peerswap.PeerStats.volumes:type_name -> peerswap.PeerVolume
+	23, // 24: peerswap.PeerStats.last_swaps:type_name -> peerswap.SwapExport
+	63, // 25: peerswap.PeerStats.cancel_codes:type_name -> peerswap.PeerStats.CancelCodesEntry
+	57, // 26: peerswap.Policy.peers:type_name -> peerswap.PeerPolicy
+	43, // 27: peerswap.ListRequestedSwapsResponse.RequestedSwapsEntry.value:type_name -> peerswap.RequestSwapList
+	8,  // 28: peerswap.PeerSwap.SwapOut:input_type -> peerswap.SwapOutRequest
+	11, // 29: peerswap.PeerSwap.BatchSwapOut:input_type -> peerswap.BatchSwapOutRequest
+	13, // 30: peerswap.PeerSwap.SwapIn:input_type -> peerswap.SwapInRequest
+	38, // 31: peerswap.PeerSwap.QuoteSwap:input_type -> peerswap.QuoteSwapRequest
+	15, // 32: peerswap.PeerSwap.GetSwap:input_type -> peerswap.GetSwapRequest
+	29, // 33: peerswap.PeerSwap.ListSwaps:input_type -> peerswap.ListSwapsRequest
+	31, // 34: peerswap.PeerSwap.ListPeers:input_type -> peerswap.ListPeersRequest
+	47, // 35: peerswap.PeerSwap.GetPeerReputation:input_type -> peerswap.GetPeerReputationRequest
+	49, // 36: peerswap.PeerSwap.GetPeerStats:input_type -> peerswap.GetPeerStatsRequest
+	41, // 37: peerswap.PeerSwap.ListRequestedSwaps:input_type -> peerswap.ListRequestedSwapsRequest
+	29, // 38: peerswap.PeerSwap.ListActiveSwaps:input_type -> peerswap.ListSwapsRequest
+	17, // 39: peerswap.PeerSwap.BumpSwapFee:input_type -> peerswap.BumpSwapFeeRequest
+	16, // 40: peerswap.PeerSwap.CancelSwap:input_type -> peerswap.CancelSwapRequest
+	19, // 41: peerswap.PeerSwap.SubscribeSwapEvents:input_type -> peerswap.SubscribeSwapEventsRequest
+	21, // 42: peerswap.PeerSwap.ExportSwaps:input_type -> peerswap.ExportSwapsRequest
+	24, // 43: peerswap.PeerSwap.ArchiveSwaps:input_type -> peerswap.ArchiveSwapsRequest
+	26, // 44: peerswap.PeerSwap.ListArchivedSwaps:input_type -> peerswap.ListArchivedSwapsRequest
+	58, // 45: peerswap.PeerSwap.AllowSwapRequests:input_type -> peerswap.AllowSwapRequestsRequest
+	33, // 46: peerswap.PeerSwap.ReloadPolicyFile:input_type -> peerswap.ReloadPolicyFileRequest
+	34, // 47: peerswap.PeerSwap.AddPeer:input_type -> peerswap.AddPeerRequest
+	35, // 48: peerswap.PeerSwap.RemovePeer:input_type -> peerswap.RemovePeerRequest
+	34, // 49: peerswap.PeerSwap.AddSusPeer:input_type -> peerswap.AddPeerRequest
+	35, // 50: peerswap.PeerSwap.RemoveSusPeer:input_type -> peerswap.RemovePeerRequest
+	36, // 51: peerswap.PeerSwap.EvaluatePolicy:input_type -> peerswap.EvaluatePolicyRequest
+	1,  // 52: peerswap.PeerSwap.LiquidGetAddress:input_type -> peerswap.GetAddressRequest
+	3,  // 53: peerswap.PeerSwap.LiquidGetBalance:input_type -> peerswap.GetBalanceRequest
+	6,  // 54: peerswap.PeerSwap.LiquidSendToAddress:input_type -> peerswap.SendToAddressRequest
+	1,  // 55: peerswap.PeerSwap.BtcGetAddress:input_type -> peerswap.GetAddressRequest
+	3,  // 56: peerswap.PeerSwap.BtcGetBalance:input_type -> peerswap.GetBalanceRequest
+	6,  // 57: peerswap.PeerSwap.BtcSendToAddress:input_type -> peerswap.SendToAddressRequest
+	60, // 58: peerswap.PeerSwap.Stop:input_type -> peerswap.Empty
+	14, // 59: peerswap.PeerSwap.SwapOut:output_type -> peerswap.SwapResponse
+	12, // 60: peerswap.PeerSwap.BatchSwapOut:output_type -> peerswap.BatchSwapOutResponse
+	14, // 61: peerswap.PeerSwap.SwapIn:output_type -> peerswap.SwapResponse
+	39, // 62: peerswap.PeerSwap.QuoteSwap:output_type -> peerswap.QuoteSwapResponse
+	14, // 63: peerswap.PeerSwap.GetSwap:output_type -> peerswap.SwapResponse
+	30, // 64: peerswap.PeerSwap.ListSwaps:output_type -> peerswap.ListSwapsResponse
+	32, // 65: peerswap.PeerSwap.ListPeers:output_type -> peerswap.ListPeersResponse
+	48, // 66: peerswap.PeerSwap.GetPeerReputation:output_type -> peerswap.PeerReputation
+	50, // 67: peerswap.PeerSwap.GetPeerStats:output_type -> peerswap.PeerStats
+	42, // 68: peerswap.PeerSwap.ListRequestedSwaps:output_type -> peerswap.ListRequestedSwapsResponse
+	30, // 69: peerswap.PeerSwap.ListActiveSwaps:output_type -> peerswap.ListSwapsResponse
+	18, // 70: peerswap.PeerSwap.BumpSwapFee:output_type -> peerswap.BumpSwapFeeResponse
+	14, // 71: peerswap.PeerSwap.CancelSwap:output_type -> peerswap.SwapResponse
+	20, // 72: peerswap.PeerSwap.SubscribeSwapEvents:output_type -> peerswap.SwapEvent
+	22, // 73: peerswap.PeerSwap.ExportSwaps:output_type -> peerswap.ExportSwapsResponse
+	25, // 74: peerswap.PeerSwap.ArchiveSwaps:output_type -> peerswap.ArchiveSwapsResponse
+	27, // 75: peerswap.PeerSwap.ListArchivedSwaps:output_type -> peerswap.ListArchivedSwapsResponse
+	56, // 76: peerswap.PeerSwap.AllowSwapRequests:output_type -> peerswap.Policy
+	56, // 77: peerswap.PeerSwap.ReloadPolicyFile:output_type -> peerswap.Policy
+	56, // 78: peerswap.PeerSwap.AddPeer:output_type -> peerswap.Policy
+	56, // 79: peerswap.PeerSwap.RemovePeer:output_type -> peerswap.Policy
+	56, // 80: peerswap.PeerSwap.AddSusPeer:output_type -> peerswap.Policy
+	56, // 81: peerswap.PeerSwap.RemoveSusPeer:output_type -> peerswap.Policy
+	37, // 82: peerswap.PeerSwap.EvaluatePolicy:output_type -> peerswap.EvaluatePolicyResponse
+	2,  // 83: peerswap.PeerSwap.LiquidGetAddress:output_type -> peerswap.GetAddressResponse
+	4,  // 84: peerswap.PeerSwap.LiquidGetBalance:output_type -> peerswap.GetBalanceResponse
+	7,  // 85: peerswap.PeerSwap.LiquidSendToAddress:output_type -> peerswap.SendToAddressResponse
+	2,  // 86: peerswap.PeerSwap.BtcGetAddress:output_type -> peerswap.GetAddressResponse
+	5,  // 87: peerswap.PeerSwap.BtcGetBalance:output_type -> peerswap.BtcGetBalanceResponse
+	7,  // 88: peerswap.PeerSwap.BtcSendToAddress:output_type -> peerswap.SendToAddressResponse
+	60, // 89: peerswap.PeerSwap.Stop:output_type -> peerswap.Empty
+	59, // [59:90] is the sub-list for method output_type
+	28, // [28:59] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_peerswaprpc_peerswaprpc_proto_init() }
@@ -2173,8 +5602,128 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressResponse); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BtcGetBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendToAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendToAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapOutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSwapOutEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSwapOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSwapOutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2185,8 +5734,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceRequest); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2197,8 +5746,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2209,8 +5758,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendToAddressRequest); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2221,8 +5770,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendToAddressResponse); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2233,8 +5782,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapOutRequest); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpSwapFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2245,8 +5794,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapOutResponse); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpSwapFeeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2257,8 +5806,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInRequest); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSwapEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2269,8 +5818,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapResponse); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2281,8 +5830,8 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSwapRequest); i {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2293,7 +5842,91 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivedSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivedSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwapsRequest); i {
 			case 0:
 				return &v.state
@@ -2305,7 +5938,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwapsResponse); i {
 			case 0:
 				return &v.state
@@ -2317,7 +5950,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPeersRequest); i {
 			case 0:
 				return &v.state
@@ -2329,7 +5962,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPeersResponse); i {
 			case 0:
 				return &v.state
@@ -2341,7 +5974,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReloadPolicyFileRequest); i {
 			case 0:
 				return &v.state
@@ -2353,7 +5986,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddPeerRequest); i {
 			case 0:
 				return &v.state
@@ -2365,7 +5998,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemovePeerRequest); i {
 			case 0:
 				return &v.state
@@ -2377,7 +6010,67 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRequestedSwapsRequest); i {
 			case 0:
 				return &v.state
@@ -2389,7 +6082,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRequestedSwapsResponse); i {
 			case 0:
 				return &v.state
@@ -2401,7 +6094,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestSwapList); i {
 			case 0:
 				return &v.state
@@ -2413,7 +6106,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestedSwap); i {
 			case 0:
 				return &v.state
@@ -2425,7 +6118,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrettyPrintSwap); i {
 			case 0:
 				return &v.state
@@ -2437,7 +6130,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeerSwapPeer); i {
 			case 0:
 				return &v.state
@@ -2449,7 +6142,79 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerReputationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReputation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeerSwapPeerChannel); i {
 			case 0:
 				return &v.state
@@ -2461,7 +6226,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapStats); i {
 			case 0:
 				return &v.state
@@ -2473,7 +6238,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeerSwapNodes); i {
 			case 0:
 				return &v.state
@@ -2485,7 +6250,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Policy); i {
 			case 0:
 				return &v.state
@@ -2497,7 +6262,19 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllowSwapRequestsRequest); i {
 			case 0:
 				return &v.state
@@ -2509,7 +6286,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllowSwapRequestsResponse); i {
 			case 0:
 				return &v.state
@@ -2521,7 +6298,7 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 				return nil
 			}
 		}
-		file_peerswaprpc_peerswaprpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_peerswaprpc_peerswaprpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -2534,13 +6311,14 @@ func file_peerswaprpc_peerswaprpc_proto_init() {
 			}
 		}
 	}
+	file_peerswaprpc_peerswaprpc_proto_msgTypes[56].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_peerswaprpc_peerswaprpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   32,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,22 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PeerSwapClient interface {
 	SwapOut(ctx context.Context, in *SwapOutRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	BatchSwapOut(ctx context.Context, in *BatchSwapOutRequest, opts ...grpc.CallOption) (*BatchSwapOutResponse, error)
 	SwapIn(ctx context.Context, in *SwapInRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	QuoteSwap(ctx context.Context, in *QuoteSwapRequest, opts ...grpc.CallOption) (*QuoteSwapResponse, error)
 	GetSwap(ctx context.Context, in *GetSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error)
 	ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error)
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	GetPeerReputation(ctx context.Context, in *GetPeerReputationRequest, opts ...grpc.CallOption) (*PeerReputation, error)
+	GetPeerStats(ctx context.Context, in *GetPeerStatsRequest, opts ...grpc.CallOption) (*PeerStats, error)
 	ListRequestedSwaps(ctx context.Context, in *ListRequestedSwapsRequest, opts ...grpc.CallOption) (*ListRequestedSwapsResponse, error)
 	ListActiveSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error)
+	BumpSwapFee(ctx context.Context, in *BumpSwapFeeRequest, opts ...grpc.CallOption) (*BumpSwapFeeResponse, error)
+	CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	SubscribeSwapEvents(ctx context.Context, in *SubscribeSwapEventsRequest, opts ...grpc.CallOption) (PeerSwap_SubscribeSwapEventsClient, error)
+	ExportSwaps(ctx context.Context, in *ExportSwapsRequest, opts ...grpc.CallOption) (*ExportSwapsResponse, error)
+	ArchiveSwaps(ctx context.Context, in *ArchiveSwapsRequest, opts ...grpc.CallOption) (*ArchiveSwapsResponse, error)
+	ListArchivedSwaps(ctx context.Context, in *ListArchivedSwapsRequest, opts ...grpc.CallOption) (*ListArchivedSwapsResponse, error)
 	// policy
 	AllowSwapRequests(ctx context.Context, in *AllowSwapRequestsRequest, opts ...grpc.CallOption) (*Policy, error)
 	ReloadPolicyFile(ctx context.Context, in *ReloadPolicyFileRequest, opts ...grpc.CallOption) (*Policy, error)
@@ -32,10 +42,15 @@ type PeerSwapClient interface {
 	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*Policy, error)
 	AddSusPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*Policy, error)
 	RemoveSusPeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*Policy, error)
+	EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error)
 	// Liquid Stuff
 	LiquidGetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
 	LiquidGetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	LiquidSendToAddress(ctx context.Context, in *SendToAddressRequest, opts ...grpc.CallOption) (*SendToAddressResponse, error)
+	// Bitcoin Stuff
+	BtcGetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
+	BtcGetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*BtcGetBalanceResponse, error)
+	BtcSendToAddress(ctx context.Context, in *SendToAddressRequest, opts ...grpc.CallOption) (*SendToAddressResponse, error)
 	Stop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -56,6 +71,15 @@ func (c *peerSwapClient) SwapOut(ctx context.Context, in *SwapOutRequest, opts .
 	return out, nil
 }
 
+func (c *peerSwapClient) BatchSwapOut(ctx context.Context, in *BatchSwapOutRequest, opts ...grpc.CallOption) (*BatchSwapOutResponse, error) {
+	out := new(BatchSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/BatchSwapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) SwapIn(ctx context.Context, in *SwapInRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
 	out := new(SwapResponse)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/SwapIn", in, out, opts...)
@@ -65,6 +89,15 @@ func (c *peerSwapClient) SwapIn(ctx context.Context, in *SwapInRequest, opts ...
 	return out, nil
 }
 
+func (c *peerSwapClient) QuoteSwap(ctx context.Context, in *QuoteSwapRequest, opts ...grpc.CallOption) (*QuoteSwapResponse, error) {
+	out := new(QuoteSwapResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/QuoteSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) GetSwap(ctx context.Context, in *GetSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
 	out := new(SwapResponse)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/GetSwap", in, out, opts...)
@@ -92,6 +125,24 @@ func (c *peerSwapClient) ListPeers(ctx context.Context, in *ListPeersRequest, op
 	return out, nil
 }
 
+func (c *peerSwapClient) GetPeerReputation(ctx context.Context, in *GetPeerReputationRequest, opts ...grpc.CallOption) (*PeerReputation, error) {
+	out := new(PeerReputation)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/GetPeerReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) GetPeerStats(ctx context.Context, in *GetPeerStatsRequest, opts ...grpc.CallOption) (*PeerStats, error) {
+	out := new(PeerStats)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/GetPeerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) ListRequestedSwaps(ctx context.Context, in *ListRequestedSwapsRequest, opts ...grpc.CallOption) (*ListRequestedSwapsResponse, error) {
 	out := new(ListRequestedSwapsResponse)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/ListRequestedSwaps", in, out, opts...)
@@ -110,6 +161,83 @@ func (c *peerSwapClient) ListActiveSwaps(ctx context.Context, in *ListSwapsReque
 	return out, nil
 }
 
+func (c *peerSwapClient) BumpSwapFee(ctx context.Context, in *BumpSwapFeeRequest, opts ...grpc.CallOption) (*BumpSwapFeeResponse, error) {
+	out := new(BumpSwapFeeResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/BumpSwapFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
+	out := new(SwapResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/CancelSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) SubscribeSwapEvents(ctx context.Context, in *SubscribeSwapEventsRequest, opts ...grpc.CallOption) (PeerSwap_SubscribeSwapEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PeerSwap_ServiceDesc.Streams[0], "/peerswap.PeerSwap/SubscribeSwapEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &peerSwapSubscribeSwapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PeerSwap_SubscribeSwapEventsClient interface {
+	Recv() (*SwapEvent, error)
+	grpc.ClientStream
+}
+
+type peerSwapSubscribeSwapEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *peerSwapSubscribeSwapEventsClient) Recv() (*SwapEvent, error) {
+	m := new(SwapEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *peerSwapClient) ExportSwaps(ctx context.Context, in *ExportSwapsRequest, opts ...grpc.CallOption) (*ExportSwapsResponse, error) {
+	out := new(ExportSwapsResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/ExportSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) ArchiveSwaps(ctx context.Context, in *ArchiveSwapsRequest, opts ...grpc.CallOption) (*ArchiveSwapsResponse, error) {
+	out := new(ArchiveSwapsResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/ArchiveSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) ListArchivedSwaps(ctx context.Context, in *ListArchivedSwapsRequest, opts ...grpc.CallOption) (*ListArchivedSwapsResponse, error) {
+	out := new(ListArchivedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/ListArchivedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) AllowSwapRequests(ctx context.Context, in *AllowSwapRequestsRequest, opts ...grpc.CallOption) (*Policy, error) {
 	out := new(Policy)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/AllowSwapRequests", in, out, opts...)
@@ -164,6 +292,15 @@ func (c *peerSwapClient) RemoveSusPeer(ctx context.Context, in *RemovePeerReques
 	return out, nil
 }
 
+func (c *peerSwapClient) EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error) {
+	out := new(EvaluatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/EvaluatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) LiquidGetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error) {
 	out := new(GetAddressResponse)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/LiquidGetAddress", in, out, opts...)
@@ -191,6 +328,33 @@ func (c *peerSwapClient) LiquidSendToAddress(ctx context.Context, in *SendToAddr
 	return out, nil
 }
 
+func (c *peerSwapClient) BtcGetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error) {
+	out := new(GetAddressResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/BtcGetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) BtcGetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*BtcGetBalanceResponse, error) {
+	out := new(BtcGetBalanceResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/BtcGetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerSwapClient) BtcSendToAddress(ctx context.Context, in *SendToAddressRequest, opts ...grpc.CallOption) (*SendToAddressResponse, error) {
+	out := new(SendToAddressResponse)
+	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/BtcSendToAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peerSwapClient) Stop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/peerswap.PeerSwap/Stop", in, out, opts...)
@@ -205,12 +369,22 @@ func (c *peerSwapClient) Stop(ctx context.Context, in *Empty, opts ...grpc.CallO
 // for forward compatibility
 type PeerSwapServer interface {
 	SwapOut(context.Context, *SwapOutRequest) (*SwapResponse, error)
+	BatchSwapOut(context.Context, *BatchSwapOutRequest) (*BatchSwapOutResponse, error)
 	SwapIn(context.Context, *SwapInRequest) (*SwapResponse, error)
+	QuoteSwap(context.Context, *QuoteSwapRequest) (*QuoteSwapResponse, error)
 	GetSwap(context.Context, *GetSwapRequest) (*SwapResponse, error)
 	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	GetPeerReputation(context.Context, *GetPeerReputationRequest) (*PeerReputation, error)
+	GetPeerStats(context.Context, *GetPeerStatsRequest) (*PeerStats, error)
 	ListRequestedSwaps(context.Context, *ListRequestedSwapsRequest) (*ListRequestedSwapsResponse, error)
 	ListActiveSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
+	BumpSwapFee(context.Context, *BumpSwapFeeRequest) (*BumpSwapFeeResponse, error)
+	CancelSwap(context.Context, *CancelSwapRequest) (*SwapResponse, error)
+	SubscribeSwapEvents(*SubscribeSwapEventsRequest, PeerSwap_SubscribeSwapEventsServer) error
+	ExportSwaps(context.Context, *ExportSwapsRequest) (*ExportSwapsResponse, error)
+	ArchiveSwaps(context.Context, *ArchiveSwapsRequest) (*ArchiveSwapsResponse, error)
+	ListArchivedSwaps(context.Context, *ListArchivedSwapsRequest) (*ListArchivedSwapsResponse, error)
 	// policy
 	AllowSwapRequests(context.Context, *AllowSwapRequestsRequest) (*Policy, error)
 	ReloadPolicyFile(context.Context, *ReloadPolicyFileRequest) (*Policy, error)
@@ -218,10 +392,15 @@ type PeerSwapServer interface {
 	RemovePeer(context.Context, *RemovePeerRequest) (*Policy, error)
 	AddSusPeer(context.Context, *AddPeerRequest) (*Policy, error)
 	RemoveSusPeer(context.Context, *RemovePeerRequest) (*Policy, error)
+	EvaluatePolicy(context.Context, *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error)
 	// Liquid Stuff
 	LiquidGetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
 	LiquidGetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
 	LiquidSendToAddress(context.Context, *SendToAddressRequest) (*SendToAddressResponse, error)
+	// Bitcoin Stuff
+	BtcGetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
+	BtcGetBalance(context.Context, *GetBalanceRequest) (*BtcGetBalanceResponse, error)
+	BtcSendToAddress(context.Context, *SendToAddressRequest) (*SendToAddressResponse, error)
 	Stop(context.Context, *Empty) (*Empty, error)
 	mustEmbedUnimplementedPeerSwapServer()
 }
@@ -233,9 +412,15 @@ type UnimplementedPeerSwapServer struct {
 func (UnimplementedPeerSwapServer) SwapOut(context.Context, *SwapOutRequest) (*SwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
 }
+func (UnimplementedPeerSwapServer) BatchSwapOut(context.Context, *BatchSwapOutRequest) (*BatchSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSwapOut not implemented")
+}
 func (UnimplementedPeerSwapServer) SwapIn(context.Context, *SwapInRequest) (*SwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapIn not implemented")
 }
+func (UnimplementedPeerSwapServer) QuoteSwap(context.Context, *QuoteSwapRequest) (*QuoteSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwap not implemented")
+}
 func (UnimplementedPeerSwapServer) GetSwap(context.Context, *GetSwapRequest) (*SwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSwap not implemented")
 }
@@ -245,12 +430,36 @@ func (UnimplementedPeerSwapServer) ListSwaps(context.Context, *ListSwapsRequest)
 func (UnimplementedPeerSwapServer) ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
 }
+func (UnimplementedPeerSwapServer) GetPeerReputation(context.Context, *GetPeerReputationRequest) (*PeerReputation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReputation not implemented")
+}
+func (UnimplementedPeerSwapServer) GetPeerStats(context.Context, *GetPeerStatsRequest) (*PeerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStats not implemented")
+}
 func (UnimplementedPeerSwapServer) ListRequestedSwaps(context.Context, *ListRequestedSwapsRequest) (*ListRequestedSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRequestedSwaps not implemented")
 }
 func (UnimplementedPeerSwapServer) ListActiveSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListActiveSwaps not implemented")
 }
+func (UnimplementedPeerSwapServer) BumpSwapFee(context.Context, *BumpSwapFeeRequest) (*BumpSwapFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpSwapFee not implemented")
+}
+func (UnimplementedPeerSwapServer) CancelSwap(context.Context, *CancelSwapRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
+func (UnimplementedPeerSwapServer) SubscribeSwapEvents(*SubscribeSwapEventsRequest, PeerSwap_SubscribeSwapEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSwapEvents not implemented")
+}
+func (UnimplementedPeerSwapServer) ExportSwaps(context.Context, *ExportSwapsRequest) (*ExportSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSwaps not implemented")
+}
+func (UnimplementedPeerSwapServer) ArchiveSwaps(context.Context, *ArchiveSwapsRequest) (*ArchiveSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSwaps not implemented")
+}
+func (UnimplementedPeerSwapServer) ListArchivedSwaps(context.Context, *ListArchivedSwapsRequest) (*ListArchivedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedSwaps not implemented")
+}
 func (UnimplementedPeerSwapServer) AllowSwapRequests(context.Context, *AllowSwapRequestsRequest) (*Policy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowSwapRequests not implemented")
 }
@@ -269,6 +478,9 @@ func (UnimplementedPeerSwapServer) AddSusPeer(context.Context, *AddPeerRequest)
 func (UnimplementedPeerSwapServer) RemoveSusPeer(context.Context, *RemovePeerRequest) (*Policy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSusPeer not implemented")
 }
+func (UnimplementedPeerSwapServer) EvaluatePolicy(context.Context, *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePolicy not implemented")
+}
 func (UnimplementedPeerSwapServer) LiquidGetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidGetAddress not implemented")
 }
@@ -278,6 +490,15 @@ func (UnimplementedPeerSwapServer) LiquidGetBalance(context.Context, *GetBalance
 func (UnimplementedPeerSwapServer) LiquidSendToAddress(context.Context, *SendToAddressRequest) (*SendToAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidSendToAddress not implemented")
 }
+func (UnimplementedPeerSwapServer) BtcGetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BtcGetAddress not implemented")
+}
+func (UnimplementedPeerSwapServer) BtcGetBalance(context.Context, *GetBalanceRequest) (*BtcGetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BtcGetBalance not implemented")
+}
+func (UnimplementedPeerSwapServer) BtcSendToAddress(context.Context, *SendToAddressRequest) (*SendToAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BtcSendToAddress not implemented")
+}
 func (UnimplementedPeerSwapServer) Stop(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -312,6 +533,24 @@ func _PeerSwap_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_BatchSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).BatchSwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/BatchSwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).BatchSwapOut(ctx, req.(*BatchSwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SwapInRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +569,24 @@ func _PeerSwap_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_QuoteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).QuoteSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/QuoteSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).QuoteSwap(ctx, req.(*QuoteSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_GetSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSwapRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +641,42 @@ func _PeerSwap_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_GetPeerReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).GetPeerReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/GetPeerReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).GetPeerReputation(ctx, req.(*GetPeerReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_GetPeerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).GetPeerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/GetPeerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).GetPeerStats(ctx, req.(*GetPeerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_ListRequestedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRequestedSwapsRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +713,117 @@ func _PeerSwap_ListActiveSwaps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_BumpSwapFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpSwapFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).BumpSwapFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/BumpSwapFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).BumpSwapFee(ctx, req.(*BumpSwapFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/CancelSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).CancelSwap(ctx, req.(*CancelSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_SubscribeSwapEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSwapEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PeerSwapServer).SubscribeSwapEvents(m, &peerSwapSubscribeSwapEventsServer{stream})
+}
+
+type PeerSwap_SubscribeSwapEventsServer interface {
+	Send(*SwapEvent) error
+	grpc.ServerStream
+}
+
+type peerSwapSubscribeSwapEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *peerSwapSubscribeSwapEventsServer) Send(m *SwapEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PeerSwap_ExportSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).ExportSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/ExportSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).ExportSwaps(ctx, req.(*ExportSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_ArchiveSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).ArchiveSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/ArchiveSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).ArchiveSwaps(ctx, req.(*ArchiveSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_ListArchivedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).ListArchivedSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/ListArchivedSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).ListArchivedSwaps(ctx, req.(*ListArchivedSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_AllowSwapRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllowSwapRequestsRequest)
 	if err := dec(in); err != nil {
@@ -528,6 +932,24 @@ func _PeerSwap_RemoveSusPeer_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_EvaluatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).EvaluatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/EvaluatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).EvaluatePolicy(ctx, req.(*EvaluatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_LiquidGetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAddressRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1004,60 @@ func _PeerSwap_LiquidSendToAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerSwap_BtcGetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).BtcGetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/BtcGetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).BtcGetAddress(ctx, req.(*GetAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_BtcGetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).BtcGetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/BtcGetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).BtcGetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerSwap_BtcSendToAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerSwapServer).BtcSendToAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peerswap.PeerSwap/BtcSendToAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerSwapServer).BtcSendToAddress(ctx, req.(*SendToAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeerSwap_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -611,10 +1087,18 @@ var PeerSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapOut",
 			Handler:    _PeerSwap_SwapOut_Handler,
 		},
+		{
+			MethodName: "BatchSwapOut",
+			Handler:    _PeerSwap_BatchSwapOut_Handler,
+		},
 		{
 			MethodName: "SwapIn",
 			Handler:    _PeerSwap_SwapIn_Handler,
 		},
+		{
+			MethodName: "QuoteSwap",
+			Handler:    _PeerSwap_QuoteSwap_Handler,
+		},
 		{
 			MethodName: "GetSwap",
 			Handler:    _PeerSwap_GetSwap_Handler,
@@ -627,6 +1111,14 @@ var PeerSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPeers",
 			Handler:    _PeerSwap_ListPeers_Handler,
 		},
+		{
+			MethodName: "GetPeerReputation",
+			Handler:    _PeerSwap_GetPeerReputation_Handler,
+		},
+		{
+			MethodName: "GetPeerStats",
+			Handler:    _PeerSwap_GetPeerStats_Handler,
+		},
 		{
 			MethodName: "ListRequestedSwaps",
 			Handler:    _PeerSwap_ListRequestedSwaps_Handler,
@@ -635,6 +1127,26 @@ var PeerSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListActiveSwaps",
 			Handler:    _PeerSwap_ListActiveSwaps_Handler,
 		},
+		{
+			MethodName: "BumpSwapFee",
+			Handler:    _PeerSwap_BumpSwapFee_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _PeerSwap_CancelSwap_Handler,
+		},
+		{
+			MethodName: "ExportSwaps",
+			Handler:    _PeerSwap_ExportSwaps_Handler,
+		},
+		{
+			MethodName: "ArchiveSwaps",
+			Handler:    _PeerSwap_ArchiveSwaps_Handler,
+		},
+		{
+			MethodName: "ListArchivedSwaps",
+			Handler:    _PeerSwap_ListArchivedSwaps_Handler,
+		},
 		{
 			MethodName: "AllowSwapRequests",
 			Handler:    _PeerSwap_AllowSwapRequests_Handler,
@@ -659,6 +1171,10 @@ var PeerSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSusPeer",
 			Handler:    _PeerSwap_RemoveSusPeer_Handler,
 		},
+		{
+			MethodName: "EvaluatePolicy",
+			Handler:    _PeerSwap_EvaluatePolicy_Handler,
+		},
 		{
 			MethodName: "LiquidGetAddress",
 			Handler:    _PeerSwap_LiquidGetAddress_Handler,
@@ -671,11 +1187,29 @@ var PeerSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidSendToAddress",
 			Handler:    _PeerSwap_LiquidSendToAddress_Handler,
 		},
+		{
+			MethodName: "BtcGetAddress",
+			Handler:    _PeerSwap_BtcGetAddress_Handler,
+		},
+		{
+			MethodName: "BtcGetBalance",
+			Handler:    _PeerSwap_BtcGetBalance_Handler,
+		},
+		{
+			MethodName: "BtcSendToAddress",
+			Handler:    _PeerSwap_BtcSendToAddress_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _PeerSwap_Stop_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSwapEvents",
+			Handler:       _PeerSwap_SubscribeSwapEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "peerswaprpc/peerswaprpc.proto",
 }
@@ -143,7 +143,7 @@ func (p *PeerswapServer) SwapOut(ctx context.Context, request *SwapOutRequest) (
 		return nil, fmt.Errorf("peer is not connected")
 	}
 
-	swapOut, err := p.swaps.SwapOut(peerId, request.Asset, shortId.String(), pk, request.SwapAmount)
+	swapOut, err := p.swaps.SwapOut(peerId, request.Asset, shortId.String(), pk, request.SwapAmount, request.MaxPremium)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +169,92 @@ func (p *PeerswapServer) SwapOut(ctx context.Context, request *SwapOutRequest) (
 	return &SwapResponse{Swap: PrettyprintFromServiceSwap(swapOut)}, nil
 }
 
+// BatchSwapOut starts a swap-out for every requested channel. Swap-outs with
+// the same peer are funded by the peer in a single opening transaction.
+func (p *PeerswapServer) BatchSwapOut(ctx context.Context, request *BatchSwapOutRequest) (*BatchSwapOutResponse, error) {
+	if len(request.Swaps) == 0 {
+		return nil, errors.New("Missing required swaps parameter")
+	}
+	if strings.Compare(request.Asset, "btc") != 0 {
+		return nil, errors.New("invalid asset, batched swap-outs are only supported for btc")
+	}
+	if !p.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+
+	chans, err := p.lnd.ListChannels(ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	channels := make(map[uint64]*lnrpc.Channel)
+	for _, v := range chans.Channels {
+		channels[v.ChanId] = v
+	}
+
+	var requests []*swap.BatchSwapOutRequest
+	for _, entry := range request.Swaps {
+		if entry.SwapAmount <= 0 {
+			return nil, errors.New("Missing required swap_amount parameter")
+		}
+		swapchan, ok := channels[entry.ChannelId]
+		if !ok {
+			return nil, fmt.Errorf("channel %d not found", entry.ChannelId)
+		}
+		if uint64(swapchan.LocalBalance) < (entry.SwapAmount + 5000) {
+			return nil, fmt.Errorf("not enough local balance on channel %d to perform swap out", entry.ChannelId)
+		}
+		if !swapchan.Active {
+			return nil, fmt.Errorf("channel %d is not connected", entry.ChannelId)
+		}
+
+		peerId := swapchan.RemotePubkey
+		// Skip this test if force flag is set.
+		if !request.Force && !p.peerRunsPeerSwap(peerId) {
+			return nil, fmt.Errorf("peer %s does not run peerswap", peerId)
+		}
+		if !p.isPeerConnected(ctx, peerId) {
+			return nil, fmt.Errorf("peer %s is not connected", peerId)
+		}
+
+		requests = append(requests, &swap.BatchSwapOutRequest{
+			PeerId:    peerId,
+			ChannelId: lnwire.NewShortChanIDFromInt(swapchan.ChanId).String(),
+			AmtSat:    entry.SwapAmount,
+		})
+	}
+
+	gi, err := p.lnd.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	swapOuts, err := p.swaps.BatchSwapOut(request.Asset, gi.IdentityPubkey, requests, request.MaxPremium)
+	if err != nil {
+		return nil, err
+	}
+
+	// In order to be responsive we wait for the `opening_tx` of all swaps to
+	// be sent before we return. The peer waits for all swaps of a batch before
+	// funding them, so we share a single, longer timeout.
+	deadline := time.Now().Add(90 * time.Second)
+	res := &BatchSwapOutResponse{}
+	for _, swapOut := range swapOuts {
+		if !swapOut.WaitForStateChange(func(st swap.StateType) bool {
+			switch st {
+			case swap.State_SwapOutSender_AwaitTxConfirmation, swap.State_SwapCanceled:
+				return true
+			default:
+				return false
+			}
+		}, time.Until(deadline)) {
+			// Timeout.
+			return nil, errors.New("rpc timeout reached, use peerswap-listswaps for info")
+		}
+		res.Swaps = append(res.Swaps, PrettyprintFromServiceSwap(swapOut))
+	}
+	return res, nil
+}
+
 // isPeerConnected returns true if the peer is connected to the lnd node.
 func (p *PeerswapServer) isPeerConnected(ctx context.Context, peerId string) bool {
 	peers, err := p.lnd.ListPeers(ctx, &lnrpc.ListPeersRequest{})
@@ -250,7 +336,7 @@ func (p *PeerswapServer) SwapIn(ctx context.Context, request *SwapInRequest) (*S
 		return nil, fmt.Errorf("peer is not connected")
 	}
 
-	swapIn, err := p.swaps.SwapIn(peerId, request.Asset, shortId.String(), pk, request.SwapAmount)
+	swapIn, err := p.swaps.SwapIn(peerId, request.Asset, shortId.String(), pk, request.SwapAmount, request.MaxPremium)
 	if err != nil {
 		return nil, err
 	}
@@ -288,21 +374,78 @@ func (p *PeerswapServer) GetSwap(ctx context.Context, request *GetSwapRequest) (
 }
 
 func (p *PeerswapServer) ListSwaps(ctx context.Context, request *ListSwapsRequest) (*ListSwapsResponse, error) {
-	swaps, err := p.swaps.ListSwaps()
+	filter, err := NewListSwapsFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	swaps, nextCursor, err := p.swaps.ListSwapsFiltered(filter)
 	if err != nil {
 		return nil, err
 	}
-	sort.Slice(swaps, func(i, j int) bool {
-		if swaps[i].Data != nil && swaps[j].Data != nil {
-			return swaps[i].Data.CreatedAt < swaps[j].Data.CreatedAt
-		}
-		return false
-	})
 	var resSwaps []*PrettyPrintSwap
 	for _, v := range swaps {
 		resSwaps = append(resSwaps, PrettyprintFromServiceSwap(v))
 	}
-	return &ListSwapsResponse{Swaps: resSwaps}, nil
+	return &ListSwapsResponse{Swaps: resSwaps, NextCursor: nextCursor}, nil
+}
+
+// NewListSwapsFilter returns the filter of the request.
+func NewListSwapsFilter(request *ListSwapsRequest) (*swap.ListSwapsFilter, error) {
+	filter := &swap.ListSwapsFilter{
+		PeerNodeId: request.PeerNodeId,
+		ChannelId:  request.ChannelId,
+		Asset:      request.Asset,
+		Descending: request.Descending,
+		Cursor:     request.Cursor,
+		Limit:      int(request.Limit),
+	}
+
+	switch request.Type {
+	case "":
+	case swap.SWAPTYPE_OUT.String():
+		swapType := swap.SWAPTYPE_OUT
+		filter.Type = &swapType
+	case swap.SWAPTYPE_IN.String():
+		swapType := swap.SWAPTYPE_IN
+		filter.Type = &swapType
+	default:
+		return nil, fmt.Errorf("unknown type %s, expected swap-out or swap-in", request.Type)
+	}
+
+	switch request.Role {
+	case "":
+	case swap.SWAPROLE_SENDER.String():
+		role := swap.SWAPROLE_SENDER
+		filter.Role = &role
+	case swap.SWAPROLE_RECEIVER.String():
+		role := swap.SWAPROLE_RECEIVER
+		filter.Role = &role
+	default:
+		return nil, fmt.Errorf("unknown role %s, expected sender or receiver", request.Role)
+	}
+
+	switch request.Status {
+	case "":
+	case "active":
+		finished := false
+		filter.Finished = &finished
+	case "finished":
+		finished := true
+		filter.Finished = &finished
+	default:
+		return nil, fmt.Errorf("unknown status %s, expected active or finished", request.Status)
+	}
+
+	for _, state := range request.States {
+		filter.States = append(filter.States, swap.StateType(state))
+	}
+	if request.CreatedAfter != 0 {
+		filter.From = time.Unix(request.CreatedAfter, 0)
+	}
+	if request.CreatedBefore != 0 {
+		filter.To = time.Unix(request.CreatedBefore, 0)
+	}
+	return filter, nil
 }
 
 func (p *PeerswapServer) ListPeers(ctx context.Context, request *ListPeersRequest) (*ListPeersResponse, error) {
@@ -328,6 +471,10 @@ func (p *PeerswapServer) ListPeers(ctx context.Context, request *ListPeersReques
 			if err != nil {
 				return nil, err
 			}
+			reputation, err := p.swaps.GetPeerReputation(v.PubKey)
+			if err != nil {
+				return nil, err
+			}
 
 			var paidFees uint64
 			var ReceiverSwapsOut, ReceiverSwapsIn, ReceiverSatsOut, ReceiverSatsIn uint64
@@ -374,7 +521,9 @@ func (p *PeerswapServer) ListPeers(ctx context.Context, request *ListPeersReques
 					SatsOut:  ReceiverSatsOut,
 					SatsIn:   ReceiverSatsIn,
 				},
-				PaidFee: paidFees,
+				PaidFee:    paidFees,
+				Policy:     GetPeerPolicyMessage(p.policy.GetPeerPolicy(v.PubKey)),
+				Reputation: newPeerReputationMessage(reputation),
 			})
 		}
 
@@ -492,6 +641,65 @@ func (p *PeerswapServer) LiquidSendToAddress(ctx context.Context, request *SendT
 	return &SendToAddressResponse{TxId: res}, nil
 }
 
+func (p *PeerswapServer) BtcGetAddress(ctx context.Context, request *GetAddressRequest) (*GetAddressResponse, error) {
+	if !p.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+
+	res, err := p.lnd.NewAddress(ctx, &lnrpc.NewAddressRequest{Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH})
+	if err != nil {
+		return nil, err
+	}
+	return &GetAddressResponse{Address: res.Address}, nil
+}
+
+func (p *PeerswapServer) BtcGetBalance(ctx context.Context, request *GetBalanceRequest) (*BtcGetBalanceResponse, error) {
+	if !p.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+
+	res, err := p.lnd.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &BtcGetBalanceResponse{
+		ConfirmedSatAmount:   uint64(res.ConfirmedBalance),
+		UnconfirmedSatAmount: uint64(res.UnconfirmedBalance),
+		ReservedSatAmount:    p.swaps.ReservedOnchainSat("btc"),
+	}, nil
+}
+
+func (p *PeerswapServer) BtcSendToAddress(ctx context.Context, request *SendToAddressRequest) (*SendToAddressResponse, error) {
+	if !p.swaps.BitcoinEnabled {
+		return nil, errors.New("bitcoin swaps are not enabled")
+	}
+
+	if request.Address == "" {
+		return nil, errors.New("address not set")
+	}
+	if request.SatAmount < 546 {
+		return nil, errors.New("amount is dust")
+	}
+
+	balance, err := p.lnd.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	err = p.swaps.CheckSpendable("btc", uint64(balance.ConfirmedBalance), request.SatAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.lnd.SendCoins(ctx, &lnrpc.SendCoinsRequest{
+		Addr:   request.Address,
+		Amount: int64(request.SatAmount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SendToAddressResponse{TxId: res.Txid}, nil
+}
+
 func (p *PeerswapServer) ListActiveSwaps(ctx context.Context, request *ListSwapsRequest) (*ListSwapsResponse, error) {
 	swaps, err := p.swaps.ListActiveSwaps()
 	if err != nil {
@@ -510,6 +718,327 @@ func (p *PeerswapServer) ListActiveSwaps(ctx context.Context, request *ListSwaps
 	return &ListSwapsResponse{Swaps: resSwaps}, nil
 }
 
+func (p *PeerswapServer) BumpSwapFee(ctx context.Context, request *BumpSwapFeeRequest) (*BumpSwapFeeResponse, error) {
+	if request.SwapId == "" {
+		return nil, errors.New("SwapId required")
+	}
+	bump, err := p.swaps.BumpSwapFee(request.SwapId, request.TargetConf, request.SatPerVbyte)
+	if err != nil {
+		return nil, err
+	}
+	return &BumpSwapFeeResponse{
+		SwapId:      request.SwapId,
+		Tx:          string(bump.Tx),
+		Method:      string(bump.Method),
+		Txid:        bump.TxId,
+		SatPerVbyte: bump.SatPerVbyte,
+	}, nil
+}
+
+func (p *PeerswapServer) CancelSwap(ctx context.Context, request *CancelSwapRequest) (*SwapResponse, error) {
+	if request.SwapId == "" {
+		return nil, errors.New("SwapId required")
+	}
+	swapRes, err := p.swaps.CancelSwap(request.SwapId, request.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapResponse{Swap: PrettyprintFromServiceSwap(swapRes)}, nil
+}
+
+func (p *PeerswapServer) SubscribeSwapEvents(request *SubscribeSwapEventsRequest, stream PeerSwap_SubscribeSwapEventsServer) error {
+	replay, events, cancel := p.swaps.SubscribeSwapEvents(request.ReplaySince)
+	defer cancel()
+
+	for _, event := range replay {
+		if err := stream.Send(newSwapEventMessage(event)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return errors.New("subscriber too slow, resubscribe with replay_since")
+			}
+			if err := stream.Send(newSwapEventMessage(event)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newSwapEventMessage(event *swap.SwapEvent) *SwapEvent {
+	return &SwapEvent{
+		Timestamp:   event.Timestamp,
+		SwapId:      event.SwapId,
+		PeerNodeId:  event.PeerNodeId,
+		Type:        event.Type,
+		Role:        event.Role,
+		Asset:       event.Asset,
+		Amount:      event.Amount,
+		OldState:    string(event.OldState),
+		NewState:    string(event.NewState),
+		OpeningTxId: event.OpeningTxId,
+		ClaimTxId:   event.ClaimTxId,
+		Error:       event.Error,
+	}
+}
+
+func (p *PeerswapServer) ExportSwaps(ctx context.Context, request *ExportSwapsRequest) (*ExportSwapsResponse, error) {
+	filter := &swap.ExportFilter{PeerNodeId: request.PeerNodeId}
+	if request.StartTime != 0 {
+		filter.From = time.Unix(request.StartTime, 0)
+	}
+	if request.EndTime != 0 {
+		filter.To = time.Unix(request.EndTime, 0)
+	}
+	exports, err := p.swaps.ExportSwaps(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.Format {
+	case "", "json":
+		res := &ExportSwapsResponse{}
+		for _, e := range exports {
+			res.Swaps = append(res.Swaps, newSwapExportMessage(e))
+		}
+		return res, nil
+	case "csv":
+		var buf strings.Builder
+		if err := swap.WriteSwapExportsCsv(&buf, exports); err != nil {
+			return nil, err
+		}
+		return &ExportSwapsResponse{Csv: buf.String()}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %s, expected json or csv", request.Format)
+	}
+}
+
+func newSwapExportMessage(e *swap.SwapExport) *SwapExport {
+	return &SwapExport{
+		SwapId:                  e.SwapId,
+		CreatedAt:               e.CreatedAt,
+		FinishedAt:              e.FinishedAt,
+		Type:                    e.Type,
+		Role:                    e.Role,
+		Asset:                   e.Asset,
+		State:                   e.State,
+		PeerNodeId:              e.PeerNodeId,
+		InitiatorNodeId:         e.InitiatorNodeId,
+		ChannelId:               e.ChannelId,
+		AmountSat:               e.AmountSat,
+		AmountMsat:              e.AmountMsat,
+		PremiumSat:              e.PremiumSat,
+		PremiumMsat:             e.PremiumMsat,
+		FeeInvoicePaymentHash:   e.FeeInvoicePaymentHash,
+		FeeInvoiceSat:           e.FeeInvoiceSat,
+		FeeInvoiceMsat:          e.FeeInvoiceMsat,
+		FeeInvoicePaid:          e.FeeInvoicePaid,
+		ClaimInvoicePaymentHash: e.ClaimInvoicePaymentHash,
+		ClaimInvoiceSat:         e.ClaimInvoiceSat,
+		ClaimInvoiceMsat:        e.ClaimInvoiceMsat,
+		ClaimInvoicePaid:        e.ClaimInvoicePaid,
+		OpeningTxId:             e.OpeningTxId,
+		OpeningTxFeeSat:         e.OpeningTxFeeSat,
+		OpeningTxFeeMsat:        e.OpeningTxFeeMsat,
+		ClaimTxId:               e.ClaimTxId,
+		ClaimTxFeeSat:           e.ClaimTxFeeSat,
+		ClaimTxFeeMsat:          e.ClaimTxFeeMsat,
+		NetSat:                  e.NetSat,
+		NetMsat:                 e.NetMsat,
+	}
+}
+
+func (p *PeerswapServer) ArchiveSwaps(ctx context.Context, request *ArchiveSwapsRequest) (*ArchiveSwapsResponse, error) {
+	archived, err := p.swaps.ArchiveSwaps(time.Duration(request.OlderThanDays) * 24 * time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	res := &ArchiveSwapsResponse{}
+	for _, a := range archived {
+		res.Swaps = append(res.Swaps, newArchivedSwapMessage(a))
+	}
+	return res, nil
+}
+
+func (p *PeerswapServer) ListArchivedSwaps(ctx context.Context, request *ListArchivedSwapsRequest) (*ListArchivedSwapsResponse, error) {
+	filter := &swap.ExportFilter{PeerNodeId: request.PeerNodeId}
+	if request.StartTime != 0 {
+		filter.From = time.Unix(request.StartTime, 0)
+	}
+	if request.EndTime != 0 {
+		filter.To = time.Unix(request.EndTime, 0)
+	}
+	archived, err := p.swaps.ListArchivedSwaps(filter)
+	if err != nil {
+		return nil, err
+	}
+	res := &ListArchivedSwapsResponse{}
+	for _, a := range archived {
+		res.Swaps = append(res.Swaps, newArchivedSwapMessage(a))
+	}
+	return res, nil
+}
+
+func newArchivedSwapMessage(a *swap.ArchivedSwap) *ArchivedSwap {
+	return &ArchivedSwap{
+		Swap:          newSwapExportMessage(&a.SwapExport),
+		CancelMessage: a.CancelMessage,
+		ArchivedAt:    a.ArchivedAt,
+		CancelCode:    string(a.CancelCode),
+	}
+}
+
+func (p *PeerswapServer) EvaluatePolicy(ctx context.Context, request *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error) {
+	if request.PeerPubkey == "" {
+		return nil, errors.New("missing required peer_pubkey parameter")
+	}
+	if request.AmountSat == 0 {
+		return nil, errors.New("missing required amount_sat parameter")
+	}
+	swapType, err := swap.ParseSwapType(request.Type)
+	if err != nil {
+		return nil, err
+	}
+	var channelId string
+	if request.ChannelId != 0 {
+		channelId = lnwire.NewShortChanIDFromInt(request.ChannelId).String()
+	}
+	eval, err := p.swaps.EvaluatePolicy(request.PeerPubkey, request.Asset, swapType, request.AmountSat, channelId)
+	if err != nil {
+		return nil, err
+	}
+	return &EvaluatePolicyResponse{
+		Accepted:   eval.Accepted,
+		PremiumSat: eval.PremiumSat,
+		Checks:     getPolicyCheckMessages(eval.Checks),
+	}, nil
+}
+
+func (p *PeerswapServer) QuoteSwap(ctx context.Context, request *QuoteSwapRequest) (*QuoteSwapResponse, error) {
+	if request.ChannelId == 0 {
+		return nil, errors.New("missing required channel_id parameter")
+	}
+	if request.AmountSat == 0 {
+		return nil, errors.New("missing required amount_sat parameter")
+	}
+	swapType, err := swap.ParseSwapType(request.Type)
+	if err != nil {
+		return nil, err
+	}
+	chans, err := p.lnd.ListChannels(ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	var swapchan *lnrpc.Channel
+	for _, v := range chans.Channels {
+		if v.ChanId == request.ChannelId {
+			swapchan = v
+		}
+	}
+	if swapchan == nil {
+		return nil, errors.New("channel not found")
+	}
+
+	terms, err := p.pollService.GetPeerTerms(swapchan.RemotePubkey)
+	if err != nil {
+		return nil, err
+	}
+	channelId := lnwire.NewShortChanIDFromInt(swapchan.ChanId).String()
+	quote, err := p.swaps.QuoteSwap(swapchan.RemotePubkey, request.Asset, swapType, request.AmountSat, channelId, terms)
+	if err != nil {
+		return nil, err
+	}
+	return &QuoteSwapResponse{
+		PeerPubkey:       quote.PeerNodeId,
+		OpeningTxFeeSat:  quote.OpeningTxFeeSat,
+		ClaimTxFeeSat:    quote.ClaimTxFeeSat,
+		PremiumSat:       quote.PremiumSat,
+		PremiumKnown:     quote.PremiumKnown,
+		TotalCostSat:     quote.TotalCostSat,
+		MaxSwapAmountSat: quote.MaxSwapAmountSat,
+		LocalAccepted:    quote.LocalAccepted,
+		LocalChecks:      getPolicyCheckMessages(quote.LocalChecks),
+		RemoteAccepted:   quote.RemoteAccepted,
+		RemoteChecks:     getPolicyCheckMessages(quote.RemoteChecks),
+	}, nil
+}
+
+func (p *PeerswapServer) GetPeerReputation(ctx context.Context, request *GetPeerReputationRequest) (*PeerReputation, error) {
+	if request.NodeId == "" {
+		return nil, errors.New("missing required node_id parameter")
+	}
+	reputation, err := p.swaps.GetPeerReputation(request.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	return newPeerReputationMessage(reputation), nil
+}
+
+func (p *PeerswapServer) GetPeerStats(ctx context.Context, request *GetPeerStatsRequest) (*PeerStats, error) {
+	if request.NodeId == "" {
+		return nil, errors.New("missing required node_id parameter")
+	}
+	stats, err := p.swaps.GetPeerStats(request.NodeId, int(request.Swaps))
+	if err != nil {
+		return nil, err
+	}
+	res := &PeerStats{
+		NodeId:                  stats.PeerNodeId,
+		Swaps:                   stats.Swaps,
+		Successes:               stats.Successes,
+		SuccessRate:             stats.SuccessRate,
+		MedianCompletionSeconds: stats.MedianCompletionSeconds,
+		CsvClaims:               stats.CsvClaims,
+		CancelReasons:           stats.CancelReasons,
+		CancelCodes:             map[string]uint64{},
+		FeesPaidSat:             stats.FeesPaidSat,
+		FeesEarnedSat:           stats.FeesEarnedSat,
+	}
+	for code, count := range stats.CancelCodes {
+		res.CancelCodes[string(code)] = count
+	}
+	for _, v := range stats.Volumes {
+		res.Volumes = append(res.Volumes, &PeerVolume{
+			Asset:     v.Asset,
+			Type:      v.Type,
+			Role:      v.Role,
+			Swaps:     v.Swaps,
+			AmountSat: v.AmountSat,
+		})
+	}
+	for _, e := range stats.LastSwaps {
+		res.LastSwaps = append(res.LastSwaps, newSwapExportMessage(e))
+	}
+	return res, nil
+}
+
+func newPeerReputationMessage(r *swap.PeerReputation) *PeerReputation {
+	res := &PeerReputation{
+		NodeId:                  r.PeerNodeId,
+		Score:                   r.Score,
+		Limited:                 r.Limited,
+		Successes:               r.Successes,
+		CsvClaims:               r.CsvClaims,
+		UnpaidClaimInvoices:     r.UnpaidClaimInvoices,
+		CanceledAfterFeeInvoice: r.CanceledAfterFeeInvoice,
+		Timeouts:                r.Timeouts,
+	}
+	for _, o := range r.History {
+		res.History = append(res.History, &PeerOutcome{
+			SwapId:     o.SwapId,
+			Outcome:    string(o.Outcome),
+			FinishedAt: o.FinishedAt,
+			Penalty:    o.Outcome.Penalty(),
+		})
+	}
+	return res
+}
+
 func (p *PeerswapServer) AllowSwapRequests(ctx context.Context, request *AllowSwapRequestsRequest) (*Policy, error) {
 	if request.Allow {
 		p.policy.EnableSwaps()
@@ -547,6 +1076,8 @@ func PrettyprintFromServiceSwap(swap *swap.SwapStateMachine) *PrettyPrintSwap {
 		ClaimTxId:       swap.Data.ClaimTxId,
 		CancelMessage:   swap.Data.GetCancelMessage(),
 		LndChanId:       lnd_chan_id,
+		Premium:         swap.Data.GetPremium(),
+		CancelCode:      string(swap.Data.GetCancelCode()),
 	}
 }
 
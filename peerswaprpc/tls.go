@@ -0,0 +1,103 @@
+package peerswaprpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+// DefaultTLSCertValidity is the validity of a generated tls certificate.
+const DefaultTLSCertValidity = 14 * 30 * 24 * time.Hour
+
+// LoadTLSCertificate loads the tls certificate and key of the rpc interface. A
+// self-signed certificate for localhost, the hostname and the extra hosts is
+// generated if there is none or the certificate expired.
+func LoadTLSCertificate(certPath, keyPath string, extraHosts []string) (tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err == nil {
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		if time.Now().Before(leaf.NotAfter) {
+			return cert, nil
+		}
+	} else if _, statErr := os.Stat(certPath); statErr == nil {
+		// Do not replace a certificate that was put in place by the user.
+		return tls.Certificate{}, err
+	}
+
+	err = generateTLSCertificate(certPath, keyPath, extraHosts)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.LoadX509KeyPair(certPath, keyPath)
+}
+
+// generateTLSCertificate writes a self-signed certificate and its key.
+func generateTLSCertificate(certPath, keyPath string, extraHosts []string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	dnsNames := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "localhost" {
+		dnsNames = append(dnsNames, hostname)
+	}
+	ips := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+	for _, host := range extraHosts {
+		if ip := net.ParseIP(host); ip != nil {
+			ips = append(ips, ip)
+		} else {
+			dnsNames = append(dnsNames, host)
+		}
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"peerswap autogenerated cert"},
+			CommonName:   dnsNames[len(dnsNames)-1],
+		},
+		NotBefore: now.Add(-time.Hour),
+		NotAfter:  now.Add(DefaultTLSCertValidity),
+
+		// The certificate is its own root, so that clients can trust it
+		// directly.
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+
+		DNSNames:    dnsNames,
+		IPAddresses: ips,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+}
@@ -0,0 +1,392 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// PolicyFileVersion is the version of the structured policy file format.
+const PolicyFileVersion = 1
+
+// PolicyFile is the structured policy file. It is a json document that holds
+// the global options, a section per asset and a section per peer:
+//
+//	{
+//	  "version": 1,
+//	  "accept_all_peers": false,
+//	  "allowlisted_peers": ["02a427..."],
+//	  "assets": {"btc": {"premium_rate_ppm": 1000}},
+//	  "peers": {"02a427...": {"allowed_assets": ["btc"]}}
+//	}
+//
+// A policy file that starts with "{" is read as a structured file, any other
+// file is read in the legacy ini format.
+type PolicyFile struct {
+	Version int `json:"version"`
+
+	AllowNewSwaps      *bool    `json:"allow_new_swaps,omitempty"`
+	AcceptAllPeers     bool     `json:"accept_all_peers,omitempty"`
+	MinSwapAmountMsat  *uint64  `json:"min_swap_amount_msat,omitempty"`
+	ReserveOnchainMsat uint64   `json:"reserve_onchain_msat,omitempty"`
+	AllowlistedPeers   []string `json:"allowlisted_peers,omitempty"`
+	SuspiciousPeers    []string `json:"suspicious_peers,omitempty"`
+	ExcludedScids      []string `json:"excluded_scids,omitempty"`
+
+	// Limits restrict the swap requests of all peers together, PeerLimits the
+	// ones of each peer.
+	Limits     *FileLimits `json:"limits,omitempty"`
+	PeerLimits *FileLimits `json:"peer_limits,omitempty"`
+
+	Reputation *FileReputation `json:"reputation,omitempty"`
+
+	// Assets are the options of an asset, btc or lbtc.
+	Assets map[string]*FileAsset `json:"assets,omitempty"`
+	// Peers are the overrides of a peer by its pubkey.
+	Peers map[string]*FilePeer `json:"peers,omitempty"`
+}
+
+// FileLimits are the limits of the number of swap requests.
+type FileLimits struct {
+	MaxConcurrentSwaps uint64 `json:"max_concurrent_swaps,omitempty"`
+	MaxSwapsPerHour    uint64 `json:"max_swaps_per_hour,omitempty"`
+	MaxSwapsPerDay     uint64 `json:"max_swaps_per_day,omitempty"`
+}
+
+// FileReputation holds the thresholds of the reputation score.
+type FileReputation struct {
+	WindowDays            uint64 `json:"window_days,omitempty"`
+	LimitScore            uint64 `json:"limit_score,omitempty"`
+	LimitedMaxSwapsPerDay uint64 `json:"limited_max_swaps_per_day,omitempty"`
+	SuspiciousScore       uint64 `json:"suspicious_score,omitempty"`
+}
+
+// FileAsset holds the options of an asset.
+type FileAsset struct {
+	ReserveOnchainMsat uint64 `json:"reserve_onchain_msat,omitempty"`
+	PremiumRatePpm     uint64 `json:"premium_rate_ppm,omitempty"`
+	PremiumFixedSat    uint64 `json:"premium_fixed_sat,omitempty"`
+	MaxSatPerDay       uint64 `json:"max_sat_per_day,omitempty"`
+	PeerMaxSatPerDay   uint64 `json:"peer_max_sat_per_day,omitempty"`
+}
+
+// FilePeer holds the overrides of a peer, see PeerPolicy.
+type FilePeer struct {
+	AllowedAssets      []string `json:"allowed_assets,omitempty"`
+	AllowedSwapTypes   []string `json:"allowed_swap_types,omitempty"`
+	MinSwapAmountMsat  *uint64  `json:"min_swap_amount_msat,omitempty"`
+	MaxSwapAmountMsat  uint64   `json:"max_swap_amount_msat,omitempty"`
+	MaxConcurrentSwaps *uint64  `json:"max_concurrent_swaps,omitempty"`
+	MaxSwapsPerHour    *uint64  `json:"max_swaps_per_hour,omitempty"`
+	MaxSwapsPerDay     *uint64  `json:"max_swaps_per_day,omitempty"`
+
+	Assets map[string]*FilePeerAsset `json:"assets,omitempty"`
+}
+
+// FilePeerAsset holds the overrides of a peer on an asset.
+type FilePeerAsset struct {
+	PremiumRatePpm  *uint64 `json:"premium_rate_ppm,omitempty"`
+	PremiumFixedSat *uint64 `json:"premium_fixed_sat,omitempty"`
+	MaxSatPerDay    *uint64 `json:"max_sat_per_day,omitempty"`
+}
+
+// isStructured returns true if b is a structured policy file.
+func isStructured(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return len(b) > 0 && b[0] == '{'
+}
+
+// parsePolicyFile parses and validates a structured policy file. Unknown
+// fields are an error.
+func parsePolicyFile(b []byte) (*PolicyFile, error) {
+	var f PolicyFile
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, err
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (f *PolicyFile) validate() error {
+	if f.Version != PolicyFileVersion {
+		return fmt.Errorf("unsupported policy file version %d, expected %d", f.Version, PolicyFileVersion)
+	}
+	for _, pubkey := range append(append([]string(nil), f.AllowlistedPeers...), f.SuspiciousPeers...) {
+		if ok, err := isValidPubkey(pubkey); !ok {
+			return err
+		}
+	}
+	for asset := range f.Assets {
+		if !isKnownAsset(asset) {
+			return fmt.Errorf("unknown asset %s, expected btc or lbtc", asset)
+		}
+	}
+	for pubkey, peer := range f.Peers {
+		for asset := range peer.Assets {
+			if !isKnownAsset(asset) {
+				return fmt.Errorf("peer %s: unknown asset %s, expected btc or lbtc", pubkey, asset)
+			}
+		}
+	}
+	return nil
+}
+
+func isKnownAsset(asset string) bool {
+	return asset == "btc" || asset == "lbtc"
+}
+
+// policy returns the policy of the file, options that are not set keep their
+// default.
+func (f *PolicyFile) policy() (*Policy, error) {
+	p := DefaultPolicy()
+	if f.AllowNewSwaps != nil {
+		p.AllowNewSwaps = *f.AllowNewSwaps
+	}
+	if f.MinSwapAmountMsat != nil {
+		p.MinSwapAmountMsat = *f.MinSwapAmountMsat
+	}
+	p.AcceptAllPeers = f.AcceptAllPeers
+	p.ReserveOnchainMsat = f.ReserveOnchainMsat
+	if len(f.AllowlistedPeers) > 0 {
+		p.PeerAllowlist = append([]string(nil), f.AllowlistedPeers...)
+	}
+	if len(f.SuspiciousPeers) > 0 {
+		p.SuspiciousPeerList = append([]string(nil), f.SuspiciousPeers...)
+	}
+	p.ExcludedScids = append([]string(nil), f.ExcludedScids...)
+	if l := f.Limits; l != nil {
+		p.MaxConcurrentSwaps = l.MaxConcurrentSwaps
+		p.MaxSwapsPerHour = l.MaxSwapsPerHour
+		p.MaxSwapsPerDay = l.MaxSwapsPerDay
+	}
+	if l := f.PeerLimits; l != nil {
+		p.PeerMaxConcurrentSwaps = l.MaxConcurrentSwaps
+		p.PeerMaxSwapsPerHour = l.MaxSwapsPerHour
+		p.PeerMaxSwapsPerDay = l.MaxSwapsPerDay
+	}
+	if r := f.Reputation; r != nil {
+		p.ReputationWindowDays = r.WindowDays
+		p.ReputationLimitScore = r.LimitScore
+		p.ReputationLimitedMaxSwapsPerDay = r.LimitedMaxSwapsPerDay
+		p.ReputationSuspiciousScore = r.SuspiciousScore
+	}
+	if a := f.Assets["btc"]; a != nil {
+		p.BtcReserveOnchainMsat = a.ReserveOnchainMsat
+		p.BtcPremiumRatePpm = a.PremiumRatePpm
+		p.BtcPremiumFixedSat = a.PremiumFixedSat
+		p.BtcMaxSatPerDay = a.MaxSatPerDay
+		p.PeerBtcMaxSatPerDay = a.PeerMaxSatPerDay
+	}
+	if a := f.Assets["lbtc"]; a != nil {
+		p.LbtcReserveOnchainMsat = a.ReserveOnchainMsat
+		p.LbtcPremiumRatePpm = a.PremiumRatePpm
+		p.LbtcPremiumFixedSat = a.PremiumFixedSat
+		p.LbtcMaxSatPerDay = a.MaxSatPerDay
+		p.PeerLbtcMaxSatPerDay = a.PeerMaxSatPerDay
+	}
+
+	// Peers are sorted by pubkey to keep the order of the policy stable.
+	var pubkeys []string
+	for pubkey := range f.Peers {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sort.Strings(pubkeys)
+	for _, pubkey := range pubkeys {
+		fp := f.Peers[pubkey]
+		pp := &PeerPolicy{
+			Pubkey:             pubkey,
+			AllowedAssets:      append([]string(nil), fp.AllowedAssets...),
+			AllowedSwapTypes:   append([]string(nil), fp.AllowedSwapTypes...),
+			MinSwapAmountMsat:  fp.MinSwapAmountMsat,
+			MaxSwapAmountMsat:  fp.MaxSwapAmountMsat,
+			MaxConcurrentSwaps: fp.MaxConcurrentSwaps,
+			MaxSwapsPerHour:    fp.MaxSwapsPerHour,
+			MaxSwapsPerDay:     fp.MaxSwapsPerDay,
+		}
+		if a := fp.Assets["btc"]; a != nil {
+			pp.BtcPremiumRatePpm = a.PremiumRatePpm
+			pp.BtcPremiumFixedSat = a.PremiumFixedSat
+			pp.BtcMaxSatPerDay = a.MaxSatPerDay
+		}
+		if a := fp.Assets["lbtc"]; a != nil {
+			pp.LbtcPremiumRatePpm = a.PremiumRatePpm
+			pp.LbtcPremiumFixedSat = a.PremiumFixedSat
+			pp.LbtcMaxSatPerDay = a.MaxSatPerDay
+		}
+		if err := pp.validate(); err != nil {
+			return nil, err
+		}
+		p.Peers = append(p.Peers, pp)
+	}
+	return p, nil
+}
+
+// NewPolicyFile returns the structured policy file of the policy.
+func NewPolicyFile(p *Policy) *PolicyFile {
+	allowNewSwaps := p.AllowNewSwaps
+	minSwapAmountMsat := p.MinSwapAmountMsat
+	f := &PolicyFile{
+		Version:            PolicyFileVersion,
+		AllowNewSwaps:      &allowNewSwaps,
+		AcceptAllPeers:     p.AcceptAllPeers,
+		MinSwapAmountMsat:  &minSwapAmountMsat,
+		ReserveOnchainMsat: p.ReserveOnchainMsat,
+		AllowlistedPeers:   append([]string(nil), p.PeerAllowlist...),
+		SuspiciousPeers:    append([]string(nil), p.SuspiciousPeerList...),
+		ExcludedScids:      append([]string(nil), p.ExcludedScids...),
+	}
+	if l := (FileLimits{p.MaxConcurrentSwaps, p.MaxSwapsPerHour, p.MaxSwapsPerDay}); l != (FileLimits{}) {
+		f.Limits = &l
+	}
+	if l := (FileLimits{p.PeerMaxConcurrentSwaps, p.PeerMaxSwapsPerHour, p.PeerMaxSwapsPerDay}); l != (FileLimits{}) {
+		f.PeerLimits = &l
+	}
+	r := FileReputation{p.ReputationWindowDays, p.ReputationLimitScore, p.ReputationLimitedMaxSwapsPerDay, p.ReputationSuspiciousScore}
+	if r != (FileReputation{}) {
+		f.Reputation = &r
+	}
+	for asset, a := range map[string]FileAsset{
+		"btc":  {p.BtcReserveOnchainMsat, p.BtcPremiumRatePpm, p.BtcPremiumFixedSat, p.BtcMaxSatPerDay, p.PeerBtcMaxSatPerDay},
+		"lbtc": {p.LbtcReserveOnchainMsat, p.LbtcPremiumRatePpm, p.LbtcPremiumFixedSat, p.LbtcMaxSatPerDay, p.PeerLbtcMaxSatPerDay},
+	} {
+		if a == (FileAsset{}) {
+			continue
+		}
+		if f.Assets == nil {
+			f.Assets = map[string]*FileAsset{}
+		}
+		a := a
+		f.Assets[asset] = &a
+	}
+
+	for _, pp := range p.Peers {
+		fp := &FilePeer{
+			AllowedAssets:      append([]string(nil), pp.AllowedAssets...),
+			AllowedSwapTypes:   append([]string(nil), pp.AllowedSwapTypes...),
+			MinSwapAmountMsat:  pp.MinSwapAmountMsat,
+			MaxSwapAmountMsat:  pp.MaxSwapAmountMsat,
+			MaxConcurrentSwaps: pp.MaxConcurrentSwaps,
+			MaxSwapsPerHour:    pp.MaxSwapsPerHour,
+			MaxSwapsPerDay:     pp.MaxSwapsPerDay,
+		}
+		for asset, a := range map[string]FilePeerAsset{
+			"btc":  {pp.BtcPremiumRatePpm, pp.BtcPremiumFixedSat, pp.BtcMaxSatPerDay},
+			"lbtc": {pp.LbtcPremiumRatePpm, pp.LbtcPremiumFixedSat, pp.LbtcMaxSatPerDay},
+		} {
+			if a == (FilePeerAsset{}) {
+				continue
+			}
+			if fp.Assets == nil {
+				fp.Assets = map[string]*FilePeerAsset{}
+			}
+			a := a
+			fp.Assets[asset] = &a
+		}
+		if f.Peers == nil {
+			f.Peers = map[string]*FilePeer{}
+		}
+		f.Peers[pp.Pubkey] = fp
+	}
+	return f
+}
+
+// Marshal returns the indented json of the policy file.
+func (f *PolicyFile) Marshal() ([]byte, error) {
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// editFile changes the policy file. A legacy file is edited by removing the
+// line remove and adding the line add, a structured file is rewritten with
+// edit applied. The caller holds mu.
+func (p *Policy) editFile(remove, add string, edit func(f *PolicyFile)) error {
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		return err
+	}
+	if !isStructured(b) {
+		if remove != "" {
+			if err := removeLineFromFile(p.path, remove); err != nil {
+				return err
+			}
+		}
+		if add != "" {
+			return addLineToFile(p.path, add)
+		}
+		return nil
+	}
+
+	f, err := parsePolicyFile(b)
+	if err != nil {
+		return ErrReloadPolicy(err.Error())
+	}
+	edit(f)
+	out, err := f.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(p.path, out)
+}
+
+func removeString(list []string, s string) []string {
+	var res []string
+	for _, v := range list {
+		if v != s {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// writeFileAtomic replaces the file at path with b, so that a reader never
+// sees a partially written file.
+func writeFileAtomic(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0660); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ConvertFile reads the policy file at src in either format and writes it as
+// a structured policy file to dst. src and dst may be the same file.
+func ConvertFile(src, dst string) error {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	p, err := create(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	out, err := NewPolicyFile(p).Marshal()
+	if err != nil {
+		return err
+	}
+	// The legacy format does not validate the pubkeys of the peer lists.
+	if _, err := parsePolicyFile(out); err != nil {
+		return fmt.Errorf("converted policy is not valid: %w", err)
+	}
+	return writeFileAtomic(dst, out)
+}
@@ -0,0 +1,174 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PolicyFile(t *testing.T) {
+	peer := randomPubKeyHex()
+	conf := fmt.Sprintf(`{
+  "version": 1,
+  "allow_new_swaps": false,
+  "min_swap_amount_msat": 200000000,
+  "allowlisted_peers": ["%s"],
+  "peer_limits": {"max_concurrent_swaps": 2},
+  "reputation": {"limit_score": 80},
+  "assets": {
+    "btc": {"premium_rate_ppm": 1000, "max_sat_per_day": 5000000},
+    "lbtc": {"premium_fixed_sat": 10}
+  },
+  "peers": {
+    "%s": {
+      "allowed_assets": ["btc"],
+      "max_concurrent_swaps": 5,
+      "assets": {"btc": {"premium_rate_ppm": 0}}
+    }
+  }
+}`, peer, peer)
+
+	policy, err := create(strings.NewReader(conf))
+	require.NoError(t, err)
+	assert.False(t, policy.NewSwapsAllowed())
+	assert.EqualValues(t, 200000000, policy.GetMinSwapAmountMsat())
+	assert.True(t, policy.IsPeerAllowed(peer))
+	assert.EqualValues(t, 1000, policy.GetPeerPremium(randomPubKeyHex(), "btc", 1000000))
+	assert.EqualValues(t, 0, policy.GetPeerPremium(peer, "btc", 1000000))
+	assert.EqualValues(t, 10, policy.GetPeerPremium(peer, "lbtc", 1000000))
+	assert.Equal(t, Limits{BtcMaxSatPerDay: 5000000}, policy.GetLimits())
+	assert.Equal(t, Limits{MaxConcurrentSwaps: 5}, policy.GetPeerLimits(peer))
+	assert.EqualValues(t, 80, policy.GetReputationPolicy().LimitScore)
+	assert.Error(t, policy.CheckPeerSwapRequest(peer, "lbtc", "swap-in", 1000000))
+
+	// Unset options keep their defaults.
+	policy, err = create(strings.NewReader(`{"version": 1}`))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPolicy(), policy)
+}
+
+func Test_PolicyFile_Invalid(t *testing.T) {
+	pubkey := randomPubKeyHex()
+	for _, conf := range []string{
+		`{}`,
+		`{"version": 2}`,
+		`{"version": 1, "unknown_option": 1}`,
+		`{"version": 1, "allowlisted_peers": ["123"]}`,
+		`{"version": 1, "assets": {"eth": {}}}`,
+		`{"version": 1, "assets": {"btc": {"premium_rate_ppm": 1000001}}}`,
+		`{"version": 1, "reputation": {"suspicious_score": 101}}`,
+		fmt.Sprintf(`{"version": 1, "peers": {"%s": {"allowed_swap_types": ["swap-both"]}}}`, pubkey),
+		fmt.Sprintf(`{"version": 1, "peers": {"%s": {"assets": {"eth": {}}}}}`, pubkey),
+		`{"version": 1, "peers": {"123": {}}}`,
+		`{"version": 1,`,
+	} {
+		_, err := create(strings.NewReader(conf))
+		assert.Error(t, err, conf)
+	}
+}
+
+func Test_ConvertFile(t *testing.T) {
+	peer := randomPubKeyHex()
+	legacy := "min_swap_amount_msat=200000000\n" +
+		fmt.Sprintf("allowlisted_peers=%s\n", peer) +
+		"btc_premium_rate_ppm=1000\n" +
+		"lbtc_max_sat_per_day=100000\n" +
+		"peer_max_swaps_per_hour=2\n" +
+		"reputation_suspicious_score=50\n" +
+		"\n" +
+		fmt.Sprintf("[peer %s]\n", peer) +
+		"allowed_swap_types=swap-out\n" +
+		"lbtc_premium_fixed_sat=0\n" +
+		"max_swaps_per_day=3\n"
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "policy.conf")
+	require.NoError(t, os.WriteFile(src, []byte(legacy), 0600))
+	require.NoError(t, ConvertFile(src, src))
+
+	b, err := os.ReadFile(src)
+	require.NoError(t, err)
+	assert.True(t, isStructured(b))
+
+	converted, err := create(strings.NewReader(string(b)))
+	require.NoError(t, err)
+	expected, err := create(strings.NewReader(legacy))
+	require.NoError(t, err)
+	assert.Equal(t, expected, converted)
+
+	// Invalid pubkeys are only rejected by the structured format.
+	require.NoError(t, os.WriteFile(src, []byte("allowlisted_peers=123\n"), 0600))
+	assert.Error(t, ConvertFile(src, filepath.Join(dir, "out.json")))
+	assert.NoFileExists(t, filepath.Join(dir, "out.json"))
+}
+
+func Test_PolicyFile_Edit(t *testing.T) {
+	peer := randomPubKeyHex()
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 1, "assets": {"btc": {"premium_rate_ppm": 1000}}}`), 0600))
+
+	policy, err := CreateFromFile(path)
+	require.NoError(t, err)
+	require.NoError(t, policy.AddToAllowlist(peer))
+	require.NoError(t, policy.AddToSuspiciousPeerList(peer))
+	require.NoError(t, policy.DisableSwaps())
+	assert.True(t, policy.IsPeerAllowed(peer))
+	assert.True(t, policy.IsPeerSuspicious(peer))
+	assert.False(t, policy.NewSwapsAllowed())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	f, err := parsePolicyFile(b)
+	require.NoError(t, err)
+	assert.Equal(t, []string{peer}, f.AllowlistedPeers)
+	assert.EqualValues(t, 1000, f.Assets["btc"].PremiumRatePpm)
+
+	require.NoError(t, policy.RemoveFromAllowlist(peer))
+	require.NoError(t, policy.RemoveFromSuspiciousPeerList(peer))
+	require.NoError(t, policy.EnableSwaps())
+	assert.False(t, policy.IsPeerAllowed(peer))
+	assert.False(t, policy.IsPeerSuspicious(peer))
+	assert.True(t, policy.NewSwapsAllowed())
+	assert.EqualValues(t, 1000, policy.GetPremium("btc", 1000000))
+}
+
+func Test_WatchFile(t *testing.T) {
+	peer := randomPubKeyHex()
+	path := filepath.Join(t.TempDir(), "policy.conf")
+	require.NoError(t, os.WriteFile(path, []byte(""), 0600))
+
+	policy, err := CreateFromFile(path)
+	require.NoError(t, err)
+	reloads := make(chan error, 10)
+	stop := policy.WatchFile(10*time.Millisecond, func(err error) {
+		reloads <- err
+	})
+	defer stop()
+
+	// The modification time of some file systems has a resolution of a
+	// second, a change of the size is detected at once.
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(`{"version": 1, "allowlisted_peers": ["%s"]}`, peer)), 0600))
+	select {
+	case err := <-reloads:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("policy file was not reloaded")
+	}
+	assert.True(t, policy.IsPeerAllowed(peer))
+
+	// An invalid file does not replace the policy.
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 1, "allowlisted_peers": ["123"]}`), 0600))
+	select {
+	case err := <-reloads:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("policy file was not reloaded")
+	}
+	assert.True(t, policy.IsPeerAllowed(peer))
+}
@@ -0,0 +1,92 @@
+package policy
+
+// Limits restrict the swap requests that we accept, either from a single peer
+// or from all peers together. A zero value is no limit.
+type Limits struct {
+	MaxConcurrentSwaps uint64 `json:"max_concurrent_swaps,omitempty"`
+	MaxSwapsPerHour    uint64 `json:"max_swaps_per_hour,omitempty"`
+	MaxSwapsPerDay     uint64 `json:"max_swaps_per_day,omitempty"`
+	BtcMaxSatPerDay    uint64 `json:"btc_max_sat_per_day,omitempty"`
+	LbtcMaxSatPerDay   uint64 `json:"lbtc_max_sat_per_day,omitempty"`
+}
+
+// MaxSatPerDay returns the limit of the swap amount per day on the asset.
+func (l Limits) MaxSatPerDay(asset string) uint64 {
+	switch asset {
+	case "btc":
+		return l.BtcMaxSatPerDay
+	case "lbtc":
+		return l.LbtcMaxSatPerDay
+	}
+	return 0
+}
+
+// IsZero returns true if none of the limits is set.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// Min returns the stricter limits of both.
+func (l Limits) Min(other Limits) Limits {
+	min := func(a, b uint64) uint64 {
+		if a == 0 || (b != 0 && b < a) {
+			return b
+		}
+		return a
+	}
+	return Limits{
+		MaxConcurrentSwaps: min(l.MaxConcurrentSwaps, other.MaxConcurrentSwaps),
+		MaxSwapsPerHour:    min(l.MaxSwapsPerHour, other.MaxSwapsPerHour),
+		MaxSwapsPerDay:     min(l.MaxSwapsPerDay, other.MaxSwapsPerDay),
+		BtcMaxSatPerDay:    min(l.BtcMaxSatPerDay, other.BtcMaxSatPerDay),
+		LbtcMaxSatPerDay:   min(l.LbtcMaxSatPerDay, other.LbtcMaxSatPerDay),
+	}
+}
+
+// GetLimits returns the limits of the swap requests of all peers together.
+func (p *Policy) GetLimits() Limits {
+	mu.Lock()
+	defer mu.Unlock()
+	return Limits{
+		MaxConcurrentSwaps: p.MaxConcurrentSwaps,
+		MaxSwapsPerHour:    p.MaxSwapsPerHour,
+		MaxSwapsPerDay:     p.MaxSwapsPerDay,
+		BtcMaxSatPerDay:    p.BtcMaxSatPerDay,
+		LbtcMaxSatPerDay:   p.LbtcMaxSatPerDay,
+	}
+}
+
+// GetPeerLimits returns the limits of the swap requests of the peer. The
+// peer_* options apply to every peer and are overridden by the limits of a
+// [peer <pubkey>] section.
+func (p *Policy) GetPeerLimits(peer string) Limits {
+	mu.Lock()
+	defer mu.Unlock()
+
+	limits := Limits{
+		MaxConcurrentSwaps: p.PeerMaxConcurrentSwaps,
+		MaxSwapsPerHour:    p.PeerMaxSwapsPerHour,
+		MaxSwapsPerDay:     p.PeerMaxSwapsPerDay,
+		BtcMaxSatPerDay:    p.PeerBtcMaxSatPerDay,
+		LbtcMaxSatPerDay:   p.PeerLbtcMaxSatPerDay,
+	}
+	pp := p.peerPolicy(peer)
+	if pp == nil {
+		return limits
+	}
+	for _, o := range []struct {
+		override *uint64
+		limit    *uint64
+	}{
+		{pp.MaxConcurrentSwaps, &limits.MaxConcurrentSwaps},
+		{pp.MaxSwapsPerHour, &limits.MaxSwapsPerHour},
+		{pp.MaxSwapsPerDay, &limits.MaxSwapsPerDay},
+		{pp.BtcMaxSatPerDay, &limits.BtcMaxSatPerDay},
+		{pp.LbtcMaxSatPerDay, &limits.LbtcMaxSatPerDay},
+	} {
+		if o.override != nil {
+			*o.limit = *o.override
+		}
+	}
+	return limits
+}
@@ -0,0 +1,41 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Limits(t *testing.T) {
+	trusted := randomPubKeyHex()
+	conf := "max_concurrent_swaps=10\n" +
+		"btc_max_sat_per_day=10000000\n" +
+		"peer_max_concurrent_swaps=2\n" +
+		"peer_max_swaps_per_hour=3\n" +
+		"\n" +
+		fmt.Sprintf("[peer %s]\n", trusted) +
+		"max_concurrent_swaps=5\n" +
+		"max_swaps_per_hour=0\n" +
+		"lbtc_max_sat_per_day=1000000\n"
+
+	policy, err := create(strings.NewReader(conf))
+	require.NoError(t, err)
+
+	assert.Equal(t, Limits{MaxConcurrentSwaps: 10, BtcMaxSatPerDay: 10000000}, policy.GetLimits())
+	assert.Equal(t, Limits{MaxConcurrentSwaps: 2, MaxSwapsPerHour: 3}, policy.GetPeerLimits(randomPubKeyHex()))
+	assert.Equal(t, Limits{MaxConcurrentSwaps: 5, LbtcMaxSatPerDay: 1000000}, policy.GetPeerLimits(trusted))
+	assert.EqualValues(t, 1000000, policy.GetPeerLimits(trusted).MaxSatPerDay("lbtc"))
+	assert.True(t, DefaultPolicy().GetLimits().IsZero())
+}
+
+func Test_ReputationPolicy(t *testing.T) {
+	policy, err := create(strings.NewReader("reputation_limit_score=80\nreputation_suspicious_score=50\n"))
+	require.NoError(t, err)
+	assert.Equal(t, ReputationPolicy{WindowDays: 30, LimitScore: 80, LimitedMaxSwapsPerDay: 1, SuspiciousScore: 50}, policy.GetReputationPolicy())
+
+	limits := Limits{MaxConcurrentSwaps: 3, MaxSwapsPerHour: 2, MaxSwapsPerDay: 5}
+	assert.Equal(t, Limits{MaxConcurrentSwaps: 1, MaxSwapsPerHour: 2, MaxSwapsPerDay: 1}, limits.Min(policy.GetReputationPolicy().LimitedLimits()))
+}
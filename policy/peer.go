@@ -0,0 +1,240 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+)
+
+// peerSectionPrefix starts the name of a policy file section that holds the
+// overrides of a peer, e.g. [peer 02a427...].
+const peerSectionPrefix = "peer "
+
+// ErrPeerPolicy is returned if the overrides of a peer do not allow a swap
+// request.
+type ErrPeerPolicy string
+
+func (e ErrPeerPolicy) Error() string {
+	return string(e)
+}
+
+// PeerPolicy overrides the policy for the swaps with a peer. It is read from
+// a [peer <pubkey>] section of the policy file. Unset fields fall back to the
+// global policy.
+type PeerPolicy struct {
+	Pubkey string `json:"pubkey"`
+
+	// AllowedAssets are the assets that the peer may request swaps on. All
+	// assets are allowed if empty.
+	AllowedAssets []string `json:"allowed_assets,omitempty" long:"allowed_assets" description:"The assets (btc, lbtc) that the peer may request swaps on, all if unset."`
+	// AllowedSwapTypes are the types of swaps that the peer may request. All
+	// types are allowed if empty.
+	AllowedSwapTypes []string `json:"allowed_swap_types,omitempty" long:"allowed_swap_types" description:"The swap types (swap-in, swap-out) that the peer may request, all if unset."`
+
+	MinSwapAmountMsat *uint64 `json:"min_swap_amount_msat,omitempty" long:"min_swap_amount_msat" description:"The minimum amount in msat of a swap with the peer."`
+	MaxSwapAmountMsat uint64  `json:"max_swap_amount_msat,omitempty" long:"max_swap_amount_msat" description:"The maximum amount in msat of a swap that the peer requests, unlimited if 0."`
+
+	BtcPremiumRatePpm   *uint64 `json:"btc_premium_rate_ppm,omitempty" long:"btc_premium_rate_ppm" description:"The premium rate in ppm that is asked for btc swap requests of the peer."`
+	BtcPremiumFixedSat  *uint64 `json:"btc_premium_fixed_sat,omitempty" long:"btc_premium_fixed_sat" description:"The fixed premium in sat that is asked for btc swap requests of the peer."`
+	LbtcPremiumRatePpm  *uint64 `json:"lbtc_premium_rate_ppm,omitempty" long:"lbtc_premium_rate_ppm" description:"The premium rate in ppm that is asked for lbtc swap requests of the peer."`
+	LbtcPremiumFixedSat *uint64 `json:"lbtc_premium_fixed_sat,omitempty" long:"lbtc_premium_fixed_sat" description:"The fixed premium in sat that is asked for lbtc swap requests of the peer."`
+
+	// The limits of the swap requests of the peer override the peer_* limits
+	// of the global policy.
+	MaxConcurrentSwaps *uint64 `json:"max_concurrent_swaps,omitempty" long:"max_concurrent_swaps" description:"The maximum number of active swaps that the peer requested, unlimited if 0."`
+	MaxSwapsPerHour    *uint64 `json:"max_swaps_per_hour,omitempty" long:"max_swaps_per_hour" description:"The maximum number of swap requests of the peer that are accepted per hour, unlimited if 0."`
+	MaxSwapsPerDay     *uint64 `json:"max_swaps_per_day,omitempty" long:"max_swaps_per_day" description:"The maximum number of swap requests of the peer that are accepted per day, unlimited if 0."`
+	BtcMaxSatPerDay    *uint64 `json:"btc_max_sat_per_day,omitempty" long:"btc_max_sat_per_day" description:"The maximum amount in sat of btc swap requests of the peer that are accepted per day, unlimited if 0."`
+	LbtcMaxSatPerDay   *uint64 `json:"lbtc_max_sat_per_day,omitempty" long:"lbtc_max_sat_per_day" description:"The maximum amount in sat of lbtc swap requests of the peer that are accepted per day, unlimited if 0."`
+}
+
+func (p *PeerPolicy) copy() *PeerPolicy {
+	c := *p
+	c.AllowedAssets = append([]string(nil), p.AllowedAssets...)
+	c.AllowedSwapTypes = append([]string(nil), p.AllowedSwapTypes...)
+	return &c
+}
+
+func (p *PeerPolicy) validate() error {
+	if ok, err := isValidPubkey(p.Pubkey); !ok {
+		return err
+	}
+	for _, asset := range p.AllowedAssets {
+		if asset != "btc" && asset != "lbtc" {
+			return fmt.Errorf("peer %s: unknown asset %s, expected btc or lbtc", p.Pubkey, asset)
+		}
+	}
+	for _, swapType := range p.AllowedSwapTypes {
+		if swapType != "swap-in" && swapType != "swap-out" {
+			return fmt.Errorf("peer %s: unknown swap type %s, expected swap-in or swap-out", p.Pubkey, swapType)
+		}
+	}
+	if p.MinSwapAmountMsat != nil && p.MaxSwapAmountMsat != 0 && *p.MinSwapAmountMsat > p.MaxSwapAmountMsat {
+		return fmt.Errorf("peer %s: min_swap_amount_msat exceeds max_swap_amount_msat", p.Pubkey)
+	}
+	return nil
+}
+
+// premium returns the premium overrides of the asset, nil if not set.
+func (p *PeerPolicy) premium(asset string) (ratePpm, fixedSat *uint64) {
+	switch asset {
+	case "btc":
+		return p.BtcPremiumRatePpm, p.BtcPremiumFixedSat
+	case "lbtc":
+		return p.LbtcPremiumRatePpm, p.LbtcPremiumFixedSat
+	}
+	return nil, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// peerPolicy returns the overrides of the peer or nil. The caller holds mu.
+func (p *Policy) peerPolicy(peer string) *PeerPolicy {
+	for _, pp := range p.Peers {
+		if pp.Pubkey == peer {
+			return pp
+		}
+	}
+	return nil
+}
+
+// GetPeerPolicy returns a copy of the overrides of the peer or nil if the
+// policy has none.
+func (p *Policy) GetPeerPolicy(peer string) *PeerPolicy {
+	mu.Lock()
+	defer mu.Unlock()
+	if pp := p.peerPolicy(peer); pp != nil {
+		return pp.copy()
+	}
+	return nil
+}
+
+// GetPeerMinSwapAmountMsat returns the minimum swap amount in msat for swaps
+// with the peer.
+func (p *Policy) GetPeerMinSwapAmountMsat(peer string) uint64 {
+	mu.Lock()
+	defer mu.Unlock()
+	if pp := p.peerPolicy(peer); pp != nil && pp.MinSwapAmountMsat != nil {
+		return *pp.MinSwapAmountMsat
+	}
+	return p.MinSwapAmountMsat
+}
+
+// GetPeerPremium returns the premium in sat that we ask the peer for a swap
+// of amountSat on the given asset.
+func (p *Policy) GetPeerPremium(peer, asset string, amountSat uint64) uint64 {
+	mu.Lock()
+	defer mu.Unlock()
+
+	ratePpm, fixedSat := p.peerPremium(peer, asset)
+	return fixedSat + amountSat*ratePpm/1000000
+}
+
+// GetPeerPremiumRate returns the premium rate in ppm and the fixed premium in
+// sat that we ask the peer for swaps on the given asset.
+func (p *Policy) GetPeerPremiumRate(peer, asset string) (ratePpm, fixedSat uint64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return p.peerPremium(peer, asset)
+}
+
+// peerPremium returns the premium of the asset with the overrides of the
+// peer. The caller holds mu.
+func (p *Policy) peerPremium(peer, asset string) (ratePpm, fixedSat uint64) {
+	ratePpm, fixedSat, ok := p.premium(asset)
+	if !ok {
+		return 0, 0
+	}
+	if pp := p.peerPolicy(peer); pp != nil {
+		peerRatePpm, peerFixedSat := pp.premium(asset)
+		if peerRatePpm != nil {
+			ratePpm = *peerRatePpm
+		}
+		if peerFixedSat != nil {
+			fixedSat = *peerFixedSat
+		}
+	}
+	return ratePpm, fixedSat
+}
+
+// CheckPeerSwapRequest returns an ErrPeerPolicy if the overrides of the peer
+// do not allow a swap request of the swap type (swap-in or swap-out) over
+// amountSat on the asset. The minimum amount is checked with
+// GetPeerMinSwapAmountMsat.
+func (p *Policy) CheckPeerSwapRequest(peer, asset, swapType string, amountSat uint64) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	pp := p.peerPolicy(peer)
+	if pp == nil {
+		return nil
+	}
+	if len(pp.AllowedAssets) > 0 && !contains(pp.AllowedAssets, asset) {
+		return ErrPeerPolicy(fmt.Sprintf("asset %s is not allowed for peer %s", asset, peer))
+	}
+	if len(pp.AllowedSwapTypes) > 0 && !contains(pp.AllowedSwapTypes, swapType) {
+		return ErrPeerPolicy(fmt.Sprintf("%s is not allowed for peer %s", swapType, peer))
+	}
+	if pp.MaxSwapAmountMsat != 0 && amountSat*1000 > pp.MaxSwapAmountMsat {
+		return ErrPeerPolicy(fmt.Sprintf("a maximum swap amount of %d msat is allowed for peer %s", pp.MaxSwapAmountMsat, peer))
+	}
+	return nil
+}
+
+// parsePeerSections parses the [peer <pubkey>] sections of a policy file.
+// Other sections are ignored.
+func parsePeerSections(b []byte) ([]*PeerPolicy, error) {
+	var peers []*PeerPolicy
+	var sections []*bytes.Buffer
+	var current *bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if !strings.HasPrefix(name, peerSectionPrefix) {
+				current = nil
+				continue
+			}
+			pubkey := strings.TrimSpace(strings.TrimPrefix(name, peerSectionPrefix))
+			for _, pp := range peers {
+				if pp.Pubkey == pubkey {
+					return nil, fmt.Errorf("duplicate section for peer %s", pubkey)
+				}
+			}
+			peers = append(peers, &PeerPolicy{Pubkey: pubkey})
+			current = &bytes.Buffer{}
+			sections = append(sections, current)
+			continue
+		}
+		if current != nil {
+			current.WriteString(line + "\n")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, pp := range peers {
+		err := flags.NewIniParser(flags.NewParser(pp, flags.None)).Parse(sections[i])
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", pp.Pubkey, err)
+		}
+		if err := pp.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return peers, nil
+}
@@ -0,0 +1,94 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PeerPolicy(t *testing.T) {
+	trusted := randomPubKeyHex()
+	small := randomPubKeyHex()
+	conf := "min_swap_amount_msat=100000000\n" +
+		"btc_premium_rate_ppm=1000\n" +
+		"\n" +
+		fmt.Sprintf("[peer %s]\n", trusted) +
+		"allowed_assets=btc\n" +
+		"min_swap_amount_msat=10000000\n" +
+		"btc_premium_rate_ppm=0\n" +
+		"\n" +
+		fmt.Sprintf("[peer %s]\n", small) +
+		"allowed_assets=lbtc\n" +
+		"allowed_swap_types=swap-out\n" +
+		"max_swap_amount_msat=500000000\n" +
+		"lbtc_premium_fixed_sat=10\n"
+
+	policy, err := create(strings.NewReader(conf))
+	require.NoError(t, err)
+	require.Len(t, policy.Peers, 2)
+	assert.EqualValues(t, 100000000, policy.MinSwapAmountMsat)
+
+	assert.EqualValues(t, 10000000, policy.GetPeerMinSwapAmountMsat(trusted))
+	assert.EqualValues(t, 100000000, policy.GetPeerMinSwapAmountMsat(small))
+	assert.EqualValues(t, 0, policy.GetPeerPremium(trusted, "btc", 1000000))
+	assert.EqualValues(t, 1000, policy.GetPeerPremium(small, "btc", 1000000))
+	assert.EqualValues(t, 10, policy.GetPeerPremium(small, "lbtc", 1000000))
+	ratePpm, fixedSat := policy.GetPeerPremiumRate(small, "lbtc")
+	assert.EqualValues(t, 0, ratePpm)
+	assert.EqualValues(t, 10, fixedSat)
+	ratePpm, _ = policy.GetPeerPremiumRate(small, "btc")
+	assert.EqualValues(t, 1000, ratePpm)
+
+	assert.NoError(t, policy.CheckPeerSwapRequest(trusted, "btc", "swap-in", 10000000))
+	assert.Error(t, policy.CheckPeerSwapRequest(trusted, "lbtc", "swap-in", 100000))
+	assert.NoError(t, policy.CheckPeerSwapRequest(small, "lbtc", "swap-out", 500000))
+	assert.Error(t, policy.CheckPeerSwapRequest(small, "lbtc", "swap-in", 100000))
+	err = policy.CheckPeerSwapRequest(small, "lbtc", "swap-out", 500001)
+	assert.ErrorIs(t, err, ErrPeerPolicy(fmt.Sprintf("a maximum swap amount of 500000000 msat is allowed for peer %s", small)))
+	// Peers without a section only follow the global policy.
+	assert.NoError(t, policy.CheckPeerSwapRequest(randomPubKeyHex(), "lbtc", "swap-in", 100000000))
+
+	pp := policy.GetPeerPolicy(small)
+	require.NotNil(t, pp)
+	assert.Equal(t, []string{"lbtc"}, pp.AllowedAssets)
+	assert.Nil(t, pp.LbtcPremiumRatePpm)
+	assert.Nil(t, policy.GetPeerPolicy(randomPubKeyHex()))
+}
+
+func Test_PeerPolicy_Invalid(t *testing.T) {
+	pubkey := randomPubKeyHex()
+	for _, conf := range []string{
+		"[peer 123]\n",
+		fmt.Sprintf("[peer %s]\nallowed_assets=eth\n", pubkey),
+		fmt.Sprintf("[peer %s]\nallowed_swap_types=swap-both\n", pubkey),
+		fmt.Sprintf("[peer %s]\nunknown_option=1\n", pubkey),
+		fmt.Sprintf("[peer %s]\nmin_swap_amount_msat=2\nmax_swap_amount_msat=1\n", pubkey),
+		fmt.Sprintf("[peer %s]\n[peer %s]\n", pubkey, pubkey),
+	} {
+		_, err := create(strings.NewReader(conf))
+		assert.Error(t, err, conf)
+	}
+}
+
+func Test_AddToAllowlist_PeerSections(t *testing.T) {
+	pubkey := randomPubKeyHex()
+	policyFilePath := path.Join(t.TempDir(), "policy.conf")
+	section := fmt.Sprintf("[peer %s]\nallowed_assets=btc\n", pubkey)
+	require.NoError(t, os.WriteFile(policyFilePath, []byte("accept_all_peers=1\n\n"+section), 0600))
+
+	policy, err := CreateFromFile(policyFilePath)
+	require.NoError(t, err)
+	require.NoError(t, policy.AddToAllowlist(pubkey))
+
+	// The global option is added before the peer section.
+	policyFile, err := os.ReadFile(policyFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("accept_all_peers=1\n\nallowlisted_peers=%s\n%s", pubkey, section), string(policyFile))
+	assert.Equal(t, []string{pubkey}, policy.PeerAllowlist)
+	assert.Len(t, policy.Peers, 1)
+}
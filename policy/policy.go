@@ -3,14 +3,17 @@ package policy
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 
+	"github.com/elementsproject/peerswap/lightning"
 	"github.com/jessevdk/go-flags"
 )
 
@@ -25,6 +28,11 @@ const (
 	// to perform a swap. We need this lower boundary as it is uneconomical to
 	// swap small amounts.
 	defaultMinSwapAmountMsat uint64 = 100000000
+
+	// defaultPremiumRatePpm and defaultPremiumFixedSat are zero as we do not
+	// ask for a premium unless configured.
+	defaultPremiumRatePpm  uint64 = 0
+	defaultPremiumFixedSat uint64 = 0
 )
 
 // Global Mutex
@@ -70,11 +78,19 @@ func (e ErrNotAValidPublicKey) Error() string {
 type Policy struct {
 	path string
 
-	ReserveOnchainMsat uint64   `json:"reserve_onchain_msat" long:"reserve_onchain_msat" description:"The amount of msats that are kept untouched on the onchain wallet for swap requests that are received." clightning_options:"ignore"`
+	ReserveOnchainMsat uint64   `json:"reserve_onchain_msat" long:"reserve_onchain_msat" description:"The amount of msats that are kept untouched on the onchain wallets, unless a reserve of the asset is set." clightning_options:"ignore"`
 	PeerAllowlist      []string `json:"allowlisted_peers" long:"allowlisted_peers" description:"A list of peers that are allowed to send swap requests to the node."`
 	SuspiciousPeerList []string `json:"suspicious_peers" long:"suspicious_peers" description:"A list of peers that acted suspicious and are not allowed to request swaps."`
 	AcceptAllPeers     bool     `json:"accept_all_peers" long:"accept_all_peers" description:"Use with caution! If set, the peer allowlist is ignored and all incoming swap requests are allowed"`
 
+	// The reserves of the single wallets override ReserveOnchainMsat if set.
+	BtcReserveOnchainMsat  uint64 `json:"btc_reserve_onchain_msat" long:"btc_reserve_onchain_msat" description:"The amount of msats that are kept untouched on the bitcoin wallet, reserve_onchain_msat if 0."`
+	LbtcReserveOnchainMsat uint64 `json:"lbtc_reserve_onchain_msat" long:"lbtc_reserve_onchain_msat" description:"The amount of msats that are kept untouched on the liquid wallet, reserve_onchain_msat if 0."`
+
+	// ExcludedScids are the channels on which no swaps are done, neither
+	// requested by peers nor started by us.
+	ExcludedScids []string `json:"excluded_scids" long:"excluded_scids" description:"A list of short channel ids of channels on which swaps are not allowed."`
+
 	// MinSwapAmountMsat is the minimum swap amount in msat that is needed to
 	// perform a swap. Below this amount it might be uneconomical to do a swap
 	// due to the on-chain costs.
@@ -84,6 +100,40 @@ type Policy struct {
 	// when we want to upgrade the node and do not want to allow for any new
 	// swap request from the peer or the node operator.
 	AllowNewSwaps bool `json:"allow_new_swaps" long:"allow_new_swaps" description:"If set to false, disables all swap requests, defaults to true."`
+
+	// The premium is the compensation in sat that we ask for when we act as
+	// the receiver of a swap request. It is composed of a rate in parts per
+	// million of the swap amount and a fixed part, configured per asset.
+	BtcPremiumRatePpm   uint64 `json:"btc_premium_rate_ppm" long:"btc_premium_rate_ppm" description:"The premium rate in ppm of the swap amount that is asked for when receiving btc swap requests."`
+	BtcPremiumFixedSat  uint64 `json:"btc_premium_fixed_sat" long:"btc_premium_fixed_sat" description:"The fixed premium in sat that is asked for when receiving btc swap requests."`
+	LbtcPremiumRatePpm  uint64 `json:"lbtc_premium_rate_ppm" long:"lbtc_premium_rate_ppm" description:"The premium rate in ppm of the swap amount that is asked for when receiving lbtc swap requests."`
+	LbtcPremiumFixedSat uint64 `json:"lbtc_premium_fixed_sat" long:"lbtc_premium_fixed_sat" description:"The fixed premium in sat that is asked for when receiving lbtc swap requests."`
+
+	// The limits of the swap requests that we accept from all peers together.
+	// A zero value is no limit.
+	MaxConcurrentSwaps uint64 `json:"max_concurrent_swaps" long:"max_concurrent_swaps" description:"The maximum number of active swaps that peers requested, unlimited if 0."`
+	MaxSwapsPerHour    uint64 `json:"max_swaps_per_hour" long:"max_swaps_per_hour" description:"The maximum number of swap requests of all peers that are accepted per hour, unlimited if 0."`
+	MaxSwapsPerDay     uint64 `json:"max_swaps_per_day" long:"max_swaps_per_day" description:"The maximum number of swap requests of all peers that are accepted per day, unlimited if 0."`
+	BtcMaxSatPerDay    uint64 `json:"btc_max_sat_per_day" long:"btc_max_sat_per_day" description:"The maximum amount in sat of btc swap requests of all peers that are accepted per day, unlimited if 0."`
+	LbtcMaxSatPerDay   uint64 `json:"lbtc_max_sat_per_day" long:"lbtc_max_sat_per_day" description:"The maximum amount in sat of lbtc swap requests of all peers that are accepted per day, unlimited if 0."`
+
+	// The limits of the swap requests that we accept from each peer. They
+	// can be overridden per peer, see PeerPolicy.
+	PeerMaxConcurrentSwaps uint64 `json:"peer_max_concurrent_swaps" long:"peer_max_concurrent_swaps" description:"The maximum number of active swaps that a peer requested, unlimited if 0."`
+	PeerMaxSwapsPerHour    uint64 `json:"peer_max_swaps_per_hour" long:"peer_max_swaps_per_hour" description:"The maximum number of swap requests of a peer that are accepted per hour, unlimited if 0."`
+	PeerMaxSwapsPerDay     uint64 `json:"peer_max_swaps_per_day" long:"peer_max_swaps_per_day" description:"The maximum number of swap requests of a peer that are accepted per day, unlimited if 0."`
+	PeerBtcMaxSatPerDay    uint64 `json:"peer_btc_max_sat_per_day" long:"peer_btc_max_sat_per_day" description:"The maximum amount in sat of btc swap requests of a peer that are accepted per day, unlimited if 0."`
+	PeerLbtcMaxSatPerDay   uint64 `json:"peer_lbtc_max_sat_per_day" long:"peer_lbtc_max_sat_per_day" description:"The maximum amount in sat of lbtc swap requests of a peer that are accepted per day, unlimited if 0."`
+
+	// The reputation score of a peer is computed from the outcomes of the
+	// swaps with the peer, see ReputationPolicy.
+	ReputationWindowDays            uint64 `json:"reputation_window_days" long:"reputation_window_days" description:"The number of days in which the outcomes of the swaps with a peer count towards its reputation score, 30 if 0."`
+	ReputationLimitScore            uint64 `json:"reputation_limit_score" long:"reputation_limit_score" description:"Peers with a reputation score below are limited to one active swap and reputation_limited_max_swaps_per_day requests per day, disabled if 0."`
+	ReputationLimitedMaxSwapsPerDay uint64 `json:"reputation_limited_max_swaps_per_day" long:"reputation_limited_max_swaps_per_day" description:"The maximum number of swap requests per day of a peer with a reputation score below reputation_limit_score, 1 if 0."`
+	ReputationSuspiciousScore       uint64 `json:"reputation_suspicious_score" long:"reputation_suspicious_score" description:"Peers with a reputation score below are added to the suspicious peers, disabled if 0."`
+
+	// Peers are the overrides of single peers, see PeerPolicy.
+	Peers []*PeerPolicy `json:"peers,omitempty"`
 }
 
 func (p *Policy) String() string {
@@ -91,16 +141,62 @@ func (p *Policy) String() string {
 		"allow_new_swaps: %t\n"+
 			"min_swap_amount_msat: %d\n"+
 			"reserve_onchain_msat: %d\n"+
+			"btc_reserve_onchain_msat: %d\n"+
+			"lbtc_reserve_onchain_msat: %d\n"+
+			"excluded_scids: %s\n"+
 			"allowlisted_peers: %s\n"+
 			"accept_all_peers: %t\n"+
-			"suspicious_peers: %s\n",
+			"suspicious_peers: %s\n"+
+			"btc_premium_rate_ppm: %d\n"+
+			"btc_premium_fixed_sat: %d\n"+
+			"lbtc_premium_rate_ppm: %d\n"+
+			"lbtc_premium_fixed_sat: %d\n"+
+			"max_concurrent_swaps: %d\n"+
+			"max_swaps_per_hour: %d\n"+
+			"max_swaps_per_day: %d\n"+
+			"btc_max_sat_per_day: %d\n"+
+			"lbtc_max_sat_per_day: %d\n"+
+			"peer_max_concurrent_swaps: %d\n"+
+			"peer_max_swaps_per_hour: %d\n"+
+			"peer_max_swaps_per_day: %d\n"+
+			"peer_btc_max_sat_per_day: %d\n"+
+			"peer_lbtc_max_sat_per_day: %d\n"+
+			"reputation_window_days: %d\n"+
+			"reputation_limit_score: %d\n"+
+			"reputation_limited_max_swaps_per_day: %d\n"+
+			"reputation_suspicious_score: %d\n",
 		p.AllowNewSwaps,
 		p.MinSwapAmountMsat,
 		p.ReserveOnchainMsat,
+		p.BtcReserveOnchainMsat,
+		p.LbtcReserveOnchainMsat,
+		p.ExcludedScids,
 		p.PeerAllowlist,
 		p.AcceptAllPeers,
 		p.SuspiciousPeerList,
+		p.BtcPremiumRatePpm,
+		p.BtcPremiumFixedSat,
+		p.LbtcPremiumRatePpm,
+		p.LbtcPremiumFixedSat,
+		p.MaxConcurrentSwaps,
+		p.MaxSwapsPerHour,
+		p.MaxSwapsPerDay,
+		p.BtcMaxSatPerDay,
+		p.LbtcMaxSatPerDay,
+		p.PeerMaxConcurrentSwaps,
+		p.PeerMaxSwapsPerHour,
+		p.PeerMaxSwapsPerDay,
+		p.PeerBtcMaxSatPerDay,
+		p.PeerLbtcMaxSatPerDay,
+		p.ReputationWindowDays,
+		p.ReputationLimitScore,
+		p.ReputationLimitedMaxSwapsPerDay,
+		p.ReputationSuspiciousScore,
 	)
+	for _, pp := range p.Peers {
+		b, _ := json.Marshal(pp)
+		str += fmt.Sprintf("peer: %s\n", b)
+	}
 	return str
 }
 
@@ -108,13 +204,45 @@ func (p *Policy) Get() Policy {
 	mu.Lock()
 	defer mu.Unlock()
 
+	var peers []*PeerPolicy
+	for _, pp := range p.Peers {
+		peers = append(peers, pp.copy())
+	}
+
 	return Policy{
-		ReserveOnchainMsat: p.ReserveOnchainMsat,
-		PeerAllowlist:      p.PeerAllowlist,
-		SuspiciousPeerList: p.SuspiciousPeerList,
-		AcceptAllPeers:     p.AcceptAllPeers,
-		MinSwapAmountMsat:  p.MinSwapAmountMsat,
-		AllowNewSwaps:      p.AllowNewSwaps,
+		ReserveOnchainMsat:  p.ReserveOnchainMsat,
+		PeerAllowlist:       p.PeerAllowlist,
+		SuspiciousPeerList:  p.SuspiciousPeerList,
+		AcceptAllPeers:      p.AcceptAllPeers,
+		MinSwapAmountMsat:   p.MinSwapAmountMsat,
+		AllowNewSwaps:       p.AllowNewSwaps,
+		BtcPremiumRatePpm:   p.BtcPremiumRatePpm,
+		BtcPremiumFixedSat:  p.BtcPremiumFixedSat,
+		LbtcPremiumRatePpm:  p.LbtcPremiumRatePpm,
+		LbtcPremiumFixedSat: p.LbtcPremiumFixedSat,
+
+		BtcReserveOnchainMsat:  p.BtcReserveOnchainMsat,
+		LbtcReserveOnchainMsat: p.LbtcReserveOnchainMsat,
+		ExcludedScids:          p.ExcludedScids,
+
+		MaxConcurrentSwaps: p.MaxConcurrentSwaps,
+		MaxSwapsPerHour:    p.MaxSwapsPerHour,
+		MaxSwapsPerDay:     p.MaxSwapsPerDay,
+		BtcMaxSatPerDay:    p.BtcMaxSatPerDay,
+		LbtcMaxSatPerDay:   p.LbtcMaxSatPerDay,
+
+		PeerMaxConcurrentSwaps: p.PeerMaxConcurrentSwaps,
+		PeerMaxSwapsPerHour:    p.PeerMaxSwapsPerHour,
+		PeerMaxSwapsPerDay:     p.PeerMaxSwapsPerDay,
+		PeerBtcMaxSatPerDay:    p.PeerBtcMaxSatPerDay,
+		PeerLbtcMaxSatPerDay:   p.PeerLbtcMaxSatPerDay,
+
+		ReputationWindowDays:            p.ReputationWindowDays,
+		ReputationLimitScore:            p.ReputationLimitScore,
+		ReputationLimitedMaxSwapsPerDay: p.ReputationLimitedMaxSwapsPerDay,
+		ReputationSuspiciousScore:       p.ReputationSuspiciousScore,
+
+		Peers: peers,
 	}
 }
 
@@ -127,6 +255,35 @@ func (p *Policy) GetReserveOnchainMsat() uint64 {
 	return p.ReserveOnchainMsat
 }
 
+// GetAssetReserveOnchainMsat returns the amount of msats that is kept in the
+// wallet of the asset. The reserve of the asset overrides the reserve of all
+// wallets.
+func (p *Policy) GetAssetReserveOnchainMsat(asset string) uint64 {
+	mu.Lock()
+	defer mu.Unlock()
+	switch {
+	case asset == "btc" && p.BtcReserveOnchainMsat != 0:
+		return p.BtcReserveOnchainMsat
+	case asset == "lbtc" && p.LbtcReserveOnchainMsat != 0:
+		return p.LbtcReserveOnchainMsat
+	}
+	return p.ReserveOnchainMsat
+}
+
+// IsChannelExcluded returns true if swaps are not allowed on the channel. The
+// short channel id may be divided by 'x' or ':'.
+func (p *Policy) IsChannelExcluded(scid string) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	scid = lightning.Scid(scid).ClnStyle()
+	for _, excluded := range p.ExcludedScids {
+		if lightning.Scid(excluded).ClnStyle() == scid {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMinSwapAmountMsat returns the minimum swap amount in msat that is needed
 // to perform a swap.
 func (p *Policy) GetMinSwapAmountMsat() uint64 {
@@ -135,6 +292,31 @@ func (p *Policy) GetMinSwapAmountMsat() uint64 {
 	return p.MinSwapAmountMsat
 }
 
+// GetPremium returns the premium in sat that we ask for a swap of amountSat on
+// the given asset. Unknown assets have no premium.
+func (p *Policy) GetPremium(asset string, amountSat uint64) uint64 {
+	mu.Lock()
+	defer mu.Unlock()
+
+	ratePpm, fixedSat, ok := p.premium(asset)
+	if !ok {
+		return 0
+	}
+	return fixedSat + amountSat*ratePpm/1000000
+}
+
+// premium returns the premium rate and fixed premium of the asset. The caller
+// holds mu.
+func (p *Policy) premium(asset string) (ratePpm, fixedSat uint64, ok bool) {
+	switch asset {
+	case "btc":
+		return p.BtcPremiumRatePpm, p.BtcPremiumFixedSat, true
+	case "lbtc":
+		return p.LbtcPremiumRatePpm, p.LbtcPremiumFixedSat, true
+	}
+	return 0, 0, false
+}
+
 // NewSwapsAllowed returns the boolean value of AllowNewSwaps.
 func (p *Policy) NewSwapsAllowed() bool {
 	return p.AllowNewSwaps
@@ -203,11 +385,9 @@ func (p *Policy) DisableSwaps() error {
 		return nil
 	}
 
-	err := removeLineFromFile(p.path, "allow_new_swaps=true")
-	if err != nil {
-		return err
-	}
-	err = addLineToFile(p.path, "allow_new_swaps=false")
+	err := p.editFile("allow_new_swaps=true", "allow_new_swaps=false", func(f *PolicyFile) {
+		f.AllowNewSwaps = new(bool)
+	})
 	if err != nil {
 		return err
 	}
@@ -225,11 +405,9 @@ func (p *Policy) EnableSwaps() error {
 		return nil
 	}
 
-	err := removeLineFromFile(p.path, "allow_new_swaps=false")
-	if err != nil {
-		return err
-	}
-	err = addLineToFile(p.path, "allow_new_swaps=true")
+	err := p.editFile("allow_new_swaps=false", "allow_new_swaps=true", func(f *PolicyFile) {
+		f.AllowNewSwaps = nil
+	})
 	if err != nil {
 		return err
 	}
@@ -257,7 +435,9 @@ func (p *Policy) AddToAllowlist(pubkey string) error {
 		return err
 	}
 
-	err = addLineToFile(p.path, fmt.Sprintf("allowlisted_peers=%s", pubkey))
+	err = p.editFile("", fmt.Sprintf("allowlisted_peers=%s", pubkey), func(f *PolicyFile) {
+		f.AllowlistedPeers = append(f.AllowlistedPeers, pubkey)
+	})
 	if err != nil {
 		return err
 	}
@@ -284,24 +464,43 @@ func (p *Policy) AddToSuspiciousPeerList(pubkey string) error {
 		return err
 	}
 
-	err = addLineToFile(p.path, fmt.Sprintf("suspicious_peers=%s", pubkey))
+	err = p.editFile("", fmt.Sprintf("suspicious_peers=%s", pubkey), func(f *PolicyFile) {
+		f.SuspiciousPeers = append(f.SuspiciousPeers, pubkey)
+	})
 	if err != nil {
 		return err
 	}
 	return p.ReloadFile()
 }
 
+// addLineToFile adds a global option line to the policy file. The line is
+// inserted before the first section, as it would belong to that section if it
+// was appended.
 func addLineToFile(filePath, line string) error {
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0660)
+	b, err := os.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
-	_, err = file.WriteString(line + "\n")
-	if err != nil {
+
+	var buf bytes.Buffer
+	var inserted bool
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		if !inserted && strings.HasPrefix(strings.TrimSpace(scanner.Text()), "[") {
+			buf.WriteString(line + "\n")
+			inserted = true
+		}
+		buf.Write(scanner.Bytes())
+		buf.WriteString("\n")
+	}
+	if err := scanner.Err(); err != nil {
 		return err
 	}
-	return nil
+	if !inserted {
+		buf.WriteString(line + "\n")
+	}
+
+	return os.WriteFile(filePath, buf.Bytes(), 0660)
 }
 
 // RemoveFromAllowlist removes the pubkey of a node from the policy
@@ -330,7 +529,9 @@ func (p *Policy) RemoveFromAllowlist(pubkey string) error {
 	if p.path == "" {
 		return ErrNoPolicyFile
 	}
-	err = removeLineFromFile(p.path, fmt.Sprintf("allowlisted_peers=%s", pubkey))
+	err = p.editFile(fmt.Sprintf("allowlisted_peers=%s", pubkey), "", func(f *PolicyFile) {
+		f.AllowlistedPeers = removeString(f.AllowlistedPeers, pubkey)
+	})
 	if err != nil {
 		return err
 	}
@@ -361,7 +562,9 @@ func (p *Policy) RemoveFromSuspiciousPeerList(pubkey string) error {
 	if p.path == "" {
 		return ErrNoPolicyFile
 	}
-	err = removeLineFromFile(p.path, fmt.Sprintf("suspicious_peers=%s", pubkey))
+	err = p.editFile(fmt.Sprintf("suspicious_peers=%s", pubkey), "", func(f *PolicyFile) {
+		f.SuspiciousPeers = removeString(f.SuspiciousPeers, pubkey)
+	})
 	if err != nil {
 		return err
 	}
@@ -416,12 +619,16 @@ func (p *Policy) reload(r io.Reader) error {
 // the default values.
 func DefaultPolicy() *Policy {
 	return &Policy{
-		ReserveOnchainMsat: defaultReserveOnchainMsat,
-		PeerAllowlist:      defaultPeerAllowlist,
-		SuspiciousPeerList: defaultSuspiciousPeerList,
-		AcceptAllPeers:     defaultAcceptAllPeers,
-		MinSwapAmountMsat:  defaultMinSwapAmountMsat,
-		AllowNewSwaps:      defaultAllowNewSwaps,
+		ReserveOnchainMsat:  defaultReserveOnchainMsat,
+		PeerAllowlist:       defaultPeerAllowlist,
+		SuspiciousPeerList:  defaultSuspiciousPeerList,
+		AcceptAllPeers:      defaultAcceptAllPeers,
+		MinSwapAmountMsat:   defaultMinSwapAmountMsat,
+		AllowNewSwaps:       defaultAllowNewSwaps,
+		BtcPremiumRatePpm:   defaultPremiumRatePpm,
+		BtcPremiumFixedSat:  defaultPremiumFixedSat,
+		LbtcPremiumRatePpm:  defaultPremiumRatePpm,
+		LbtcPremiumFixedSat: defaultPremiumFixedSat,
 	}
 }
 
@@ -461,15 +668,72 @@ func CreateFromFile(path string) (*Policy, error) {
 
 // Create returns a policy based on a DefaultPolicy.
 func create(r io.Reader) (*Policy, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, ErrCreatePolicy(err.Error())
+	}
+
+	if isStructured(b) {
+		f, err := parsePolicyFile(b)
+		if err != nil {
+			return nil, ErrCreatePolicy(err.Error())
+		}
+		policy, err := f.policy()
+		if err != nil {
+			return nil, ErrCreatePolicy(err.Error())
+		}
+		if err := policy.validate(); err != nil {
+			return nil, ErrCreatePolicy(err.Error())
+		}
+		return policy, nil
+	}
+
 	policy := DefaultPolicy()
-	err := flags.NewIniParser(flags.NewParser(policy, flags.Default|flags.IgnoreUnknown)).Parse(r)
+	err = flags.NewIniParser(flags.NewParser(policy, flags.Default|flags.IgnoreUnknown)).Parse(bytes.NewReader(b))
 	if err != nil {
 		return nil, ErrCreatePolicy(err.Error())
 	}
+	policy.Peers, err = parsePeerSections(b)
+	if err != nil {
+		return nil, ErrCreatePolicy(err.Error())
+	}
+	if err := policy.validate(); err != nil {
+		return nil, ErrCreatePolicy(err.Error())
+	}
 
 	return policy, nil
 }
 
+// validate checks the options that both policy file formats share.
+func (p *Policy) validate() error {
+	for _, rate := range []uint64{p.BtcPremiumRatePpm, p.LbtcPremiumRatePpm} {
+		if rate > 1000000 {
+			return fmt.Errorf("premium rate of %d ppm exceeds 1000000 ppm", rate)
+		}
+	}
+	for _, pp := range p.Peers {
+		for _, rate := range []*uint64{pp.BtcPremiumRatePpm, pp.LbtcPremiumRatePpm} {
+			if rate != nil && *rate > 1000000 {
+				return fmt.Errorf("peer %s: premium rate of %d ppm exceeds 1000000 ppm", pp.Pubkey, *rate)
+			}
+		}
+	}
+	for _, scid := range p.ExcludedScids {
+		if !scidRegexp.MatchString(scid) {
+			return fmt.Errorf("%s is not a valid short channel id", scid)
+		}
+	}
+	for _, score := range []uint64{p.ReputationLimitScore, p.ReputationSuspiciousScore} {
+		if score > 100 {
+			return fmt.Errorf("reputation score of %d exceeds 100", score)
+		}
+	}
+	return nil
+}
+
+// scidRegexp matches a short channel id divided by 'x' or ':'.
+var scidRegexp = regexp.MustCompile(`^[0-9]+[x:][0-9]+[x:][0-9]+$`)
+
 // isValidPubkey validates that the pubkey is 66 bytes hex encoded.
 func isValidPubkey(pubkey string) (bool, error) {
 	matched, err := regexp.MatchString("^[0-9a-f]{66}?\\z", pubkey)
@@ -374,3 +374,40 @@ func randomPubKeyHex() string {
 	rand.Read(b)
 	return hex.EncodeToString(b)
 }
+
+func Test_GetPremium(t *testing.T) {
+	conf := "btc_premium_rate_ppm=1000\n" +
+		"btc_premium_fixed_sat=100\n" +
+		"lbtc_premium_rate_ppm=500\n"
+
+	policy, err := create(strings.NewReader(conf))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1100), policy.GetPremium("btc", 1000000))
+	assert.Equal(t, uint64(500), policy.GetPremium("lbtc", 1000000))
+	assert.Equal(t, uint64(0), policy.GetPremium("unknown", 1000000))
+
+	// The default policy does not ask for a premium.
+	assert.Equal(t, uint64(0), DefaultPolicy().GetPremium("btc", 1000000))
+}
+
+func Test_AssetReserveAndExcludedScids(t *testing.T) {
+	conf := "reserve_onchain_msat=1000000\n" +
+		"lbtc_reserve_onchain_msat=2000000\n" +
+		"excluded_scids=100x2x3\n" +
+		"excluded_scids=100:2:4\n"
+
+	policy, err := create(strings.NewReader(conf))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1000000), policy.GetAssetReserveOnchainMsat("btc"))
+	assert.Equal(t, uint64(2000000), policy.GetAssetReserveOnchainMsat("lbtc"))
+
+	// Short channel ids match in both styles.
+	assert.True(t, policy.IsChannelExcluded("100:2:3"))
+	assert.True(t, policy.IsChannelExcluded("100x2x4"))
+	assert.False(t, policy.IsChannelExcluded("100x2x5"))
+
+	_, err = create(strings.NewReader("excluded_scids=100x2\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package policy
+
+const (
+	// defaultReputationWindowDays is the number of days in which the outcomes
+	// of the swaps with a peer count towards its reputation score.
+	defaultReputationWindowDays uint64 = 30
+
+	// defaultReputationLimitedMaxSwapsPerDay is the number of swap requests
+	// per day that are accepted from a peer with a low reputation score.
+	defaultReputationLimitedMaxSwapsPerDay uint64 = 1
+)
+
+// ReputationPolicy holds the thresholds of the reputation score of a peer. A
+// threshold of 0 disables it.
+type ReputationPolicy struct {
+	WindowDays uint64
+	// Peers with a score below LimitScore are limited to one active swap and
+	// LimitedMaxSwapsPerDay swap requests per day.
+	LimitScore            uint64
+	LimitedMaxSwapsPerDay uint64
+	// Peers with a score below SuspiciousScore are added to the suspicious
+	// peers.
+	SuspiciousScore uint64
+}
+
+// LimitedLimits returns the limits of a peer with a score below LimitScore.
+func (r ReputationPolicy) LimitedLimits() Limits {
+	return Limits{
+		MaxConcurrentSwaps: 1,
+		MaxSwapsPerDay:     r.LimitedMaxSwapsPerDay,
+	}
+}
+
+// GetReputationPolicy returns the thresholds of the reputation score. Unset
+// options are replaced by their defaults.
+func (p *Policy) GetReputationPolicy() ReputationPolicy {
+	mu.Lock()
+	defer mu.Unlock()
+	rp := ReputationPolicy{
+		WindowDays:            p.ReputationWindowDays,
+		LimitScore:            p.ReputationLimitScore,
+		LimitedMaxSwapsPerDay: p.ReputationLimitedMaxSwapsPerDay,
+		SuspiciousScore:       p.ReputationSuspiciousScore,
+	}
+	if rp.WindowDays == 0 {
+		rp.WindowDays = defaultReputationWindowDays
+	}
+	if rp.LimitedMaxSwapsPerDay == 0 {
+		rp.LimitedMaxSwapsPerDay = defaultReputationLimitedMaxSwapsPerDay
+	}
+	return rp
+}
@@ -0,0 +1,66 @@
+package policy
+
+import (
+	"os"
+	"time"
+)
+
+// DefaultWatchInterval is the interval in which the policy file is checked
+// for changes.
+const DefaultWatchInterval = 5 * time.Second
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(path string) (fileState, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}, err
+	}
+	return fileState{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// WatchFile reloads the policy file when it changes. The file is checked
+// every interval and onReload is called with the result of every reload. As
+// the whole file is parsed and validated before it replaces the policy, a
+// file with an error leaves the running policy untouched. The returned
+// function stops the watcher.
+func (p *Policy) WatchFile(interval time.Duration, onReload func(err error)) (stop func()) {
+	mu.Lock()
+	path := p.path
+	mu.Unlock()
+
+	done := make(chan struct{})
+	if path == "" {
+		return func() { close(done) }
+	}
+
+	last, _ := statFile(path)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			state, err := statFile(path)
+			if err != nil || state == last {
+				continue
+			}
+			last = state
+
+			mu.Lock()
+			err = p.ReloadFile()
+			mu.Unlock()
+			if onReload != nil {
+				onReload(err)
+			}
+		}
+	}()
+	return func() { close(done) }
+}
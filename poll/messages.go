@@ -1,11 +1,22 @@
 package poll
 
-import "github.com/elementsproject/peerswap/messages"
+import (
+	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/swap"
+)
 
 type PollMessage struct {
 	Version     uint64   `json:"version"`
 	Assets      []string `json:"assets"`
 	PeerAllowed bool     `json:"peer_allowed"`
+	// Premiums are the premiums that we ask the peer for by asset.
+	Premiums map[string]swap.PremiumRate `json:"premiums,omitempty"`
+	// MaxVersion is the highest protocol version that we support. Version
+	// stays the version that all peers support, so that older peers keep
+	// seeing us as compatible.
+	MaxVersion uint64 `json:"max_version,omitempty"`
+	// MinVersion is the lowest protocol version that we support.
+	MinVersion uint64 `json:"min_version,omitempty"`
 }
 
 func (PollMessage) MessageType() messages.MessageType {
@@ -16,6 +27,14 @@ type RequestPollMessage struct {
 	Version     uint64   `json:"version"`
 	Assets      []string `json:"assets"`
 	PeerAllowed bool     `json:"peer_allowed"`
+	// Premiums are the premiums that we ask the peer for by asset.
+	Premiums map[string]swap.PremiumRate `json:"premiums,omitempty"`
+	// MaxVersion is the highest protocol version that we support. Version
+	// stays the version that all peers support, so that older peers keep
+	// seeing us as compatible.
+	MaxVersion uint64 `json:"max_version,omitempty"`
+	// MinVersion is the lowest protocol version that we support.
+	MinVersion uint64 `json:"min_version,omitempty"`
 }
 
 func (RequestPollMessage) MessageType() messages.MessageType {
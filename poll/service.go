@@ -3,6 +3,7 @@ package poll
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -38,6 +39,7 @@ type PeerGetter interface {
 
 type Policy interface {
 	IsPeerAllowed(peerId string) bool
+	GetPeerPremiumRate(peerId, asset string) (ratePpm, fixedSat uint64)
 }
 
 type Store interface {
@@ -51,7 +53,29 @@ type PollInfo struct {
 	Assets          []string `json:"assets"`
 	PeerAllowed     bool
 	LastSeen        time.Time
+	// Premiums are the premiums that the peer asks us for, nil if the peer
+	// does not announce them.
+	Premiums map[string]swap.PremiumRate `json:"premiums,omitempty"`
+	// MaxProtocolVersion is the highest protocol version that the peer
+	// supports, 0 if the peer does not announce it.
+	MaxProtocolVersion uint64 `json:"max_version,omitempty"`
+	// MinProtocolVersion is the lowest protocol version that the peer
+	// supports, 0 if the peer does not announce it.
+	MinProtocolVersion uint64 `json:"min_version,omitempty"`
 }
+
+// PeerTerms returns the terms of the peer for our swaps.
+func (p *PollInfo) PeerTerms() *swap.PeerTerms {
+	return &swap.PeerTerms{
+		ProtocolVersion:    p.ProtocolVersion,
+		MaxProtocolVersion: p.MaxProtocolVersion,
+		MinProtocolVersion: p.MinProtocolVersion,
+		Assets:             p.Assets,
+		SwapsAllowed:       p.PeerAllowed,
+		Premiums:           p.Premiums,
+	}
+}
+
 type Service struct {
 	sync.RWMutex
 	clock *time.Ticker
@@ -119,8 +143,11 @@ func (s *Service) Stop() {
 func (s *Service) Poll(peer string) {
 	poll := PollMessage{
 		Version:     swap.PEERSWAP_PROTOCOL_VERSION,
+		MaxVersion:  swap.PEERSWAP_MAX_PROTOCOL_VERSION,
+		MinVersion:  swap.PEERSWAP_MIN_PROTOCOL_VERSION,
 		Assets:      s.assets,
 		PeerAllowed: s.policy.IsPeerAllowed(peer),
+		Premiums:    s.premiums(peer),
 	}
 
 	msg, err := json.Marshal(poll)
@@ -132,6 +159,16 @@ func (s *Service) Poll(peer string) {
 	s.sendMessage(peer, msg, int(poll.MessageType()))
 }
 
+// premiums returns the premiums that we ask the peer for on our assets.
+func (s *Service) premiums(peer string) map[string]swap.PremiumRate {
+	premiums := make(map[string]swap.PremiumRate)
+	for _, asset := range s.assets {
+		ratePpm, fixedSat := s.policy.GetPeerPremiumRate(peer, asset)
+		premiums[asset] = swap.PremiumRate{RatePpm: ratePpm, FixedSat: fixedSat}
+	}
+	return premiums
+}
+
 func (s *Service) PollAllPeers() {
 	for _, peer := range s.peers.GetPeers() {
 		go s.Poll(peer)
@@ -143,8 +180,11 @@ func (s *Service) PollAllPeers() {
 func (s *Service) RequestPoll(peer string) {
 	request := RequestPollMessage{
 		Version:     swap.PEERSWAP_PROTOCOL_VERSION,
+		MaxVersion:  swap.PEERSWAP_MAX_PROTOCOL_VERSION,
+		MinVersion:  swap.PEERSWAP_MIN_PROTOCOL_VERSION,
 		Assets:      s.assets,
 		PeerAllowed: s.policy.IsPeerAllowed(peer),
+		Premiums:    s.premiums(peer),
 	}
 
 	msg, err := json.Marshal(request)
@@ -180,18 +220,22 @@ func (s *Service) MessageHandler(peerId string, msgType string, payload []byte)
 		if err != nil {
 			return err
 		}
-		s.store.Update(peerId, PollInfo{
-			ProtocolVersion: msg.Version,
-			Assets:          msg.Assets,
-			PeerAllowed:     msg.PeerAllowed,
-			LastSeen:        time.Now(),
-		})
+		info := PollInfo{
+			ProtocolVersion:    msg.Version,
+			MaxProtocolVersion: msg.MaxVersion,
+			MinProtocolVersion: msg.MinVersion,
+			Assets:             msg.Assets,
+			PeerAllowed:        msg.PeerAllowed,
+			LastSeen:           time.Now(),
+			Premiums:           msg.Premiums,
+		}
+		s.store.Update(peerId, info)
 		if ti, ok := s.tmpStore[peerId]; ok {
 			if ti == string(payload) {
 				return nil
 			}
 		}
-		if msg.Version != swap.PEERSWAP_PROTOCOL_VERSION {
+		if !info.IsCompatible() {
 			log.Debugf("Received poll from INCOMPATIBLE peer %s: %s", peerId, string(payload))
 		} else {
 			log.Debugf("Received poll from peer %s: %s", peerId, string(payload))
@@ -204,12 +248,16 @@ func (s *Service) MessageHandler(peerId string, msgType string, payload []byte)
 		if err != nil {
 			return err
 		}
-		s.store.Update(peerId, PollInfo{
-			ProtocolVersion: msg.Version,
-			Assets:          msg.Assets,
-			PeerAllowed:     msg.PeerAllowed,
-			LastSeen:        time.Now(),
-		})
+		info := PollInfo{
+			ProtocolVersion:    msg.Version,
+			MaxProtocolVersion: msg.MaxVersion,
+			MinProtocolVersion: msg.MinVersion,
+			Assets:             msg.Assets,
+			PeerAllowed:        msg.PeerAllowed,
+			LastSeen:           time.Now(),
+			Premiums:           msg.Premiums,
+		}
+		s.store.Update(peerId, info)
 		// Send a poll on request
 		s.Poll(peerId)
 		if ti, ok := s.tmpStore[peerId]; ok {
@@ -217,7 +265,7 @@ func (s *Service) MessageHandler(peerId string, msgType string, payload []byte)
 				return nil
 			}
 		}
-		if msg.Version != swap.PEERSWAP_PROTOCOL_VERSION {
+		if !info.IsCompatible() {
 			log.Debugf("Received poll from INCOMPATIBLE peer %s: %s", peerId, string(payload))
 		} else {
 			log.Debugf("Received poll from peer %s: %s", peerId, string(payload))
@@ -233,8 +281,15 @@ func (s *Service) GetPolls() (map[string]PollInfo, error) {
 	return s.store.GetAll()
 }
 
-// GetCompatiblePolls returns all polls from peers that are running the same
-// protocol version.
+// IsCompatible returns true if we support one of the protocol versions that
+// the peer supports.
+func (p *PollInfo) IsCompatible() bool {
+	_, ok := swap.HighestCommonProtocolVersion(p.PeerTerms().ProtocolVersions())
+	return ok
+}
+
+// GetCompatiblePolls returns all polls from peers that support one of our
+// protocol versions.
 func (s *Service) GetCompatiblePolls() (map[string]PollInfo, error) {
 	var compPeers = make(map[string]PollInfo)
 	peers, err := s.store.GetAll()
@@ -242,7 +297,7 @@ func (s *Service) GetCompatiblePolls() (map[string]PollInfo, error) {
 		return nil, err
 	}
 	for id, p := range peers {
-		if p.ProtocolVersion == swap.PEERSWAP_PROTOCOL_VERSION {
+		if p.IsCompatible() {
 			compPeers[id] = p
 		}
 	}
@@ -264,6 +319,20 @@ func (s *Service) GetPollFrom(peerId string) (*PollInfo, error) {
 	return nil, PollNotFoundErr(peerId)
 }
 
+// GetPeerTerms returns the terms of the peer for our swaps from its last
+// poll, nil if the peer did not poll.
+func (s *Service) GetPeerTerms(peerId string) (*swap.PeerTerms, error) {
+	poll, err := s.GetPollFrom(peerId)
+	var notFound PollNotFoundErr
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return poll.PeerTerms(), nil
+}
+
 func (s *Service) sendMessage(peer string, msg []byte, msgType int) {
 	if err := s.messenger.SendMessage(peer, msg, msgType); err != nil {
 		s.Lock()
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/swap"
 	"github.com/stretchr/testify/assert"
 	"go.etcd.io/bbolt"
 )
@@ -50,6 +51,13 @@ func (m *PolicyMock) IsPeerAllowed(peerId string) bool {
 	return m.allowList[m.called-1]
 }
 
+func (m *PolicyMock) GetPeerPremiumRate(peerId, asset string) (ratePpm, fixedSat uint64) {
+	if asset == "asset1" {
+		return 1000, 10
+	}
+	return 0, 0
+}
+
 func TestSendMessage(t *testing.T) {
 	dir := t.TempDir()
 	db, err := bbolt.Open(path.Join(dir, "poll-db"), os.ModePerm, nil)
@@ -85,6 +93,8 @@ func TestSendMessage(t *testing.T) {
 	for i, isAllowed := range policy.allowList {
 		assert.Equal(t, isAllowed, msgs[i].PeerAllowed)
 		assert.Equal(t, msgs[i].Assets, assets)
+		assert.Equal(t, swap.PremiumRate{RatePpm: 1000, FixedSat: 10}, msgs[i].Premiums["asset1"])
+		assert.Equal(t, swap.PremiumRate{}, msgs[i].Premiums["asset2"])
 	}
 }
 
@@ -111,10 +121,12 @@ func TestRecievePollAndPollRequest(t *testing.T) {
 	rpmt := messages.MessageTypeToHexString(messages.MESSAGETYPE_REQUEST_POLL)
 
 	// Handle poll message
+	premiums := map[string]swap.PremiumRate{"btc": {RatePpm: 500}}
 	pmp, err := json.Marshal(PollMessage{
 		Version:     0,
 		Assets:      []string{},
 		PeerAllowed: false,
+		Premiums:    premiums,
 	})
 	if err != nil {
 		t.Fatalf("could not marshal poll msg: %v", err)
@@ -126,6 +138,7 @@ func TestRecievePollAndPollRequest(t *testing.T) {
 		t.Fatalf("GetAll(): %v", err)
 	}
 	assert.Len(t, polls, 1)
+	assert.Equal(t, premiums, polls["peer"].Premiums)
 	assert.Len(t, messenger.peersReceived, 0)
 
 	// Handle poll request message
@@ -191,3 +204,51 @@ func TestRemoveUnseen(t *testing.T) {
 
 	assert.Len(t, m, 1)
 }
+
+func TestGetCompatiblePolls(t *testing.T) {
+	dir := t.TempDir()
+	db, err := bbolt.Open(path.Join(dir, "poll-db"), os.ModePerm, nil)
+	if err != nil {
+		t.Fatalf("could not open db: %v", err)
+	}
+	store, err := NewStore(db)
+	if err != nil {
+		t.Fatalf("could not create store: %v", err)
+	}
+
+	ps := NewService(500*time.Millisecond, 1*time.Second, store, &MessengerMock{}, &PolicyMock{}, &PeerGetterMock{}, nil)
+
+	pmt := messages.MessageTypeToHexString(messages.MESSAGETYPE_POLL)
+	for peer, msg := range map[string]PollMessage{
+		// Peers of older versions only announce a single version.
+		"legacy": {Version: swap.PEERSWAP_PROTOCOL_VERSION},
+		"range":  {Version: swap.PEERSWAP_PROTOCOL_VERSION, MinVersion: swap.PEERSWAP_PROTOCOL_VERSION, MaxVersion: swap.PEERSWAP_MAX_PROTOCOL_VERSION + 1},
+		"newer":  {Version: swap.PEERSWAP_MAX_PROTOCOL_VERSION, MinVersion: swap.PEERSWAP_MAX_PROTOCOL_VERSION, MaxVersion: swap.PEERSWAP_MAX_PROTOCOL_VERSION + 1},
+		"future": {Version: swap.PEERSWAP_MAX_PROTOCOL_VERSION + 1, MinVersion: swap.PEERSWAP_MAX_PROTOCOL_VERSION + 1, MaxVersion: swap.PEERSWAP_MAX_PROTOCOL_VERSION + 2},
+		"old":    {Version: swap.PEERSWAP_MIN_PROTOCOL_VERSION - 1},
+	} {
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatalf("could not marshal poll msg: %v", err)
+		}
+		err = ps.MessageHandler(peer, pmt, payload)
+		if err != nil {
+			t.Fatalf("MessageHandler(): %v", err)
+		}
+	}
+
+	polls, err := ps.GetCompatiblePolls()
+	if err != nil {
+		t.Fatalf("GetCompatiblePolls(): %v", err)
+	}
+	var peers []string
+	for peer := range polls {
+		peers = append(peers, peer)
+	}
+	assert.ElementsMatch(t, []string{"legacy", "range", "newer"}, peers)
+
+	poll := polls["range"]
+	minVersion, maxVersion := poll.PeerTerms().ProtocolVersions()
+	assert.EqualValues(t, swap.PEERSWAP_PROTOCOL_VERSION, minVersion)
+	assert.EqualValues(t, swap.PEERSWAP_MAX_PROTOCOL_VERSION+1, maxVersion)
+}
@@ -0,0 +1,147 @@
+// Package sqlstore implements the swap, requested swap, poll and version
+// stores on SQLite or Postgres as an alternative to bbolt.
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type Backend string
+
+const (
+	BackendBolt     Backend = "bolt"
+	BackendSqlite   Backend = "sqlite"
+	BackendPostgres Backend = "postgres"
+)
+
+// ParseBackend parses the name of a database backend. An empty name is bolt.
+func ParseBackend(name string) (Backend, error) {
+	switch Backend(strings.ToLower(name)) {
+	case "", BackendBolt:
+		return BackendBolt, nil
+	case BackendSqlite:
+		return BackendSqlite, nil
+	case BackendPostgres:
+		return BackendPostgres, nil
+	}
+	return "", fmt.Errorf("unknown database backend %s, expected bolt, sqlite or postgres", name)
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// DB is a SQL database that holds the peerswap tables.
+type DB struct {
+	*sql.DB
+	backend Backend
+}
+
+// Open opens the database and creates the tables that do not exist yet. The
+// dsn is the path to the database file for sqlite and the connection string
+// for postgres.
+func Open(backend Backend, dsn string) (*DB, error) {
+	var driver string
+	switch backend {
+	case BackendSqlite:
+		driver = "sqlite3"
+		// Deleted swaps are overwritten so that no key material of archived
+		// swaps is left in the free pages of the file.
+		dsn = fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_secure_delete=true", dsn)
+	case BackendPostgres:
+		driver = "postgres"
+	default:
+		return nil, fmt.Errorf("backend %s is not a sql database", backend)
+	}
+
+	sqlDb, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if backend == BackendSqlite {
+		// SQLite allows only one writer at a time.
+		sqlDb.SetMaxOpenConns(1)
+	}
+	if err := sqlDb.Ping(); err != nil {
+		sqlDb.Close()
+		return nil, err
+	}
+
+	db := &DB{DB: sqlDb, backend: backend}
+	if err := db.createTables(); err != nil {
+		sqlDb.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func (db *DB) Backend() Backend {
+	return db.backend
+}
+
+func (db *DB) createTables() error {
+	serial := "INTEGER PRIMARY KEY AUTOINCREMENT"
+	if db.backend == BackendPostgres {
+		serial = "BIGSERIAL PRIMARY KEY"
+	}
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS swaps (
+			swap_id TEXT PRIMARY KEY,
+			peer_node_id TEXT NOT NULL,
+			state TEXT NOT NULL,
+			created_at BIGINT NOT NULL,
+			channel_id TEXT NOT NULL,
+			data TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS swaps_peer_node_id ON swaps (peer_node_id)`,
+		`CREATE INDEX IF NOT EXISTS swaps_state ON swaps (state)`,
+		`CREATE INDEX IF NOT EXISTS swaps_created_at ON swaps (created_at)`,
+		`CREATE INDEX IF NOT EXISTS swaps_channel_id ON swaps (channel_id)`,
+		`CREATE TABLE IF NOT EXISTS archived_swaps (
+			swap_id TEXT PRIMARY KEY,
+			peer_node_id TEXT NOT NULL,
+			created_at BIGINT NOT NULL,
+			data TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS archived_swaps_created_at ON archived_swaps (created_at)`,
+		`CREATE TABLE IF NOT EXISTS peer_outcomes (
+			swap_id TEXT PRIMARY KEY,
+			peer_node_id TEXT NOT NULL,
+			outcome TEXT NOT NULL,
+			finished_at BIGINT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS peer_outcomes_peer_node_id ON peer_outcomes (peer_node_id)`,
+		`CREATE TABLE IF NOT EXISTS requested_swaps (
+			id ` + serial + `,
+			peer_node_id TEXT NOT NULL,
+			asset TEXT NOT NULL,
+			amount_sat BIGINT NOT NULL,
+			swap_type INTEGER NOT NULL,
+			rejection_reason TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS requested_swaps_peer_node_id ON requested_swaps (peer_node_id)`,
+		`CREATE TABLE IF NOT EXISTS polls (
+			peer_node_id TEXT PRIMARY KEY,
+			last_seen BIGINT NOT NULL,
+			data TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS version (
+			name TEXT PRIMARY KEY,
+			value TEXT NOT NULL
+		)`,
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
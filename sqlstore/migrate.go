@@ -0,0 +1,170 @@
+package sqlstore
+
+import (
+	"fmt"
+
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/elementsproject/peerswap/version"
+	"go.etcd.io/bbolt"
+)
+
+// MigrationReport holds the number of records that were migrated.
+type MigrationReport struct {
+	Swaps          int
+	ArchivedSwaps  int
+	PeerOutcomes   int
+	RequestedSwaps int
+	Polls          int
+	Version        string
+}
+
+func (r *MigrationReport) String() string {
+	return fmt.Sprintf("swaps: %d, archived swaps: %d, peer outcomes: %d, requested swaps: %d, polls: %d, version: %q",
+		r.Swaps, r.ArchivedSwaps, r.PeerOutcomes, r.RequestedSwaps, r.Polls, r.Version)
+}
+
+// MigrateFromBolt copies all swaps, archived swaps, peer outcomes, requested
+// swaps, polls and the version from the bbolt database into the empty sql database in one
+// transaction. Afterwards it counts the records in the sql database and
+// returns an error if they do not match the bbolt database.
+func MigrateFromBolt(boltDb *bbolt.DB, db *DB) (*MigrationReport, error) {
+	swapStore, err := swap.NewBboltStore(boltDb)
+	if err != nil {
+		return nil, err
+	}
+	swaps, err := swapStore.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	archivedSwaps, err := swapStore.ListArchived()
+	if err != nil {
+		return nil, err
+	}
+	peerOutcomes, err := swapStore.ListPeerOutcomes("")
+	if err != nil {
+		return nil, err
+	}
+	requestedSwapStore, err := swap.NewRequestedSwapsStore(boltDb)
+	if err != nil {
+		return nil, err
+	}
+	requestedSwaps, err := requestedSwapStore.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	pollStore, err := poll.NewStore(boltDb)
+	if err != nil {
+		return nil, err
+	}
+	polls, err := pollStore.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	versionStore, err := version.NewVersionStore(boltDb)
+	if err != nil {
+		return nil, err
+	}
+	v, err := versionStore.GetVersion()
+	if err != nil && err != version.ErrDoesNotExist {
+		return nil, err
+	}
+
+	expected := &MigrationReport{
+		Swaps:         len(swaps),
+		ArchivedSwaps: len(archivedSwaps),
+		PeerOutcomes:  len(peerOutcomes),
+		Polls:         len(polls),
+	}
+	for _, reqswaps := range requestedSwaps {
+		expected.RequestedSwaps += len(reqswaps)
+	}
+	if err == nil {
+		expected.Version = v
+	}
+
+	before, err := db.count()
+	if err != nil {
+		return nil, err
+	}
+	if before.Swaps != 0 || before.ArchivedSwaps != 0 || before.PeerOutcomes != 0 || before.RequestedSwaps != 0 || before.Polls != 0 || before.Version != "" {
+		return nil, fmt.Errorf("the sql database is not empty: %s", before)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, sw := range swaps {
+		if err := putSwap(tx, sw); err != nil {
+			return nil, fmt.Errorf("swap %s: %w", sw.SwapId, err)
+		}
+	}
+	for _, archived := range archivedSwaps {
+		if err := putArchivedSwap(tx, archived); err != nil {
+			return nil, fmt.Errorf("archived swap %s: %w", archived.SwapId, err)
+		}
+	}
+	for _, outcome := range peerOutcomes {
+		if err := putPeerOutcome(tx, outcome); err != nil {
+			return nil, fmt.Errorf("outcome of swap %s: %w", outcome.SwapId, err)
+		}
+	}
+	for id, reqswaps := range requestedSwaps {
+		for _, reqswap := range reqswaps {
+			if err := addRequestedSwap(tx, id, reqswap); err != nil {
+				return nil, fmt.Errorf("requested swap of %s: %w", id, err)
+			}
+		}
+	}
+	for peerId, info := range polls {
+		if err := putPoll(tx, peerId, info); err != nil {
+			return nil, fmt.Errorf("poll of %s: %w", peerId, err)
+		}
+	}
+	if expected.Version != "" {
+		if err := setVersion(tx, expected.Version); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	migrated, err := db.count()
+	if err != nil {
+		return nil, err
+	}
+	if *migrated != *expected {
+		return migrated, fmt.Errorf("record counts do not match after the migration, bolt: %s, sql: %s", expected, migrated)
+	}
+	return migrated, nil
+}
+
+// count counts the records in the database.
+func (db *DB) count() (*MigrationReport, error) {
+	r := &MigrationReport{}
+	counts := []struct {
+		table string
+		n     *int
+	}{
+		{"swaps", &r.Swaps},
+		{"archived_swaps", &r.ArchivedSwaps},
+		{"peer_outcomes", &r.PeerOutcomes},
+		{"requested_swaps", &r.RequestedSwaps},
+		{"polls", &r.Polls},
+	}
+	for _, c := range counts {
+		if err := db.QueryRow(`SELECT COUNT(*) FROM ` + c.table).Scan(c.n); err != nil {
+			return nil, err
+		}
+	}
+	v, err := NewVersionStore(db).GetVersion()
+	if err != nil && err != version.ErrDoesNotExist {
+		return nil, err
+	}
+	r.Version = v
+	return r, nil
+}
@@ -0,0 +1,59 @@
+package sqlstore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/elementsproject/peerswap/poll"
+)
+
+// PollStore implements poll.Store.
+type PollStore struct {
+	db *DB
+}
+
+func NewPollStore(db *DB) *PollStore {
+	return &PollStore{db: db}
+}
+
+func (s *PollStore) Update(peerId string, info poll.PollInfo) error {
+	return putPoll(s.db, peerId, info)
+}
+
+func (s *PollStore) GetAll() (map[string]poll.PollInfo, error) {
+	rows, err := s.db.Query(`SELECT peer_node_id, data FROM polls`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pollinfos := map[string]poll.PollInfo{}
+	for rows.Next() {
+		var peerId, data string
+		if err := rows.Scan(&peerId, &data); err != nil {
+			return nil, err
+		}
+		var info poll.PollInfo
+		if err := json.Unmarshal([]byte(data), &info); err != nil {
+			return nil, err
+		}
+		pollinfos[peerId] = info
+	}
+	return pollinfos, rows.Err()
+}
+
+func (s *PollStore) RemoveUnseen(now time.Time, olderThan time.Duration) error {
+	_, err := s.db.Exec(`DELETE FROM polls WHERE last_seen < $1`, now.Add(-olderThan).UnixNano())
+	return err
+}
+
+func putPoll(q querier, peerId string, info poll.PollInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	_, err = q.Exec(`INSERT INTO polls (peer_node_id, last_seen, data) VALUES ($1, $2, $3)
+		ON CONFLICT (peer_node_id) DO UPDATE SET last_seen = excluded.last_seen, data = excluded.data`,
+		peerId, info.LastSeen.UnixNano(), string(data))
+	return err
+}
@@ -0,0 +1,46 @@
+package sqlstore
+
+import (
+	"github.com/elementsproject/peerswap/swap"
+)
+
+// AddPeerOutcome implements swap.ReputationStore.
+func (s *SwapStore) AddPeerOutcome(outcome *swap.PeerOutcome) error {
+	return putPeerOutcome(s.db, outcome)
+}
+
+// ListPeerOutcomes implements swap.ReputationStore.
+func (s *SwapStore) ListPeerOutcomes(peer string) ([]*swap.PeerOutcome, error) {
+	query := `SELECT swap_id, peer_node_id, outcome, finished_at FROM peer_outcomes`
+	var args []interface{}
+	if peer != "" {
+		query += ` WHERE peer_node_id = $1`
+		args = append(args, peer)
+	}
+	rows, err := s.db.Query(query+` ORDER BY finished_at, swap_id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outcomes []*swap.PeerOutcome
+	for rows.Next() {
+		o := &swap.PeerOutcome{}
+		if err := rows.Scan(&o.SwapId, &o.PeerNodeId, &o.Outcome, &o.FinishedAt); err != nil {
+			return nil, err
+		}
+		outcomes = append(outcomes, o)
+	}
+	return outcomes, rows.Err()
+}
+
+func putPeerOutcome(q querier, outcome *swap.PeerOutcome) error {
+	_, err := q.Exec(`INSERT INTO peer_outcomes (swap_id, peer_node_id, outcome, finished_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (swap_id) DO UPDATE SET
+			peer_node_id = excluded.peer_node_id,
+			outcome = excluded.outcome,
+			finished_at = excluded.finished_at`,
+		outcome.SwapId, outcome.PeerNodeId, string(outcome.Outcome), outcome.FinishedAt)
+	return err
+}
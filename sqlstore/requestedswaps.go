@@ -0,0 +1,62 @@
+package sqlstore
+
+import (
+	"github.com/elementsproject/peerswap/swap"
+)
+
+// RequestedSwapsStore implements swap.RequestedSwapsStore with one row per
+// rejected request.
+type RequestedSwapsStore struct {
+	db *DB
+}
+
+func NewRequestedSwapsStore(db *DB) *RequestedSwapsStore {
+	return &RequestedSwapsStore{db: db}
+}
+
+func (s *RequestedSwapsStore) Add(id string, reqswap swap.RequestedSwap) error {
+	return addRequestedSwap(s.db, id, reqswap)
+}
+
+func (s *RequestedSwapsStore) Get(id string) ([]swap.RequestedSwap, error) {
+	reqswaps, err := s.query(`SELECT peer_node_id, asset, amount_sat, swap_type, rejection_reason
+		FROM requested_swaps WHERE peer_node_id = $1 ORDER BY id`, id)
+	if err != nil {
+		return nil, err
+	}
+	return reqswaps[id], nil
+}
+
+func (s *RequestedSwapsStore) GetAll() (map[string][]swap.RequestedSwap, error) {
+	return s.query(`SELECT peer_node_id, asset, amount_sat, swap_type, rejection_reason
+		FROM requested_swaps ORDER BY id`)
+}
+
+func (s *RequestedSwapsStore) query(query string, args ...interface{}) (map[string][]swap.RequestedSwap, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reqswaps := map[string][]swap.RequestedSwap{}
+	for rows.Next() {
+		var id string
+		var amount int64
+		var reqswap swap.RequestedSwap
+		err := rows.Scan(&id, &reqswap.Asset, &amount, &reqswap.Type, &reqswap.RejectionReason)
+		if err != nil {
+			return nil, err
+		}
+		reqswap.AmountSat = uint64(amount)
+		reqswaps[id] = append(reqswaps[id], reqswap)
+	}
+	return reqswaps, rows.Err()
+}
+
+func addRequestedSwap(q querier, id string, reqswap swap.RequestedSwap) error {
+	_, err := q.Exec(`INSERT INTO requested_swaps (peer_node_id, asset, amount_sat, swap_type, rejection_reason)
+		VALUES ($1, $2, $3, $4, $5)`,
+		id, reqswap.Asset, int64(reqswap.AmountSat), int(reqswap.Type), reqswap.RejectionReason)
+	return err
+}
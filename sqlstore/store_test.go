@@ -0,0 +1,298 @@
+package sqlstore
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/elementsproject/peerswap/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_SwapStore(t *testing.T) {
+	db := openTestDb(t)
+	store := NewSwapStore(db)
+
+	_, err := store.GetData(swap.NewSwapId().String())
+	assert.ErrorIs(t, err, swap.ErrDataNotAvailable)
+
+	a := newTestSwap("alice", 200)
+	b := newTestSwap("bob", 100)
+	require.NoError(t, store.UpdateData(a))
+	require.NoError(t, store.UpdateData(b))
+
+	a.Current = swap.State_ClaimedPreimage
+	a.Data.ClaimTxId = "claimtxid"
+	require.NoError(t, store.UpdateData(a))
+
+	got, err := store.GetData(a.SwapId.String())
+	require.NoError(t, err)
+	assert.Equal(t, swap.State_ClaimedPreimage, got.Current)
+	assert.Equal(t, "claimtxid", got.Data.ClaimTxId)
+	assert.Equal(t, "alice", got.Data.PeerNodeId)
+
+	all, err := store.ListAll()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, b.SwapId.String(), all[0].SwapId.String())
+
+	byPeer, err := store.ListAllByPeer("alice")
+	require.NoError(t, err)
+	require.Len(t, byPeer, 1)
+	assert.Equal(t, a.SwapId.String(), byPeer[0].SwapId.String())
+
+	var state string
+	require.NoError(t, db.QueryRow(`SELECT state FROM swaps WHERE swap_id = $1`, a.SwapId.String()).Scan(&state))
+	assert.Equal(t, string(swap.State_ClaimedPreimage), state)
+}
+
+func Test_SwapStore_ListFiltered(t *testing.T) {
+	store := NewSwapStore(openTestDb(t))
+
+	var ids []string
+	for i, state := range []swap.StateType{swap.State_ClaimedPreimage, swap.State_SwapOutSender_AwaitTxConfirmation, swap.State_SwapCanceled, swap.State_ClaimedCsv} {
+		sw := newTestSwap("bob", int64(100*(i+1)))
+		sw.Current = state
+		require.NoError(t, store.UpdateData(sw))
+		ids = append(ids, sw.SwapId.String())
+	}
+	// Swaps with the same creation time are ordered by id.
+	sw := newTestSwap("carol", 400)
+	sw.Data.SwapOutRequest.Scid = "4x5x6"
+	require.NoError(t, store.UpdateData(sw))
+	if sw.SwapId.String() < ids[3] {
+		ids = append(ids[:3], sw.SwapId.String(), ids[3])
+	} else {
+		ids = append(ids, sw.SwapId.String())
+	}
+
+	swapIds := func(swaps []*swap.SwapStateMachine) []string {
+		var ids []string
+		for _, sw := range swaps {
+			ids = append(ids, sw.SwapId.String())
+		}
+		return ids
+	}
+
+	var got []string
+	filter := &swap.ListSwapsFilter{Limit: 2}
+	for {
+		swaps, err := store.ListFiltered(filter)
+		require.NoError(t, err)
+		got = append(got, swapIds(swaps)...)
+		if len(swaps) < filter.Limit {
+			break
+		}
+		filter.Cursor = swap.NewListSwapsCursor(swaps[len(swaps)-1]).String()
+	}
+	assert.Equal(t, ids, got)
+
+	third, err := store.GetData(ids[2])
+	require.NoError(t, err)
+	swaps, err := store.ListFiltered(&swap.ListSwapsFilter{Descending: true, Limit: 2, Cursor: swap.NewListSwapsCursor(third).String()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[1], ids[0]}, swapIds(swaps))
+
+	finished := true
+	swaps, err = store.ListFiltered(&swap.ListSwapsFilter{
+		PeerNodeId: "bob",
+		Finished:   &finished,
+		From:       time.Unix(200, 0),
+		Descending: true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, swaps, 2)
+	assert.Equal(t, swap.State_ClaimedCsv, swaps[0].Current)
+	assert.Equal(t, swap.State_SwapCanceled, swaps[1].Current)
+
+	swaps, err = store.ListFiltered(&swap.ListSwapsFilter{ChannelId: "4:5:6"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{sw.SwapId.String()}, swapIds(swaps))
+
+	swaps, err = store.ListFiltered(&swap.ListSwapsFilter{States: []swap.StateType{swap.State_SwapOutSender_AwaitTxConfirmation}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[1], sw.SwapId.String()}, swapIds(swaps))
+}
+
+func Test_SwapStore_Archive(t *testing.T) {
+	store := NewSwapStore(openTestDb(t))
+
+	a := newTestSwap("alice", 200)
+	b := newTestSwap("bob", 100)
+	require.NoError(t, store.UpdateData(a))
+	require.NoError(t, store.UpdateData(b))
+
+	for _, sw := range []*swap.SwapStateMachine{a, b} {
+		require.NoError(t, store.ArchiveSwap(&swap.ArchivedSwap{
+			SwapExport: swap.SwapExport{SwapId: sw.SwapId.String(), PeerNodeId: sw.Data.PeerNodeId, CreatedAt: sw.Data.CreatedAt},
+			ArchivedAt: 1000,
+		}))
+	}
+
+	all, err := store.ListAll()
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	archived, err := store.ListArchived()
+	require.NoError(t, err)
+	require.Len(t, archived, 2)
+	assert.Equal(t, b.SwapId.String(), archived[0].SwapId)
+	assert.Equal(t, "alice", archived[1].PeerNodeId)
+	assert.EqualValues(t, 1000, archived[1].ArchivedAt)
+}
+
+func Test_SwapStore_PeerOutcomes(t *testing.T) {
+	store := NewSwapStore(openTestDb(t))
+
+	first := &swap.PeerOutcome{SwapId: swap.NewSwapId().String(), PeerNodeId: "alice", Outcome: swap.OutcomeCsvClaim, FinishedAt: 200}
+	second := &swap.PeerOutcome{SwapId: swap.NewSwapId().String(), PeerNodeId: "alice", Outcome: swap.OutcomeSuccess, FinishedAt: 100}
+	other := &swap.PeerOutcome{SwapId: swap.NewSwapId().String(), PeerNodeId: "bob", Outcome: swap.OutcomeTimeout, FinishedAt: 300}
+	for _, o := range []*swap.PeerOutcome{first, second, other, first} {
+		require.NoError(t, store.AddPeerOutcome(o))
+	}
+
+	outcomes, err := store.ListPeerOutcomes("alice")
+	require.NoError(t, err)
+	assert.Equal(t, []*swap.PeerOutcome{second, first}, outcomes)
+
+	outcomes, err = store.ListPeerOutcomes("")
+	require.NoError(t, err)
+	assert.Len(t, outcomes, 3)
+}
+
+func Test_RequestedSwapsStore(t *testing.T) {
+	store := NewRequestedSwapsStore(openTestDb(t))
+
+	first := swap.RequestedSwap{Asset: "btc", AmountSat: 100000, Type: swap.SWAPTYPE_IN, RejectionReason: "not allowed"}
+	second := swap.RequestedSwap{Asset: "lbtc", AmountSat: 200000, Type: swap.SWAPTYPE_OUT, RejectionReason: "no balance"}
+	require.NoError(t, store.Add("alice", first))
+	require.NoError(t, store.Add("alice", second))
+	require.NoError(t, store.Add("bob", first))
+
+	reqswaps, err := store.Get("alice")
+	require.NoError(t, err)
+	assert.Equal(t, []swap.RequestedSwap{first, second}, reqswaps)
+
+	all, err := store.GetAll()
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, []swap.RequestedSwap{first}, all["bob"])
+}
+
+func Test_PollStore(t *testing.T) {
+	store := NewPollStore(openTestDb(t))
+	now := time.Now()
+
+	require.NoError(t, store.Update("alice", poll.PollInfo{ProtocolVersion: 3, Assets: []string{"btc"}, LastSeen: now}))
+	require.NoError(t, store.Update("bob", poll.PollInfo{ProtocolVersion: 3, LastSeen: now.Add(-2 * time.Hour)}))
+	require.NoError(t, store.Update("alice", poll.PollInfo{ProtocolVersion: 4, Assets: []string{"btc"}, LastSeen: now}))
+
+	polls, err := store.GetAll()
+	require.NoError(t, err)
+	require.Len(t, polls, 2)
+	assert.EqualValues(t, 4, polls["alice"].ProtocolVersion)
+
+	require.NoError(t, store.RemoveUnseen(now, time.Hour))
+	polls, err = store.GetAll()
+	require.NoError(t, err)
+	assert.Len(t, polls, 1)
+	assert.Contains(t, polls, "alice")
+}
+
+func Test_VersionStore(t *testing.T) {
+	store := NewVersionStore(openTestDb(t))
+
+	_, err := store.GetVersion()
+	assert.ErrorIs(t, err, version.ErrDoesNotExist)
+
+	require.NoError(t, store.SetVersion("v0.2"))
+	require.NoError(t, store.SetVersion("v0.3"))
+	v, err := store.GetVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "v0.3", v)
+}
+
+func Test_MigrateFromBolt(t *testing.T) {
+	boltDb, err := bbolt.Open(filepath.Join(t.TempDir(), "swaps"), 0700, nil)
+	require.NoError(t, err)
+	defer boltDb.Close()
+
+	boltSwaps, err := swap.NewBboltStore(boltDb)
+	require.NoError(t, err)
+	a := newTestSwap("alice", 100)
+	require.NoError(t, boltSwaps.UpdateData(a))
+	require.NoError(t, boltSwaps.UpdateData(newTestSwap("bob", 200)))
+	c := newTestSwap("carol", 300)
+	require.NoError(t, boltSwaps.UpdateData(c))
+	require.NoError(t, boltSwaps.ArchiveSwap(&swap.ArchivedSwap{SwapExport: swap.SwapExport{SwapId: c.SwapId.String(), PeerNodeId: "carol", CreatedAt: 300}}))
+	require.NoError(t, boltSwaps.AddPeerOutcome(&swap.PeerOutcome{SwapId: c.SwapId.String(), PeerNodeId: "carol", Outcome: swap.OutcomeSuccess, FinishedAt: 400}))
+
+	boltRequested, err := swap.NewRequestedSwapsStore(boltDb)
+	require.NoError(t, err)
+	require.NoError(t, boltRequested.Add("alice", swap.RequestedSwap{Asset: "btc", AmountSat: 1}))
+	require.NoError(t, boltRequested.Add("alice", swap.RequestedSwap{Asset: "btc", AmountSat: 2}))
+
+	boltPolls, err := poll.NewStore(boltDb)
+	require.NoError(t, err)
+	require.NoError(t, boltPolls.Update("alice", poll.PollInfo{ProtocolVersion: 3, LastSeen: time.Now()}))
+
+	boltVersion, err := version.NewVersionStore(boltDb)
+	require.NoError(t, err)
+	require.NoError(t, boltVersion.SetVersion("v0.2"))
+
+	db := openTestDb(t)
+	report, err := MigrateFromBolt(boltDb, db)
+	require.NoError(t, err)
+	assert.Equal(t, &MigrationReport{Swaps: 2, ArchivedSwaps: 1, PeerOutcomes: 1, RequestedSwaps: 2, Polls: 1, Version: "v0.2"}, report)
+
+	got, err := NewSwapStore(db).GetData(a.SwapId.String())
+	require.NoError(t, err)
+	assert.Equal(t, a.Data.CreatedAt, got.Data.CreatedAt)
+
+	// A second migration into the same database is refused.
+	_, err = MigrateFromBolt(boltDb, db)
+	assert.Error(t, err)
+}
+
+func Test_ParseBackend(t *testing.T) {
+	backend, err := ParseBackend("")
+	require.NoError(t, err)
+	assert.Equal(t, BackendBolt, backend)
+
+	backend, err = ParseBackend("SQLite")
+	require.NoError(t, err)
+	assert.Equal(t, BackendSqlite, backend)
+
+	_, err = ParseBackend("mysql")
+	assert.Error(t, err)
+}
+
+func openTestDb(t *testing.T) *DB {
+	db, err := Open(BackendSqlite, filepath.Join(t.TempDir(), "swaps.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestSwap(peer string, createdAt int64) *swap.SwapStateMachine {
+	swapId := swap.NewSwapId()
+	return &swap.SwapStateMachine{
+		SwapId:  swapId,
+		Type:    swap.SWAPTYPE_OUT,
+		Role:    swap.SWAPROLE_SENDER,
+		Current: swap.State_SwapOutSender_AwaitTxConfirmation,
+		Data: &swap.SwapData{
+			PeerNodeId: peer,
+			CreatedAt:  createdAt,
+			SwapOutRequest: &swap.SwapOutRequestMessage{
+				SwapId: swapId,
+				Scid:   "1x2x3",
+				Amount: 100000,
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package sqlstore
+
+import (
+	"github.com/elementsproject/peerswap/poll"
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/elementsproject/peerswap/version"
+	"go.etcd.io/bbolt"
+)
+
+// Stores holds the stores of the configured backend.
+type Stores struct {
+	Swaps          swap.Store
+	RequestedSwaps swap.RequestedSwapsStore
+	Polls          poll.Store
+	Version        version.Store
+
+	close func() error
+}
+
+// OpenStores opens the stores on the backend. boltPath is the path of the
+// bbolt database, the sqlite database defaults to boltPath.sqlite if the dsn
+// is empty.
+func OpenStores(backend Backend, dsn string, boltPath string) (*Stores, error) {
+	if backend == BackendBolt {
+		return openBoltStores(boltPath)
+	}
+
+	if backend == BackendSqlite && dsn == "" {
+		dsn = boltPath + ".sqlite"
+	}
+	db, err := Open(backend, dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &Stores{
+		Swaps:          NewSwapStore(db),
+		RequestedSwaps: NewRequestedSwapsStore(db),
+		Polls:          NewPollStore(db),
+		Version:        NewVersionStore(db),
+		close:          db.Close,
+	}, nil
+}
+
+func openBoltStores(path string) (*Stores, error) {
+	boltDb, err := bbolt.Open(path, 0700, nil)
+	if err != nil {
+		return nil, err
+	}
+	s, err := newBoltStores(boltDb)
+	if err != nil {
+		boltDb.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func newBoltStores(boltDb *bbolt.DB) (*Stores, error) {
+	var err error
+	s := &Stores{close: boltDb.Close}
+	if s.Swaps, err = swap.NewBboltStore(boltDb); err != nil {
+		return nil, err
+	}
+	if s.RequestedSwaps, err = swap.NewRequestedSwapsStore(boltDb); err != nil {
+		return nil, err
+	}
+	if s.Polls, err = poll.NewStore(boltDb); err != nil {
+		return nil, err
+	}
+	if s.Version, err = version.NewVersionStore(boltDb); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Stores) Close() error {
+	return s.close()
+}
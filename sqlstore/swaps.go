@@ -0,0 +1,244 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elementsproject/peerswap/swap"
+)
+
+// SwapStore implements swap.Store. The swap is stored as json like in the
+// bbolt store, the columns that are queried are stored next to it.
+type SwapStore struct {
+	db *DB
+}
+
+func NewSwapStore(db *DB) *SwapStore {
+	return &SwapStore{db: db}
+}
+
+func (s *SwapStore) UpdateData(data *swap.SwapStateMachine) error {
+	return putSwap(s.db, data)
+}
+
+func (s *SwapStore) GetData(id string) (*swap.SwapStateMachine, error) {
+	row := s.db.QueryRow(`SELECT data FROM swaps WHERE swap_id = $1`, id)
+	var data string
+	err := row.Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, swap.ErrDataNotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSwap(data)
+}
+
+func (s *SwapStore) ListAll() ([]*swap.SwapStateMachine, error) {
+	return s.query(`SELECT data FROM swaps ORDER BY created_at, swap_id`)
+}
+
+func (s *SwapStore) ListAllByPeer(peer string) ([]*swap.SwapStateMachine, error) {
+	return s.query(`SELECT data FROM swaps WHERE peer_node_id = $1 ORDER BY created_at, swap_id`, peer)
+}
+
+// ListFiltered implements swap.FilteredStore. It selects the swaps by the
+// indexed columns and checks the remaining fields of the filter on the
+// decoded swaps.
+func (s *SwapStore) ListFiltered(filter *swap.ListSwapsFilter) ([]*swap.SwapStateMachine, error) {
+	cursor, err := filter.ParseCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	var where []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	in := func(states []swap.StateType) string {
+		var params []string
+		for _, state := range states {
+			params = append(params, arg(string(state)))
+		}
+		return "(" + strings.Join(params, ", ") + ")"
+	}
+
+	if filter.PeerNodeId != "" {
+		where = append(where, "peer_node_id = "+arg(filter.PeerNodeId))
+	}
+	if filter.ChannelId != "" {
+		where = append(where, "channel_id = "+arg(filter.Scid()))
+	}
+	if len(filter.States) > 0 {
+		where = append(where, "state IN "+in(filter.States))
+	}
+	if filter.Finished != nil {
+		if *filter.Finished {
+			where = append(where, "state IN "+in(swap.FinalStates))
+		} else {
+			where = append(where, "state NOT IN "+in(swap.FinalStates))
+		}
+	}
+	if !filter.From.IsZero() {
+		where = append(where, "created_at >= "+arg(filter.From.Unix()))
+	}
+	if !filter.To.IsZero() {
+		where = append(where, "created_at < "+arg(filter.To.Unix()))
+	}
+	order := "ASC"
+	cmp := ">"
+	if filter.Descending {
+		order = "DESC"
+		cmp = "<"
+	}
+	if cursor != nil {
+		createdAt := arg(cursor.CreatedAt)
+		swapId := arg(cursor.SwapId)
+		where = append(where, fmt.Sprintf("(created_at %s %s OR (created_at = %s AND swap_id %s %s))",
+			cmp, createdAt, createdAt, cmp, swapId))
+	}
+
+	query := `SELECT data FROM swaps`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(` ORDER BY created_at %s, swap_id %s`, order, order)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var swaps []*swap.SwapStateMachine
+	for rows.Next() {
+		if filter.Limit > 0 && len(swaps) >= filter.Limit {
+			break
+		}
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		sw, err := unmarshalSwap(data)
+		if err != nil {
+			return nil, err
+		}
+		if filter.Matches(sw) {
+			swaps = append(swaps, sw)
+		}
+	}
+	return swaps, rows.Err()
+}
+
+// ArchiveSwap implements swap.ArchiveStore.
+func (s *SwapStore) ArchiveSwap(archived *swap.ArchivedSwap) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := putArchivedSwap(tx, archived); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM swaps WHERE swap_id = $1`, archived.SwapId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListArchived implements swap.ArchiveStore.
+func (s *SwapStore) ListArchived() ([]*swap.ArchivedSwap, error) {
+	rows, err := s.db.Query(`SELECT data FROM archived_swaps ORDER BY created_at, swap_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var archived []*swap.ArchivedSwap
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		a := &swap.ArchivedSwap{}
+		if err := json.Unmarshal([]byte(data), a); err != nil {
+			return nil, err
+		}
+		archived = append(archived, a)
+	}
+	return archived, rows.Err()
+}
+
+func (s *SwapStore) query(query string, args ...interface{}) ([]*swap.SwapStateMachine, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var swaps []*swap.SwapStateMachine
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		sw, err := unmarshalSwap(data)
+		if err != nil {
+			return nil, err
+		}
+		swaps = append(swaps, sw)
+	}
+	return swaps, rows.Err()
+}
+
+func putSwap(q querier, sw *swap.SwapStateMachine) error {
+	data, err := json.Marshal(sw)
+	if err != nil {
+		return err
+	}
+	var peerNodeId, channelId string
+	var createdAt int64
+	if sw.Data != nil {
+		peerNodeId = sw.Data.PeerNodeId
+		channelId = sw.Data.GetScid()
+		createdAt = sw.Data.CreatedAt
+	}
+	_, err = q.Exec(`INSERT INTO swaps (swap_id, peer_node_id, state, created_at, channel_id, data)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (swap_id) DO UPDATE SET
+			peer_node_id = excluded.peer_node_id,
+			state = excluded.state,
+			created_at = excluded.created_at,
+			channel_id = excluded.channel_id,
+			data = excluded.data`,
+		sw.SwapId.String(), peerNodeId, string(sw.Current), createdAt, channelId, string(data))
+	return err
+}
+
+func putArchivedSwap(q querier, archived *swap.ArchivedSwap) error {
+	data, err := json.Marshal(archived)
+	if err != nil {
+		return err
+	}
+	_, err = q.Exec(`INSERT INTO archived_swaps (swap_id, peer_node_id, created_at, data)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (swap_id) DO UPDATE SET
+			peer_node_id = excluded.peer_node_id,
+			created_at = excluded.created_at,
+			data = excluded.data`,
+		archived.SwapId, archived.PeerNodeId, archived.CreatedAt, string(data))
+	return err
+}
+
+func unmarshalSwap(data string) (*swap.SwapStateMachine, error) {
+	sw := &swap.SwapStateMachine{}
+	if err := json.Unmarshal([]byte(data), sw); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
@@ -0,0 +1,38 @@
+package sqlstore
+
+import (
+	"database/sql"
+
+	"github.com/elementsproject/peerswap/version"
+)
+
+// VersionStore implements version.Store.
+type VersionStore struct {
+	db *DB
+}
+
+func NewVersionStore(db *DB) *VersionStore {
+	return &VersionStore{db: db}
+}
+
+func (s *VersionStore) GetVersion() (string, error) {
+	var v string
+	err := s.db.QueryRow(`SELECT value FROM version WHERE name = 'version'`).Scan(&v)
+	if err == sql.ErrNoRows {
+		return "", version.ErrDoesNotExist
+	}
+	if err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+func (s *VersionStore) SetVersion(v string) error {
+	return setVersion(s.db, v)
+}
+
+func setVersion(q querier, v string) error {
+	_, err := q.Exec(`INSERT INTO version (name, value) VALUES ('version', $1)
+		ON CONFLICT (name) DO UPDATE SET value = excluded.value`, v)
+	return err
+}
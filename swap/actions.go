@@ -29,7 +29,6 @@ type CheckRequestWrapperAction struct {
 
 func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapData) EventType {
 	if !services.policy.NewSwapsAllowed() {
-		swap.LastErr = errors.New("swaps are disabled")
 		swap.CancelMessage = "swaps are disabled"
 		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
 			Asset:           swap.GetChain(),
@@ -37,11 +36,10 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_SwapsDisabled, Message: swap.CancelMessage})
 	}
 
 	if swap.GetChain() == l_btc_chain && !services.liquidEnabled {
-		swap.LastErr = errors.New("lbtc swaps are not supported")
 		swap.CancelMessage = "lbtc swaps are not supported"
 		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
 			Asset:           swap.GetChain(),
@@ -49,11 +47,10 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_AssetNotSupported, Message: swap.CancelMessage})
 	}
 
 	if swap.GetChain() == btc_chain && !services.bitcoinEnabled {
-		swap.LastErr = errors.New("btc swaps are not supported")
 		swap.CancelMessage = "btc swaps are not supported"
 		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
 			Asset:           swap.GetChain(),
@@ -61,29 +58,31 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_AssetNotSupported, Message: swap.CancelMessage})
 	}
 
-	if swap.GetProtocolVersion() != PEERSWAP_PROTOCOL_VERSION {
-		swap.CancelMessage = "incompatible peerswap version"
+	if !IsSupportedProtocolVersion(uint64(swap.GetProtocolVersion())) {
+		swap.CancelMessage = fmt.Sprintf("incompatible peerswap version %d, supported are %d to %d",
+			swap.GetProtocolVersion(), PEERSWAP_MIN_PROTOCOL_VERSION, PEERSWAP_MAX_PROTOCOL_VERSION)
 		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
 			Asset:           swap.GetChain(),
 			AmountSat:       swap.GetAmount(),
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_IncompatibleVersion, Message: swap.CancelMessage})
 	}
+	swap.ProtocolVersion = swap.GetProtocolVersion()
 
-	if swap.GetAmount()*1000 < services.policy.GetMinSwapAmountMsat() {
-		swap.CancelMessage = ErrMinimumSwapSize(services.policy.GetMinSwapAmountMsat()).Error()
+	if minMsat := services.policy.GetPeerMinSwapAmountMsat(swap.PeerNodeId); swap.GetAmount()*1000 < minMsat {
+		swap.CancelMessage = ErrMinimumSwapSize(minMsat).Error()
 		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
 			Asset:           swap.GetChain(),
 			AmountSat:       swap.GetAmount(),
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(ErrMinimumSwapSize(minMsat))
 	}
 
 	_, wallet, _, err := services.getOnChainServices(swap.GetChain())
@@ -99,7 +98,7 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_AssetNotSupported, Message: swap.CancelMessage})
 	}
 
 	if swap.GetNetwork() != "" && swap.GetNetwork() != wallet.GetNetwork() {
@@ -110,7 +109,7 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 			Type:            swap.GetType(),
 			RejectionReason: swap.CancelMessage,
 		})
-		return swap.HandleError(errors.New(swap.CancelMessage))
+		return swap.HandleError(CancelError{Code: CancelCode_AssetNotSupported, Message: swap.CancelMessage})
 	}
 
 	if !services.policy.IsPeerAllowed(swap.PeerNodeId) {
@@ -135,6 +134,42 @@ func (a CheckRequestWrapperAction) Execute(services *SwapServices, swap *SwapDat
 		return swap.HandleError(PeerIsSuspiciousError(swap.PeerNodeId))
 	}
 
+	if services.policy.IsChannelExcluded(swap.GetScid()) {
+		err = ChannelExcludedError(swap.GetScid())
+		swap.CancelMessage = err.Error()
+		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
+			Asset:           swap.GetChain(),
+			AmountSat:       swap.GetAmount(),
+			Type:            swap.GetType(),
+			RejectionReason: swap.CancelMessage,
+		})
+		return swap.HandleError(err)
+	}
+
+	err = services.policy.CheckPeerSwapRequest(swap.PeerNodeId, swap.GetChain(), swap.GetType().String(), swap.GetAmount())
+	if err != nil {
+		swap.CancelMessage = err.Error()
+		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
+			Asset:           swap.GetChain(),
+			AmountSat:       swap.GetAmount(),
+			Type:            swap.GetType(),
+			RejectionReason: swap.CancelMessage,
+		})
+		return swap.HandleError(err)
+	}
+
+	err = checkRequestLimits(services, swap)
+	if err != nil {
+		swap.CancelMessage = err.Error()
+		services.requestedSwapsStore.Add(swap.PeerNodeId, RequestedSwap{
+			Asset:           swap.GetChain(),
+			AmountSat:       swap.GetAmount(),
+			Type:            swap.GetType(),
+			RejectionReason: swap.CancelMessage,
+		})
+		return swap.HandleError(err)
+	}
+
 	// Call next Action
 	return a.next.Execute(services, swap)
 }
@@ -145,11 +180,10 @@ type SwapInReceiverInitAction struct{}
 
 func (s *SwapInReceiverInitAction) Execute(services *SwapServices, swap *SwapData) EventType {
 	agreementMessage := &SwapInAgreementMessage{
-		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+		ProtocolVersion: swap.GetProtocolVersion(),
 		SwapId:          swap.GetId(),
 		Pubkey:          hex.EncodeToString(swap.GetPrivkey().PubKey().SerializeCompressed()),
-		// todo: set premium
-		Premium: 0,
+		Premium:         services.policy.GetPeerPremium(swap.PeerNodeId, swap.GetChain(), swap.GetAmount()),
 	}
 	swap.SwapInAgreement = agreementMessage
 
@@ -175,6 +209,9 @@ func (s *SwapData) HandleError(err error) EventType {
 	if s.CancelMessage == "" {
 		s.CancelMessage = s.LastErrString
 	}
+	if err != nil {
+		s.setCancelCode(CancelCodeFromError(err))
+	}
 	return Event_ActionFailed
 }
 
@@ -189,12 +226,13 @@ func (s *ClaimSwapTransactionWithPreimageAction) Execute(services *SwapServices,
 	}
 
 	if swap.ClaimTxId == "" {
-		txId, _, address, err := wallet.CreatePreimageSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams())
+		txId, txHex, address, err := wallet.CreatePreimageSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams())
 		if err != nil {
 			log.Infof("Error claiming tx with preimage %v", err)
 			return Event_OnRetry
 		}
 		swap.ClaimTxId = txId
+		setClaimTxFee(wallet, swap, txHex)
 		err = wallet.SetLabel(txId, address, labels.ClaimByInvoice(swap.GetId().Short()))
 		if err != nil {
 			log.Infof("Error labeling transaction. txid: %s, label: %s, error: %v",
@@ -217,6 +255,22 @@ func (c *CreateAndBroadcastOpeningTransaction) Execute(services *SwapServices, s
 		return Event_ActionSucceeded
 	}
 
+	// Check that the premium that our peer asks for is acceptable. Only the
+	// swap-in sender pays a premium here, the swap-out sender checks the
+	// premium before paying the fee invoice.
+	if swap.GetType() == SWAPTYPE_IN && swap.GetPremium() > swap.MaxPremium {
+		return swap.HandleError(PremiumLimitExceededError{Premium: swap.GetPremium(), MaxPremium: swap.MaxPremium})
+	}
+	if swap.GetPremium() >= swap.GetAmount() {
+		return swap.HandleError(fmt.Errorf("premium %d exceeds swap amount %d", swap.GetPremium(), swap.GetAmount()))
+	}
+
+	// The balance might have been spent since the swap was agreed on.
+	err = services.checkWalletReserve(swap.GetChain(), swap.GetAmount(), swap.GetId().String())
+	if err != nil {
+		return swap.HandleError(err)
+	}
+
 	// Generate Preimage
 	preimage, err := lightning.GetPreimage()
 	if err != nil {
@@ -226,26 +280,21 @@ func (c *CreateAndBroadcastOpeningTransaction) Execute(services *SwapServices, s
 
 	// Construct memo
 	memo := fmt.Sprintf("peerswap %s %s %s %s", swap.GetChain(), INVOICE_CLAIM, swap.GetScidInBoltFormat(), swap.GetId())
-	payreq, err := services.lightning.GetPayreq((swap.GetAmount())*1000, preimage.String(), swap.GetId().String(), memo, INVOICE_CLAIM, swap.GetInvoiceExpiry(), swap.GetInvoiceCltv())
+	payreq, err := services.lightning.GetPayreq(swap.GetClaimInvoiceAmount()*1000, preimage.String(), swap.GetId().String(), memo, INVOICE_CLAIM, swap.GetInvoiceExpiry(), swap.GetInvoiceCltv())
 	if err != nil {
 		return swap.HandleError(err)
 	}
 
-	var blindingKey *btcec.PrivateKey
+	openingParams := swap.GetOpeningParams()
+	openingParams.ClaimPaymentHash = preimage.Hash().String()
+
 	var blindingKeyHex string
 	if swap.GetChain() == l_btc_chain {
-		blindingKey = swap.GetOpeningParams().BlindingKey
-		blindingKeyHex = hex.EncodeToString(blindingKey.Serialize())
+		blindingKeyHex = hex.EncodeToString(openingParams.BlindingKey.Serialize())
 	}
 
 	// Create the opening transaction
-	txHex, address, txId, _, vout, err := wallet.CreateOpeningTransaction(&OpeningParams{
-		TakerPubkey:      swap.GetTakerPubkey(),
-		MakerPubkey:      swap.GetMakerPubkey(),
-		ClaimPaymentHash: preimage.Hash().String(),
-		Amount:           swap.GetAmount(),
-		BlindingKey:      blindingKey,
-	})
+	txHex, address, txId, _, vout, err := wallet.CreateOpeningTransaction(openingParams)
 	if err != nil {
 		return swap.HandleError(err)
 	}
@@ -378,15 +427,24 @@ func (c *CreateSwapOutFromRequestAction) Execute(services *SwapServices, swap *S
 		return swap.HandleError(err)
 	}
 
-	// Check if onchain balance is sufficient for swap + fees
-	walletBalance, err := wallet.GetOnchainBalance()
+	// Check if onchain balance is sufficient for swap + fees. The funds that
+	// other swaps still need for their opening transactions are not
+	// available.
+	walletBalance, err := services.availableOnchainSat(wallet, swap.GetChain(), swap.GetId().String())
 	if err != nil {
 		return swap.HandleError(err)
 	}
 	if walletBalance < swap.GetAmount()+openingFee {
-		return swap.HandleError(errors.New("insufficient walletbalance"))
+		return swap.HandleError(CancelError{Code: CancelCode_InsufficientBalance, Message: "insufficient walletbalance"})
+	}
+	err = services.checkOnchainReserve(swap.GetChain(), walletBalance, swap.GetAmount()+openingFee)
+	if err != nil {
+		return swap.HandleError(err)
 	}
 
+	// The premium is added to the fee invoice.
+	premium := services.policy.GetPeerPremium(swap.PeerNodeId, swap.GetChain(), swap.GetAmount())
+
 	// Construct memo
 	memo := fmt.Sprintf("peerswap %s %s %s %s", swap.GetChain(), INVOICE_FEE, swap.GetScidInBoltFormat(), swap.GetId())
 
@@ -395,16 +453,17 @@ func (c *CreateSwapOutFromRequestAction) Execute(services *SwapServices, swap *S
 	if err != nil {
 		return swap.HandleError(err)
 	}
-	feeInvoice, err := services.lightning.GetPayreq(openingFee*1000, feepreimage.String(), swap.GetId().String(), memo, INVOICE_FEE, 600, 0)
+	feeInvoice, err := services.lightning.GetPayreq((openingFee+premium)*1000, feepreimage.String(), swap.GetId().String(), memo, INVOICE_FEE, 600, 0)
 	if err != nil {
 		return swap.HandleError(err)
 	}
 
 	message := &SwapOutAgreementMessage{
-		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+		ProtocolVersion: swap.GetProtocolVersion(),
 		SwapId:          swap.GetId(),
 		Pubkey:          hex.EncodeToString(swap.GetPrivkey().PubKey().SerializeCompressed()),
 		Payreq:          feeInvoice,
+		Premium:         premium,
 	}
 	swap.SwapOutAgreement = message
 
@@ -433,12 +492,13 @@ func (c *ClaimSwapTransactionWithCsv) Execute(services *SwapServices, swap *Swap
 	}
 
 	if swap.ClaimTxId == "" {
-		txId, _, address, err := wallet.CreateCsvSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams())
+		txId, txHex, address, err := wallet.CreateCsvSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams())
 		if err != nil {
 			swap.HandleError(err)
 			return Event_OnRetry
 		}
 		swap.ClaimTxId = txId
+		setClaimTxFee(wallet, swap, txHex)
 		err = wallet.SetLabel(txId, address, labels.ClaimByCsv(swap.GetId().Short()))
 		if err != nil {
 			log.Infof("Error labeling transaction. txid: %s, label: %s, error: %v",
@@ -465,11 +525,12 @@ func (c *ClaimSwapTransactionCoop) Execute(services *SwapServices, swap *SwapDat
 	takerKey, _ := btcec.PrivKeyFromBytes(takerKeyBytes)
 
 	if swap.ClaimTxId == "" {
-		txId, _, address, err := wallet.CreateCoopSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams(), &Secp256k1Signer{key: takerKey})
+		txId, txHex, address, err := wallet.CreateCoopSpendingTransaction(swap.GetOpeningParams(), swap.GetClaimParams(), &Secp256k1Signer{key: takerKey})
 		if err != nil {
 			return swap.HandleError(err)
 		}
 		swap.ClaimTxId = txId
+		setClaimTxFee(wallet, swap, txHex)
 		err = wallet.SetLabel(txId, address, labels.ClaimByCoop(swap.GetId().Short()))
 		if err != nil {
 			log.Infof("Error labeling transaction. txid: %s, label: %s, error: %v",
@@ -489,9 +550,14 @@ func (s *SendCancelAction) Execute(services *SwapServices, swap *SwapData) Event
 	}
 	messenger := services.messenger
 
+	code := swap.CancelCode
+	if code == "" {
+		code = CancelCodeFromError(swap.LastErr)
+	}
 	msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 		SwapId:  swap.GetId(),
 		Message: swap.CancelMessage,
+		Code:    code,
 	})
 	if err != nil {
 		return swap.HandleError(err)
@@ -615,7 +681,17 @@ func (r *PayFeeInvoiceAction) Execute(services *SwapServices, swap *SwapData) Ev
 		return swap.HandleError(fmt.Errorf("the prepayment probe was unsuccessful: %s", failureReason))
 	}
 
-	swap.OpeningTxFee = msatAmt / 1000
+	// Check that the premium that our peer asks for is acceptable and that it
+	// is covered by the fee invoice.
+	premium := swap.GetPremium()
+	if premium > swap.MaxPremium {
+		return swap.HandleError(PremiumLimitExceededError{Premium: premium, MaxPremium: swap.MaxPremium})
+	}
+	if msatAmt/1000 < premium {
+		return swap.HandleError(fmt.Errorf("fee invoice amount %d msat does not cover premium %d sat", msatAmt, premium))
+	}
+
+	swap.OpeningTxFee = msatAmt/1000 - premium
 
 	expectedFee, err := wallet.GetFlatOpeningTXFee()
 	if err != nil {
@@ -668,8 +744,15 @@ func (t *AwaitTxConfirmationAction) Execute(services *SwapServices, swap *SwapDa
 		))
 	}
 
-	// Next we check that the invoice amount matches the requested swap amount.
-	if msatAmount != swap.GetAmount()*1000 {
+	// Next we check that the invoice amount matches the requested swap amount
+	// minus a premium that we might have asked for. The claim invoice of a
+	// batched swap may be reduced by the part of the opening tx fee that the
+	// swap did not need because it shares the opening tx with other swaps.
+	minAmount := swap.GetClaimInvoiceAmount() * 1000
+	if swap.SwapOutRequest != nil && swap.SwapOutRequest.BatchId != "" && swap.OpeningTxFee < swap.GetClaimInvoiceAmount() {
+		minAmount -= swap.OpeningTxFee * 1000
+	}
+	if msatAmount > swap.GetClaimInvoiceAmount()*1000 || msatAmount < minAmount {
 		return swap.HandleError(fmt.Errorf(
 			"invoice amount does not equal swap amount, invoice: %v, swap %v",
 			swap.OpeningTxBroadcasted.Payreq,
@@ -846,7 +929,8 @@ type AddSuspiciousPeerAction struct {
 }
 
 func (c *AddSuspiciousPeerAction) Execute(services *SwapServices, swap *SwapData) EventType {
-	if err := services.policy.AddToSuspiciousPeerList(swap.PeerNodeId); err != nil {
+	err := services.addSuspiciousPeer(swap.PeerNodeId, swap.GetId().String(), "swap was claimed by csv")
+	if err != nil {
 		// Since retries are unlikely to succeed,log output and move to the next state.
 		log.Infof("error adding peer %s to suspicious peer list: %v", swap.PeerNodeId, err)
 		return c.next.Execute(services, swap)
@@ -854,3 +938,18 @@ func (c *AddSuspiciousPeerAction) Execute(services *SwapServices, swap *SwapData
 	log.Infof("added peer %s to suspicious peer list", swap.PeerNodeId)
 	return c.next.Execute(services, swap)
 }
+
+// setClaimTxFee records the fee of the claim transaction if the wallet can
+// tell it.
+func setClaimTxFee(wallet Wallet, swap *SwapData, txHex string) {
+	feeWallet, ok := wallet.(SpendingTxFeeWallet)
+	if !ok {
+		return
+	}
+	fee, err := feeWallet.GetSpendingTxFee(swap.GetOpeningParams(), txHex)
+	if err != nil {
+		log.Debugf("Error getting the fee of the claim transaction %s: %v", swap.ClaimTxId, err)
+		return
+	}
+	swap.ClaimTxFee = fee
+}
@@ -0,0 +1,183 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+)
+
+const (
+	// DefaultArchiveInterval is the default time between two runs of the
+	// automatic archival of finished swaps.
+	DefaultArchiveInterval = time.Hour
+
+	// ArchiveMinConfirmations is the number of confirmations that the claim
+	// of a swap needs before the swap is archived and its key material is
+	// dropped.
+	ArchiveMinConfirmations = 100
+
+	// MinArchiveAge is the minimum time since a swap finished before it can
+	// be archived. Request limits and the peer reputation count recent swaps
+	// from the live store.
+	MinArchiveAge = 24 * time.Hour
+)
+
+var ErrArchiveNotSupported = errors.New("archiving swaps is not supported by the store")
+
+var ErrArchiveAgeTooShort = fmt.Errorf("swaps can only be archived at least %v after they finished", MinArchiveAge)
+
+// ArchiveStore is implemented by stores that can archive finished swaps.
+type ArchiveStore interface {
+	// ArchiveSwap stores the archived swap and deletes the swap with the same
+	// id from the live swaps in one transaction. Stores that can not
+	// overwrite deleted records may keep the key material of the deleted
+	// swap in free space of the database file until it is reused.
+	ArchiveSwap(archived *ArchivedSwap) error
+	// ListArchived returns all archived swaps.
+	ListArchived() ([]*ArchivedSwap, error)
+}
+
+// ArchivedSwap is the compact record of a finished swap that is kept after
+// the swap is removed from the live store. It holds the accounting record of
+// the swap but no key material, preimages or raw transactions.
+type ArchivedSwap struct {
+	SwapExport
+	CancelMessage string     `json:"cancel_message,omitempty"`
+	CancelCode    CancelCode `json:"cancel_code,omitempty"`
+	ArchivedAt    int64      `json:"archived_at"`
+}
+
+// ArchiveSwaps archives the swaps that finished more than olderThan ago. A
+// swap is only archived once its claim transaction has at least
+// ArchiveMinConfirmations, or if the wallet can not tell the confirmations.
+// Swaps that finished before the finish time was recorded are aged by their
+// creation time. It returns the archived swaps.
+func (s *SwapService) ArchiveSwaps(olderThan time.Duration) ([]*ArchivedSwap, error) {
+	store, ok := s.swapServices.swapStore.(ArchiveStore)
+	if !ok {
+		return nil, ErrArchiveNotSupported
+	}
+	if olderThan < MinArchiveAge {
+		return nil, ErrArchiveAgeTooShort
+	}
+
+	finished := true
+	swaps, _, err := s.ListSwapsFiltered(&ListSwapsFilter{Finished: &finished})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var archived []*ArchivedSwap
+	for _, swap := range swaps {
+		finishedAt := swap.Data.FinishedAt
+		if finishedAt == 0 {
+			finishedAt = swap.Data.CreatedAt
+		}
+		if now.Sub(time.Unix(finishedAt, 0)) < olderThan {
+			continue
+		}
+		confirmed, err := s.isClaimDeeplyConfirmed(swap)
+		if err != nil {
+			log.Infof("[Archive] could not get confirmations of swap %s: %v", swap.SwapId, err)
+			continue
+		}
+		if !confirmed {
+			continue
+		}
+
+		a := &ArchivedSwap{
+			SwapExport:    *s.swapServices.exportSwap(swap),
+			CancelMessage: swap.Data.CancelMessage,
+			CancelCode:    cancelCode(swap.Data),
+			ArchivedAt:    now.Unix(),
+		}
+		if err := store.ArchiveSwap(a); err != nil {
+			return archived, err
+		}
+		archived = append(archived, a)
+	}
+	if len(archived) > 0 {
+		log.Infof("[Archive] archived %d swaps", len(archived))
+	}
+	return archived, nil
+}
+
+// isClaimDeeplyConfirmed returns true if the claim transaction of the swap has
+// at least ArchiveMinConfirmations. Swaps without a claim by us and swaps on
+// chains whose wallet can not tell the confirmations count as confirmed.
+func (s *SwapService) isClaimDeeplyConfirmed(swap *SwapStateMachine) (bool, error) {
+	if swap.Data.ClaimTxId == "" {
+		return true, nil
+	}
+	_, wallet, _, err := s.swapServices.getOnChainServices(swap.Data.GetChain())
+	if err != nil {
+		return false, err
+	}
+	confWallet, ok := wallet.(TxConfirmationsWallet)
+	if !ok {
+		return true, nil
+	}
+	confs, err := confWallet.GetTxConfirmations(swap.Data.ClaimTxId)
+	if err != nil {
+		return false, err
+	}
+	return confs >= ArchiveMinConfirmations, nil
+}
+
+// ListArchivedSwaps returns the archived swaps that match the filter, ordered
+// by creation time.
+func (s *SwapService) ListArchivedSwaps(filter *ExportFilter) ([]*ArchivedSwap, error) {
+	store, ok := s.swapServices.swapStore.(ArchiveStore)
+	if !ok {
+		return nil, ErrArchiveNotSupported
+	}
+	all, err := store.ListArchived()
+	if err != nil {
+		return nil, err
+	}
+
+	var archived []*ArchivedSwap
+	for _, a := range all {
+		if filter.PeerNodeId != "" && a.PeerNodeId != filter.PeerNodeId {
+			continue
+		}
+		createdAt := time.Unix(a.CreatedAt, 0)
+		if !filter.From.IsZero() && createdAt.Before(filter.From) {
+			continue
+		}
+		if !filter.To.IsZero() && !createdAt.Before(filter.To) {
+			continue
+		}
+		archived = append(archived, a)
+	}
+	sort.SliceStable(archived, func(i, j int) bool {
+		if archived[i].CreatedAt != archived[j].CreatedAt {
+			return archived[i].CreatedAt < archived[j].CreatedAt
+		}
+		return archived[i].SwapId < archived[j].SwapId
+	})
+	return archived, nil
+}
+
+// AutoArchiveSwaps archives the swaps that finished more than olderThan ago
+// on every interval until ctx is done.
+func (s *SwapService) AutoArchiveSwaps(ctx context.Context, interval, olderThan time.Duration) {
+	clock := time.NewTicker(interval)
+	defer clock.Stop()
+	for {
+		select {
+		case <-clock.C:
+			_, err := s.ArchiveSwaps(olderThan)
+			if err != nil {
+				log.Infof("[Archive] could not archive swaps: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
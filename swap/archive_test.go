@@ -0,0 +1,94 @@
+package swap
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_ArchiveSwaps(t *testing.T) {
+	swapService := getTestSetup("alice")
+	chain := swapService.swapServices.bitcoinWallet.(*dummyChain)
+
+	_, err := swapService.ArchiveSwaps(0)
+	assert.ErrorIs(t, err, ErrArchiveNotSupported)
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "swaps"), 0700, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	store, err := NewBboltStore(db)
+	require.NoError(t, err)
+	swapService.swapServices.swapStore = store
+
+	now := time.Now()
+	old := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage)
+	old.Data.PeerNodeId = "bob"
+	old.Data.CreatedAt = now.Add(-40 * 24 * time.Hour).Unix()
+	old.Data.FinishedAt = now.Add(-31 * 24 * time.Hour).Unix()
+	old.Data.PrivkeyBytes = []byte{1, 2, 3}
+	old.Data.ClaimPreimage = "preimage"
+	old.Data.ClaimTxId = "claimtxid"
+	require.NoError(t, store.UpdateData(old))
+
+	recent := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage)
+	recent.Data.CreatedAt = now.Add(-2 * 24 * time.Hour).Unix()
+	recent.Data.FinishedAt = now.Add(-24 * time.Hour).Unix()
+	require.NoError(t, store.UpdateData(recent))
+
+	active := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapOutSender_AwaitTxConfirmation)
+	active.Data.CreatedAt = now.Add(-40 * 24 * time.Hour).Unix()
+	require.NoError(t, store.UpdateData(active))
+
+	// Recent swaps still count for the request limits and the reputation.
+	_, err = swapService.ArchiveSwaps(0)
+	assert.ErrorIs(t, err, ErrArchiveAgeTooShort)
+	_, err = swapService.ArchiveSwaps(MinArchiveAge - time.Second)
+	assert.ErrorIs(t, err, ErrArchiveAgeTooShort)
+
+	// The claim is not deeply confirmed yet.
+	chain.txConfirmations = ArchiveMinConfirmations - 1
+	archived, err := swapService.ArchiveSwaps(30 * 24 * time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, archived)
+
+	chain.txConfirmations = ArchiveMinConfirmations
+	archived, err = swapService.ArchiveSwaps(30 * 24 * time.Hour)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Equal(t, old.SwapId.String(), archived[0].SwapId)
+	assert.Equal(t, "claimtxid", archived[0].ClaimTxId)
+
+	_, err = store.GetData(old.SwapId.String())
+	assert.ErrorIs(t, err, ErrDataNotAvailable)
+	_, err = store.GetData(recent.SwapId.String())
+	assert.NoError(t, err)
+	_, err = store.GetData(active.SwapId.String())
+	assert.NoError(t, err)
+
+	// The archive holds no key material.
+	list, err := swapService.ListArchivedSwaps(&ExportFilter{PeerNodeId: "bob"})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, old.SwapId.String(), list[0].SwapId)
+	stored, err := json.Marshal(list[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored), "private_key")
+	assert.NotContains(t, string(stored), "preimage")
+	assert.NotContains(t, string(stored), "txhex")
+
+	list, err = swapService.ListArchivedSwaps(&ExportFilter{PeerNodeId: "carol"})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// Archived swaps are still exported.
+	exports, err := swapService.ExportSwaps(&ExportFilter{})
+	require.NoError(t, err)
+	require.Len(t, exports, 2)
+	assert.Equal(t, old.SwapId.String(), exports[0].SwapId)
+	assert.Equal(t, recent.SwapId.String(), exports[1].SwapId)
+}
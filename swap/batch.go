@@ -0,0 +1,494 @@
+package swap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elementsproject/peerswap/labels"
+	"github.com/elementsproject/peerswap/lightning"
+	"github.com/elementsproject/peerswap/log"
+)
+
+const (
+	// maxBatchSize is the maximum number of swap-outs that can be funded by a
+	// single opening transaction.
+	maxBatchSize = 32
+
+	// openingTxBatchTimeout is the time the maker waits for all swaps of a
+	// batch to pay their fee invoice. After the timeout the swaps that are
+	// ready are funded without the missing ones.
+	openingTxBatchTimeout = 1 * time.Minute
+)
+
+var ErrBatchNotSupported = errors.New("batched swap-outs are only supported for btc")
+
+// BatchSwapOutRequest describes a single swap-out of a batch.
+type BatchSwapOutRequest struct {
+	PeerId    string
+	ChannelId string
+	AmtSat    uint64
+}
+
+// BatchWallet is implemented by wallets that can fund the opening outputs of
+// several swaps in a single transaction.
+type BatchWallet interface {
+	// PrepareBatchOpeningTransaction creates and signs a transaction that
+	// funds the opening outputs of the swaps without publishing it.
+	PrepareBatchOpeningTransaction(swapParams []*OpeningParams) (*BatchOpeningTx, error)
+	// PublishBatchOpeningTransaction publishes a prepared batch opening
+	// transaction and returns the hex of the published transaction.
+	PublishBatchOpeningTransaction(tx *BatchOpeningTx) (txHex string, err error)
+}
+
+// BatchOpeningTx is a batch opening transaction that is ready to be
+// published. The opening output of the i-th swap is sent to Addresses[i] at
+// output Vouts[i].
+type BatchOpeningTx struct {
+	TxId  string `json:"txid"`
+	TxHex string `json:"tx_hex"`
+	// Psbt is the signed psbt of the transaction for wallets that publish
+	// psbts.
+	Psbt      string   `json:"psbt,omitempty"`
+	Fee       uint64   `json:"fee"`
+	Addresses []string `json:"addresses"`
+	Vouts     []uint32 `json:"vouts"`
+}
+
+// BatchOpening is the part of a batch opening transaction that belongs to a
+// single swap. It is stored with the swap before the transaction is
+// published, so that a swap that was interrupted can publish the transaction
+// again instead of funding a second opening output.
+type BatchOpening struct {
+	Tx             *BatchOpeningTx `json:"tx"`
+	Index          int             `json:"index"`
+	ClaimPreimage  string          `json:"claim_preimage"`
+	Payreq         string          `json:"payreq"`
+	StartingHeight uint32          `json:"starting_height"`
+}
+
+// applyBatchOpening applies the recorded batch opening of the swap to the
+// swap data, just like the opening transaction of a single swap.
+func applyBatchOpening(data *SwapData, txHex string) error {
+	opening := data.BatchOpening
+	if opening == nil || opening.Tx == nil || opening.Index >= len(opening.Tx.Vouts) {
+		return errors.New("no batch opening recorded")
+	}
+	message := &OpeningTxBroadcastedMessage{
+		SwapId:    data.GetId(),
+		Payreq:    opening.Payreq,
+		TxId:      opening.Tx.TxId,
+		ScriptOut: opening.Tx.Vouts[opening.Index],
+	}
+	nextMessage, nextMessageType, err := MarshalPeerswapMessage(message)
+	if err != nil {
+		return err
+	}
+	data.ClaimPreimage = opening.ClaimPreimage
+	data.OpeningTxHex = txHex
+	data.StartingBlockHeight = opening.StartingHeight
+	data.OpeningTxBroadcasted = message
+	data.NextMessage = nextMessage
+	data.NextMessageType = nextMessageType
+	data.BatchOpening = nil
+	return nil
+}
+
+// batchClaimDiscount returns the amount that the claim invoice of a swap is
+// reduced by because the swap was funded together with other swaps. Every
+// swap paid the full opening transaction fee with its fee invoice but only
+// has to pay its share of the batch opening transaction fee.
+func batchClaimDiscount(lc LightningClient, swap *SwapData, batchFee uint64, batchSize int) (uint64, error) {
+	if batchSize < 2 || swap.SwapOutAgreement == nil {
+		return 0, nil
+	}
+	_, msatAmt, _, err := lc.DecodePayreq(swap.SwapOutAgreement.Payreq)
+	if err != nil {
+		return 0, err
+	}
+	premium := swap.SwapOutAgreement.Premium
+	if msatAmt/1000 <= premium {
+		return 0, nil
+	}
+	paidFee := msatAmt/1000 - premium
+	share := (batchFee + uint64(batchSize) - 1) / uint64(batchSize)
+	if paidFee <= share {
+		return 0, nil
+	}
+	discount := paidFee - share
+	if discount >= swap.GetClaimInvoiceAmount() {
+		return 0, nil
+	}
+	return discount, nil
+}
+
+// openingTxBatch collects the swaps of a batch that are ready to be funded.
+type openingTxBatch struct {
+	size    int
+	swapIds []string
+	timer   *time.Timer
+	funded  bool
+}
+
+// openingTxBatchService collects swap-out receiver swaps that belong to the
+// same batch and calls the fund callback as soon as all swaps of the batch
+// are ready or the batch timed out.
+type openingTxBatchService struct {
+	sync.Mutex
+	batches map[string]*openingTxBatch
+	timeout time.Duration
+	fund    func(batchId string, swapIds []string)
+}
+
+func newOpeningTxBatchService(timeout time.Duration, fund func(batchId string, swapIds []string)) *openingTxBatchService {
+	return &openingTxBatchService{
+		batches: make(map[string]*openingTxBatch),
+		timeout: timeout,
+		fund:    fund,
+	}
+}
+
+// join adds the swap to the batch. It returns false if the batch was already
+// funded, in which case the swap has to be funded on its own.
+func (b *openingTxBatchService) join(batchId string, size int, swapId string) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	batch, ok := b.batches[batchId]
+	if !ok {
+		batch = &openingTxBatch{size: size}
+		batch.timer = time.AfterFunc(b.timeout, func() { b.release(batchId) })
+		b.batches[batchId] = batch
+	}
+	if batch.funded {
+		return false
+	}
+
+	for _, id := range batch.swapIds {
+		if id == swapId {
+			return true
+		}
+	}
+	batch.swapIds = append(batch.swapIds, swapId)
+
+	if len(batch.swapIds) >= batch.size {
+		batch.timer.Stop()
+		b.fundBatch(batchId, batch)
+	}
+	return true
+}
+
+// release funds the swaps of a batch that timed out.
+func (b *openingTxBatchService) release(batchId string) {
+	b.Lock()
+	defer b.Unlock()
+
+	batch, ok := b.batches[batchId]
+	if !ok || batch.funded {
+		return
+	}
+	b.fundBatch(batchId, batch)
+}
+
+// fundBatch marks the batch as funded and calls the fund callback. The fund
+// callback sends events to the swaps of the batch, one of them may be the one
+// that is currently joining, so it is called asynchronously. The funded batch
+// is kept for another timeout so that late swaps know they have to be funded
+// on their own. Must be called with the lock held.
+func (b *openingTxBatchService) fundBatch(batchId string, batch *openingTxBatch) {
+	batch.funded = true
+	go b.fund(batchId, batch.swapIds)
+	time.AfterFunc(b.timeout, func() {
+		b.Lock()
+		defer b.Unlock()
+		delete(b.batches, batchId)
+	})
+}
+
+// batchOpeningTxContext is the event context that applies the recorded batch
+// opening to a single swap once the batch opening transaction was published.
+type batchOpeningTxContext struct {
+	openingTxHex string
+}
+
+func (c *batchOpeningTxContext) ApplyToSwapData(data *SwapData) error {
+	if data.OpeningTxBroadcasted != nil {
+		return AlreadyExistsError
+	}
+	return applyBatchOpening(data, c.openingTxHex)
+}
+
+func (c *batchOpeningTxContext) Validate(data *SwapData) error {
+	return nil
+}
+
+// JoinOpeningTxBatchWrapperAction adds a swap-out that is part of a batch to
+// the batch of its peer. Swaps that are not part of a batch, or that can not
+// be batched, call the next Action.
+type JoinOpeningTxBatchWrapperAction struct {
+	next Action
+}
+
+func (a *JoinOpeningTxBatchWrapperAction) Execute(services *SwapServices, swap *SwapData) EventType {
+	if swap.OpeningTxBroadcasted != nil || swap.SwapOutRequest == nil || swap.SwapOutRequest.BatchId == "" {
+		return a.next.Execute(services, swap)
+	}
+
+	_, wallet, _, err := services.getOnChainServices(swap.GetChain())
+	if err != nil {
+		return swap.HandleError(err)
+	}
+	batchWallet, ok := wallet.(BatchWallet)
+	if !ok {
+		return a.next.Execute(services, swap)
+	}
+
+	// The batch opening tx was recorded but we might have stopped before
+	// it was published, publish it again and continue with it.
+	if swap.BatchOpening != nil {
+		txHex, err := batchWallet.PublishBatchOpeningTransaction(swap.BatchOpening.Tx)
+		if err != nil {
+			log.Infof("[Batch] could not publish opening tx %s again: %v", swap.BatchOpening.Tx.TxId, err)
+			txHex = swap.BatchOpening.Tx.TxHex
+		}
+		err = applyBatchOpening(swap, txHex)
+		if err != nil {
+			return swap.HandleError(err)
+		}
+		return Event_ActionSucceeded
+	}
+
+	if services.batchService == nil {
+		return a.next.Execute(services, swap)
+	}
+
+	batchId := swap.PeerNodeId + swap.SwapOutRequest.BatchId
+	if !services.batchService.join(batchId, int(swap.SwapOutRequest.BatchSize), swap.GetId().String()) {
+		// The batch was already funded without us.
+		return a.next.Execute(services, swap)
+	}
+	return NoOp
+}
+
+// BatchSwapOut starts several swap-outs. Swap-outs with the same peer share a
+// batch id so that the peer can fund their opening outputs in a single
+// transaction. Each swap has its own state machine. Swaps that were started
+// before an error occurred are returned together with the error.
+func (s *SwapService) BatchSwapOut(chain string, initiator string, requests []*BatchSwapOutRequest, maxPremium uint64) ([]*SwapStateMachine, error) {
+	if chain != btc_chain {
+		return nil, ErrBatchNotSupported
+	}
+	if len(requests) == 0 {
+		return nil, errors.New("no swaps given")
+	}
+
+	// Group the requests by peer and check for duplicate channels.
+	var peers []string
+	byPeer := map[string][]*BatchSwapOutRequest{}
+	channels := map[string]struct{}{}
+	for _, r := range requests {
+		if _, ok := channels[r.ChannelId]; ok {
+			return nil, fmt.Errorf("channel %s is used more than once", r.ChannelId)
+		}
+		channels[r.ChannelId] = struct{}{}
+
+		if _, ok := byPeer[r.PeerId]; !ok {
+			peers = append(peers, r.PeerId)
+		}
+		byPeer[r.PeerId] = append(byPeer[r.PeerId], r)
+	}
+
+	var swaps []*SwapStateMachine
+	for _, peer := range peers {
+		peerRequests := byPeer[peer]
+		if len(peerRequests) > maxBatchSize {
+			return swaps, fmt.Errorf("exceeding maximum batch size of %d swaps per peer", maxBatchSize)
+		}
+
+		// A single swap with a peer does not need a batch.
+		var batchId string
+		if len(peerRequests) > 1 {
+			batchId = newSwapId()
+		}
+
+		for _, r := range peerRequests {
+			swap, err := s.swapOut(peer, chain, r.ChannelId, initiator, r.AmtSat, maxPremium, batchId, uint32(len(peerRequests)))
+			if err != nil {
+				return swaps, err
+			}
+			swaps = append(swaps, swap)
+		}
+	}
+	return swaps, nil
+}
+
+// fundOpeningTxBatch creates a single opening transaction for all swaps of a
+// batch and sends the result to the swaps.
+func (s *SwapService) fundOpeningTxBatch(batchId string, swapIds []string) {
+	var swaps []*SwapStateMachine
+	for _, id := range swapIds {
+		swap, err := s.GetActiveSwap(id)
+		if err != nil {
+			log.Infof("[Batch] swap %s of batch is not active anymore: %v", id, err)
+			continue
+		}
+		swaps = append(swaps, swap)
+	}
+	if len(swaps) == 0 {
+		return
+	}
+
+	batchWallet, openings, err := s.prepareBatchOpeningTransaction(swaps)
+	if err != nil {
+		log.Infof("[Batch] could not fund batch with %d swaps: %v", len(swaps), err)
+		s.failBatch(swaps, err)
+		return
+	}
+
+	// Record the batch opening with every swap before the transaction is
+	// published. A swap that is interrupted after this point publishes the
+	// recorded transaction again on recovery.
+	for i, swap := range swaps {
+		err = s.setBatchOpening(swap, openings[i])
+		if err != nil {
+			log.Infof("[Batch] could not record opening tx of swap %s: %v", swap.SwapId.String(), err)
+			s.failBatch(swaps, err)
+			return
+		}
+	}
+
+	tx := openings[0].Tx
+	txHex, err := batchWallet.PublishBatchOpeningTransaction(tx)
+	if err != nil {
+		log.Infof("[Batch] could not publish opening tx %s: %v", tx.TxId, err)
+		s.failBatch(swaps, err)
+		return
+	}
+
+	_, wallet, _, err := s.swapServices.getOnChainServices(swaps[0].Data.GetChain())
+	if err == nil {
+		for i, swap := range swaps {
+			err = wallet.SetLabel(tx.TxId, tx.Addresses[i], labels.Opening(swap.Data.GetId().Short()))
+			if err != nil {
+				log.Infof("Error labeling transaction. txid: %s, label: %s, error: %v",
+					tx.TxId, labels.Opening(swap.Data.GetId().Short()), err)
+			}
+		}
+	}
+
+	log.Infof("[Batch] funded %d swaps in opening tx %s", len(swaps), tx.TxId)
+	for _, swap := range swaps {
+		s.sendBatchEvent(swap, Event_OnBatchOpeningTxBroadcasted, &batchOpeningTxContext{openingTxHex: txHex})
+	}
+}
+
+// failBatch removes the recorded batch opening from the swaps of a batch that
+// could not be funded and fails them.
+func (s *SwapService) failBatch(swaps []*SwapStateMachine, err error) {
+	for _, swap := range swaps {
+		if setErr := s.setBatchOpening(swap, nil); setErr != nil {
+			log.Infof("[Batch] could not reset opening tx of swap %s: %v", swap.SwapId.String(), setErr)
+		}
+		s.sendBatchEvent(swap, Event_ActionFailed, &SwapErrorContext{Err: err, SendPeer: true})
+	}
+}
+
+// setBatchOpening stores the batch opening of the swap.
+func (s *SwapService) setBatchOpening(swap *SwapStateMachine, opening *BatchOpening) error {
+	swap.mutex.Lock()
+	defer swap.mutex.Unlock()
+
+	if swap.Data.BatchOpening == nil && opening == nil {
+		return nil
+	}
+	swap.Data.BatchOpening = opening
+	return s.swapServices.swapStore.UpdateData(swap)
+}
+
+func (s *SwapService) sendBatchEvent(swap *SwapStateMachine, event EventType, eventCtx EventContext) {
+	done, err := swap.SendEvent(event, eventCtx)
+	if err != nil {
+		log.Infof("[Batch] could not send %s to swap %s: %v", event, swap.SwapId.String(), err)
+		return
+	}
+	if done {
+		s.RemoveActiveSwap(swap.SwapId.String())
+	}
+}
+
+// prepareBatchOpeningTransaction creates the claim invoices of the swaps and
+// a single opening transaction for all of them without publishing it.
+func (s *SwapService) prepareBatchOpeningTransaction(swaps []*SwapStateMachine) (BatchWallet, []*BatchOpening, error) {
+	txWatcher, wallet, _, err := s.swapServices.getOnChainServices(swaps[0].Data.GetChain())
+	if err != nil {
+		return nil, nil, err
+	}
+	batchWallet, ok := wallet.(BatchWallet)
+	if !ok {
+		return nil, nil, ErrBatchNotSupported
+	}
+
+	// The balance might have been spent since the swaps were agreed on.
+	var amountSat uint64
+	var swapIds []string
+	for _, swap := range swaps {
+		amountSat += swap.Data.GetAmount()
+		swapIds = append(swapIds, swap.SwapId.String())
+	}
+	err = s.swapServices.checkWalletReserve(swaps[0].Data.GetChain(), amountSat, swapIds...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var params []*OpeningParams
+	var preimages []lightning.Preimage
+	for _, swap := range swaps {
+		preimage, err := lightning.GetPreimage()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		preimages = append(preimages, preimage)
+		openingParams := swap.Data.GetOpeningParams()
+		openingParams.ClaimPaymentHash = preimage.Hash().String()
+		params = append(params, openingParams)
+	}
+
+	tx, err := batchWallet.PrepareBatchOpeningTransaction(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tx.Addresses) != len(swaps) || len(tx.Vouts) != len(swaps) {
+		return nil, nil, fmt.Errorf("expected %d outputs in batch opening tx, got %d", len(swaps), len(tx.Vouts))
+	}
+
+	startingHeight, err := txWatcher.GetBlockHeight()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var openings []*BatchOpening
+	for i, swap := range swaps {
+		discount, err := batchClaimDiscount(s.swapServices.lightning, swap.Data, tx.Fee, len(swaps))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		memo := fmt.Sprintf("peerswap %s %s %s %s", swap.Data.GetChain(), INVOICE_CLAIM, swap.Data.GetScidInBoltFormat(), swap.Data.GetId())
+		payreq, err := s.swapServices.lightning.GetPayreq((swap.Data.GetClaimInvoiceAmount()-discount)*1000, preimages[i].String(), swap.Data.GetId().String(), memo, INVOICE_CLAIM, swap.Data.GetInvoiceExpiry(), swap.Data.GetInvoiceCltv())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		openings = append(openings, &BatchOpening{
+			Tx:             tx,
+			Index:          i,
+			ClaimPreimage:  hex.EncodeToString(preimages[i][:]),
+			Payreq:         payreq,
+			StartingHeight: startingHeight,
+		})
+	}
+	return batchWallet, openings, nil
+}
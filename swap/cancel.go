@@ -0,0 +1,72 @@
+package swap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SwapNotCancelableError is returned if a swap can not be canceled in its
+// current state.
+type SwapNotCancelableError struct {
+	SwapId string
+	State  StateType
+}
+
+func (e SwapNotCancelableError) Error() string {
+	return fmt.Sprintf("swap %s can not be canceled in state %s, only swaps that we started "+
+		"can be canceled before any funds are committed", e.SwapId, e.State)
+}
+
+// cancelRequestedContext is the event context of a cancel of a swap by the
+// operator.
+type cancelRequestedContext struct {
+	reason string
+}
+
+func (c *cancelRequestedContext) ApplyToSwapData(data *SwapData) error {
+	data.CancelMessage = c.reason
+	data.CancelCode = CancelCode_CanceledByOperator
+	data.LastErr = fmt.Errorf("canceled by operator: %s", c.reason)
+	return nil
+}
+
+func (c *cancelRequestedContext) Validate(data *SwapData) error {
+	return nil
+}
+
+// CancelSwap cancels a swap that we started. The swap can only be canceled
+// while we wait for the agreement of the peer, that is before the fee invoice
+// of a swap-out is paid and before the opening transaction of a swap-in is
+// broadcasted. The peer is sent a cancel message with the reason and the
+// channel is released for new swaps.
+func (s *SwapService) CancelSwap(swapId string, reason string) (*SwapStateMachine, error) {
+	swap, err := s.GetActiveSwap(swapId)
+	if err == ErrSwapDoesNotExist {
+		stored, err := s.swapServices.swapStore.GetData(swapId)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("swap %s is already finished in state %s", swapId, stored.Current)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if swap.Role != SWAPROLE_SENDER || !swap.EventIsValid(Event_OnCancelRequested) {
+		return nil, SwapNotCancelableError{SwapId: swapId, State: swap.Current}
+	}
+	if reason == "" {
+		reason = "swap canceled"
+	}
+
+	done, err := swap.SendEvent(Event_OnCancelRequested, &cancelRequestedContext{reason: reason})
+	if errors.Is(err, ErrEventRejected) {
+		// The swap moved on while we were waiting for it.
+		return nil, SwapNotCancelableError{SwapId: swapId, State: swap.Current}
+	} else if err != nil {
+		return nil, err
+	}
+	if done {
+		s.RemoveActiveSwap(swapId)
+	}
+	return swap, nil
+}
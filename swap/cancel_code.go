@@ -0,0 +1,145 @@
+package swap
+
+import (
+	"errors"
+
+	"github.com/elementsproject/peerswap/policy"
+)
+
+// CancelCode is the machine readable reason why a swap was canceled. It is
+// sent next to the free text message of the cancel message. Peers of older
+// versions do not send it.
+type CancelCode string
+
+const (
+	// CancelCode_Unknown is the code of cancels that came without a code.
+	CancelCode_Unknown CancelCode = "unknown"
+	// CancelCode_InternalError is the code of errors that have no code of
+	// their own.
+	CancelCode_InternalError       CancelCode = "internal_error"
+	CancelCode_SwapsDisabled       CancelCode = "swaps_disabled"
+	CancelCode_AssetNotSupported   CancelCode = "asset_not_supported"
+	CancelCode_IncompatibleVersion CancelCode = "incompatible_version"
+	CancelCode_AmountTooSmall      CancelCode = "amount_too_small"
+	CancelCode_PeerNotAllowed      CancelCode = "peer_not_allowed"
+	CancelCode_ChannelExcluded     CancelCode = "channel_excluded"
+	CancelCode_ChannelBusy         CancelCode = "channel_busy"
+	CancelCode_PolicyRejected      CancelCode = "policy_rejected"
+	CancelCode_LimitReached        CancelCode = "limit_reached"
+	CancelCode_InsufficientBalance CancelCode = "insufficient_balance"
+	CancelCode_PremiumTooHigh      CancelCode = "premium_too_high"
+	CancelCode_ProbeFailed         CancelCode = "probe_failed"
+	CancelCode_InvalidMessage      CancelCode = "invalid_message"
+	CancelCode_Timeout             CancelCode = "timeout"
+	CancelCode_CanceledByOperator  CancelCode = "canceled_by_operator"
+)
+
+// isKnown returns true if the code is one of the codes above.
+func (c CancelCode) isKnown() bool {
+	switch c {
+	case CancelCode_Unknown,
+		CancelCode_InternalError,
+		CancelCode_SwapsDisabled,
+		CancelCode_AssetNotSupported,
+		CancelCode_IncompatibleVersion,
+		CancelCode_AmountTooSmall,
+		CancelCode_PeerNotAllowed,
+		CancelCode_ChannelExcluded,
+		CancelCode_ChannelBusy,
+		CancelCode_PolicyRejected,
+		CancelCode_LimitReached,
+		CancelCode_InsufficientBalance,
+		CancelCode_PremiumTooHigh,
+		CancelCode_ProbeFailed,
+		CancelCode_InvalidMessage,
+		CancelCode_Timeout,
+		CancelCode_CanceledByOperator:
+		return true
+	}
+	return false
+}
+
+// CancelError is an error that cancels a swap with the given code.
+type CancelError struct {
+	Code    CancelCode
+	Message string
+}
+
+func (e CancelError) Error() string {
+	return e.Message
+}
+
+// CancelCodeFromError returns the cancel code of an error,
+// CancelCode_InternalError if the error has no code.
+func CancelCodeFromError(err error) CancelCode {
+	var cancelErr CancelError
+	var minSize ErrMinimumSwapSize
+	var notAllowed PeerNotAllowedError
+	var suspicious PeerIsSuspiciousError
+	var excluded ChannelExcludedError
+	var active ActiveSwapError
+	var peerPolicy policy.ErrPeerPolicy
+	var limit RequestLimitError
+	var reserve ReserveOnchainError
+	var reserved ReservedFundsError
+	var premium PremiumLimitExceededError
+	var version NoCommonProtocolVersionError
+	var asset WrongAssetError
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &cancelErr):
+		return cancelErr.Code
+	case errors.As(err, &minSize):
+		return CancelCode_AmountTooSmall
+	case errors.As(err, &notAllowed), errors.As(err, &suspicious):
+		return CancelCode_PeerNotAllowed
+	case errors.As(err, &excluded):
+		return CancelCode_ChannelExcluded
+	case errors.As(err, &active):
+		return CancelCode_ChannelBusy
+	case errors.As(err, &peerPolicy):
+		return CancelCode_PolicyRejected
+	case errors.As(err, &limit):
+		return CancelCode_LimitReached
+	case errors.As(err, &reserve), errors.As(err, &reserved):
+		return CancelCode_InsufficientBalance
+	case errors.As(err, &premium):
+		return CancelCode_PremiumTooHigh
+	case errors.As(err, &version):
+		return CancelCode_IncompatibleVersion
+	case errors.As(err, &asset):
+		return CancelCode_AssetNotSupported
+	default:
+		return CancelCode_InternalError
+	}
+}
+
+// GetCancelCode returns the code that the swap was canceled with, by the
+// peer or by us. It is empty if the swap was not canceled.
+func (s *SwapData) GetCancelCode() CancelCode {
+	if s.Cancel != nil {
+		if !s.Cancel.Code.isKnown() {
+			return CancelCode_Unknown
+		}
+		return s.Cancel.Code
+	}
+	if s.CancelCode != "" {
+		return s.CancelCode
+	}
+	if s.LastErr != nil {
+		return CancelCodeFromError(s.LastErr)
+	}
+	if s.CancelMessage != "" {
+		return CancelCode_Unknown
+	}
+	return ""
+}
+
+// setCancelCode sets the code that we cancel the swap with, unless it is
+// already set.
+func (s *SwapData) setCancelCode(code CancelCode) {
+	if s.CancelCode == "" {
+		s.CancelCode = code
+	}
+}
@@ -0,0 +1,87 @@
+package swap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/elementsproject/peerswap/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CancelCodeFromError(t *testing.T) {
+	for err, code := range map[error]CancelCode{
+		CancelError{Code: CancelCode_SwapsDisabled, Message: "swaps are disabled"}: CancelCode_SwapsDisabled,
+		ErrMinimumSwapSize(1000):                                   CancelCode_AmountTooSmall,
+		PeerNotAllowedError("peer"):                                CancelCode_PeerNotAllowed,
+		PeerIsSuspiciousError("peer"):                              CancelCode_PeerNotAllowed,
+		ChannelExcludedError("1x2x3"):                              CancelCode_ChannelExcluded,
+		ActiveSwapError{channelId: "1x2x3", swapId: "swap"}:        CancelCode_ChannelBusy,
+		policy.ErrPeerPolicy("not allowed"):                        CancelCode_PolicyRejected,
+		RequestLimitError{Max: 1, Limit: "swaps per hour"}:         CancelCode_LimitReached,
+		ReserveOnchainError{Asset: "btc"}:                          CancelCode_InsufficientBalance,
+		ReservedFundsError{Asset: "btc"}:                           CancelCode_InsufficientBalance,
+		PremiumLimitExceededError{Premium: 2, MaxPremium: 1}:       CancelCode_PremiumTooHigh,
+		NoCommonProtocolVersionError{MinVersion: 5, MaxVersion: 6}: CancelCode_IncompatibleVersion,
+		WrongAssetError("doge"):                                    CancelCode_AssetNotSupported,
+		errors.New("rpc failed"):                                   CancelCode_InternalError,
+	} {
+		assert.Equal(t, code, CancelCodeFromError(err), err.Error())
+		// Wrapped errors keep their code.
+		assert.Equal(t, code, CancelCodeFromError(fmt.Errorf("wrapped: %w", err)), err.Error())
+	}
+	assert.Equal(t, CancelCode(""), CancelCodeFromError(nil))
+}
+
+func Test_GetCancelCode(t *testing.T) {
+	data := &SwapData{}
+	assert.Equal(t, CancelCode(""), data.GetCancelCode())
+
+	data.HandleError(ChannelExcludedError("1x2x3"))
+	assert.Equal(t, CancelCode_ChannelExcluded, data.GetCancelCode())
+	// The first error of a swap is the one that canceled it.
+	data.HandleError(errors.New("rpc failed"))
+	assert.Equal(t, CancelCode_ChannelExcluded, data.GetCancelCode())
+
+	// The code of the peer wins, cancels of older peers have no code.
+	data.Cancel = &CancelMessage{Message: "swaps are disabled", Code: CancelCode_SwapsDisabled}
+	assert.Equal(t, CancelCode_SwapsDisabled, data.GetCancelCode())
+	data.Cancel = &CancelMessage{Message: "swaps are disabled"}
+	assert.Equal(t, CancelCode_Unknown, data.GetCancelCode())
+
+	// Swaps from before the codes only have a message.
+	data = &SwapData{CancelMessage: "swaps are disabled"}
+	assert.Equal(t, CancelCode_Unknown, data.GetCancelCode())
+}
+
+// Test_CancelMessage_UnknownCode checks that codes that a peer made up are
+// stored as unknown.
+func Test_CancelMessage_UnknownCode(t *testing.T) {
+	data := &SwapData{}
+	require.NoError(t, CancelMessage{Message: "made up", Code: "made_up_code"}.ApplyToSwapData(data))
+	assert.Equal(t, CancelCode_Unknown, data.Cancel.Code)
+	assert.Equal(t, CancelCode_Unknown, data.GetCancelCode())
+
+	require.NoError(t, CancelMessage{Message: "premium too high", Code: CancelCode_PremiumTooHigh}.ApplyToSwapData(data))
+	assert.Equal(t, CancelCode_PremiumTooHigh, data.GetCancelCode())
+
+	// Cancels that were stored before the codes were checked.
+	data.Cancel = &CancelMessage{Message: "made up", Code: "made_up_code"}
+	assert.Equal(t, CancelCode_Unknown, data.GetCancelCode())
+}
+
+func Test_CancelMessageCompatibility(t *testing.T) {
+	var msg CancelMessage
+	require.NoError(t, json.Unmarshal([]byte(`{"swap_id":null,"message":"swaps are disabled"}`), &msg))
+	assert.Equal(t, CancelCode(""), msg.Code)
+
+	payload, err := json.Marshal(CancelMessage{Message: "premium too high", Code: CancelCode_PremiumTooHigh})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"swap_id":null,"message":"premium too high","code":"premium_too_high"}`, string(payload))
+
+	payload, err = json.Marshal(CancelMessage{Message: "swaps are disabled"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"swap_id":null,"message":"swaps are disabled"}`, string(payload))
+}
@@ -0,0 +1,67 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/elementsproject/peerswap/messages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CancelSwap(t *testing.T) {
+	_, peer, _, _, chanId := getTestParams()
+	msgChan := make(chan PeerMessage)
+	swapServices := getSwapServices(msgChan)
+	swapService := NewSwapService(swapServices)
+
+	newSwap := func(swapType SwapType, role SwapRole, state StateType) *SwapStateMachine {
+		var swap *SwapStateMachine
+		if swapType == SWAPTYPE_OUT {
+			swap = newSwapOutSenderFSM(swapServices, "alice", peer)
+			swap.Data.SwapOutRequest = &SwapOutRequestMessage{SwapId: swap.SwapId, Scid: chanId, Asset: "btc"}
+		} else {
+			swap = newSwapInSenderFSM(swapServices, "alice", peer)
+			swap.Data.SwapInRequest = &SwapInRequestMessage{SwapId: swap.SwapId, Scid: chanId, Asset: "btc"}
+		}
+		swap.Role = role
+		swap.Current = state
+		require.NoError(t, swapService.lockSwap(swap.SwapId.String(), chanId, swap))
+		return swap
+	}
+
+	for _, swapType := range []SwapType{SWAPTYPE_OUT, SWAPTYPE_IN} {
+		state := State_SwapOutSender_AwaitAgreement
+		if swapType == SWAPTYPE_IN {
+			state = State_SwapInSender_AwaitAgreement
+		}
+		swap := newSwap(swapType, SWAPROLE_SENDER, state)
+		canceled, err := swapService.CancelSwap(swap.SwapId.String(), "changed my mind")
+		require.NoError(t, err)
+		assert.Equal(t, State_SwapCanceled, canceled.Current)
+		assert.Equal(t, "changed my mind", canceled.Data.CancelMessage)
+		assert.Equal(t, CancelCode_CanceledByOperator, canceled.Data.GetCancelCode())
+		assert.Equal(t, "canceled by operator: changed my mind", canceled.Data.LastErrString)
+		assert.Equal(t, messages.MESSAGETYPE_CANCELED, (<-msgChan).MessageType())
+
+		// The channel is released for the next swap.
+		_, err = swapService.GetActiveSwap(swap.SwapId.String())
+		assert.ErrorIs(t, err, ErrSwapDoesNotExist)
+		_, err = swapService.CancelSwap(swap.SwapId.String(), "")
+		assert.Error(t, err)
+	}
+
+	// The fee invoice is paid, the swap has to run to its end.
+	swap := newSwap(SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapOutSender_AwaitTxBroadcastedMessage)
+	_, err := swapService.CancelSwap(swap.SwapId.String(), "")
+	assert.Equal(t, SwapNotCancelableError{SwapId: swap.SwapId.String(), State: State_SwapOutSender_AwaitTxBroadcastedMessage}, err)
+	assert.Equal(t, State_SwapOutSender_AwaitTxBroadcastedMessage, swap.Current)
+	swapService.RemoveActiveSwap(swap.SwapId.String())
+
+	// Swaps of the peer can not be canceled.
+	swap = newSwap(SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapOutSender_AwaitAgreement)
+	_, err = swapService.CancelSwap(swap.SwapId.String(), "")
+	assert.ErrorAs(t, err, &SwapNotCancelableError{})
+
+	_, err = swapService.CancelSwap(NewSwapId().String(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,199 @@
+package swap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PolicyCheck is the result of one of the checks that a swap request of a
+// peer has to pass.
+type PolicyCheck struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	// Skipped is true if the check could not be run, e.g. because no
+	// channel was given.
+	Skipped bool   `json:"skipped,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// PolicyEvaluation holds the results of the checks of a swap request.
+type PolicyEvaluation struct {
+	PeerNodeId string         `json:"peer_node_id"`
+	Asset      string         `json:"asset"`
+	SwapType   SwapType       `json:"swap_type"`
+	AmountSat  uint64         `json:"amount_sat"`
+	ChannelId  string         `json:"channel_id,omitempty"`
+	PremiumSat uint64         `json:"premium_sat"`
+	Accepted   bool           `json:"accepted"`
+	Checks     []*PolicyCheck `json:"checks"`
+}
+
+func (e *PolicyEvaluation) add(name string, err error, passReason string) {
+	(*policyChecks)(&e.Checks).add(name, err, passReason)
+}
+
+func (e *PolicyEvaluation) skip(name string, reason string) {
+	(*policyChecks)(&e.Checks).skip(name, reason)
+}
+
+// policyChecks collects the results of checks in the order they are run.
+type policyChecks []*PolicyCheck
+
+// add records a check that passed if err is nil. The reason of a failed
+// check is the error.
+func (c *policyChecks) add(name string, err error, passReason string) {
+	check := &PolicyCheck{Name: name, Passed: err == nil, Reason: passReason}
+	if err != nil {
+		check.Reason = err.Error()
+	}
+	*c = append(*c, check)
+}
+
+// skip records a check that could not be run. It does not fail.
+func (c *policyChecks) skip(name string, reason string) {
+	*c = append(*c, &PolicyCheck{Name: name, Passed: true, Skipped: true, Reason: reason})
+}
+
+// passed returns true if all checks passed.
+func (c policyChecks) passed() bool {
+	for _, check := range c {
+		if !check.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// EvaluatePolicy runs the checks of a swap request of the peer without
+// creating a swap. The swap type is the one that the peer requests, a
+// swap-out of the peer is funded by our wallet. The channel balance is only
+// checked if a channel is given.
+func (s *SwapService) EvaluatePolicy(peer, asset string, swapType SwapType, amountSat uint64, channelId string) (*PolicyEvaluation, error) {
+	if swapType != SWAPTYPE_IN && swapType != SWAPTYPE_OUT {
+		return nil, errors.New("unknown swap type, expected swap-in or swap-out")
+	}
+	if asset != btc_chain && asset != l_btc_chain {
+		return nil, fmt.Errorf("unknown asset %s, expected btc or lbtc", asset)
+	}
+
+	services := s.swapServices
+	eval := &PolicyEvaluation{
+		PeerNodeId: peer,
+		Asset:      asset,
+		SwapType:   swapType,
+		AmountSat:  amountSat,
+		ChannelId:  channelId,
+	}
+
+	var err error
+	if !services.policy.NewSwapsAllowed() {
+		err = errors.New("swaps are disabled")
+	}
+	eval.add("new_swaps_allowed", err, "swaps are enabled")
+
+	err = nil
+	if (asset == btc_chain && !services.bitcoinEnabled) || (asset == l_btc_chain && !services.liquidEnabled) {
+		err = fmt.Errorf("%s swaps are not supported", asset)
+	}
+	eval.add("asset_enabled", err, fmt.Sprintf("%s swaps are supported", asset))
+
+	err = nil
+	if !services.policy.IsPeerAllowed(peer) {
+		err = PeerNotAllowedError(peer)
+	}
+	eval.add("allowlist", err, "peer is allowed to request swaps")
+
+	err = nil
+	if services.policy.IsPeerSuspicious(peer) {
+		err = PeerIsSuspiciousError(peer)
+	}
+	eval.add("suspicious_peers", err, "peer is not suspicious")
+
+	eval.add("peer_policy", services.policy.CheckPeerSwapRequest(peer, asset, swapType.String(), amountSat),
+		"peer policy allows the request")
+
+	err = nil
+	minMsat := services.policy.GetPeerMinSwapAmountMsat(peer)
+	if amountSat*1000 < minMsat {
+		err = ErrMinimumSwapSize(minMsat)
+	}
+	eval.add("min_swap_amount", err, fmt.Sprintf("amount is at least %d msat", minMsat))
+
+	eval.add("request_limits", checkRequestLimits(services, newEvaluationSwapData(peer, asset, swapType, amountSat, channelId)),
+		"request limits are not reached")
+
+	if swapType == SWAPTYPE_OUT {
+		eval.add("onchain_balance", services.checkWalletReserve(asset, amountSat), "wallet balance covers the amount, the opening fee and the reserve")
+	} else {
+		eval.skip("onchain_balance", "the peer funds the opening transaction")
+	}
+
+	if channelId == "" {
+		eval.skip("excluded_channel", "no channel given")
+		eval.skip("channel_balance", "no channel given")
+	} else {
+		err = nil
+		if services.policy.IsChannelExcluded(channelId) {
+			err = ChannelExcludedError(channelId)
+		}
+		eval.add("excluded_channel", err, "swaps are allowed on the channel")
+		eval.add("channel_balance", checkChannelBalance(services, swapType, amountSat, channelId), "channel balance covers the amount")
+	}
+
+	err = nil
+	eval.PremiumSat = services.policy.GetPeerPremium(peer, asset, amountSat)
+	if eval.PremiumSat >= amountSat {
+		err = fmt.Errorf("premium %d exceeds swap amount %d", eval.PremiumSat, amountSat)
+	}
+	eval.add("premium", err, fmt.Sprintf("premium of %d sat is asked", eval.PremiumSat))
+
+	eval.Accepted = policyChecks(eval.Checks).passed()
+	return eval, nil
+}
+
+// newEvaluationSwapData returns the data of a swap that the peer requested,
+// as it is checked by the request handlers.
+func newEvaluationSwapData(peer, asset string, swapType SwapType, amountSat uint64, channelId string) *SwapData {
+	// Only the chain is read from the network and the asset of the request.
+	var network, liquidAsset string
+	if asset == btc_chain {
+		network = btc_chain
+	} else {
+		liquidAsset = l_btc_chain
+	}
+	data := &SwapData{PeerNodeId: peer}
+	if swapType == SWAPTYPE_OUT {
+		data.SwapOutRequest = &SwapOutRequestMessage{SwapId: NewSwapId(), Network: network, Asset: liquidAsset, Amount: amountSat, Scid: channelId}
+	} else {
+		data.SwapInRequest = &SwapInRequestMessage{SwapId: NewSwapId(), Network: network, Asset: liquidAsset, Amount: amountSat, Scid: channelId}
+	}
+	return data
+}
+
+// checkChannelBalance returns an error if the channel can not take the
+// payment of the swap. We receive the swap-out payment of the peer and send
+// the swap-in payment.
+func checkChannelBalance(services *SwapServices, swapType SwapType, amountSat uint64, channelId string) error {
+	if swapType == SWAPTYPE_OUT {
+		rs, err := services.lightning.ReceivableMsat(channelId)
+		if err != nil {
+			return err
+		}
+		if rs <= amountSat*1000 {
+			return fmt.Errorf("exceeding receivable amount_msat: %d", rs)
+		}
+		return nil
+	}
+
+	if err := services.lightning.CanSpend(amountSat * 1000); err != nil {
+		return err
+	}
+	sp, err := services.lightning.SpendableMsat(channelId)
+	if err != nil {
+		return err
+	}
+	if sp <= amountSat*1000 {
+		return fmt.Errorf("exceeding spendable amount_msat: %d", sp)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EvaluatePolicy(t *testing.T) {
+	service := getTestSetup("alice")
+	_, peer, _, _, chanId := getTestParams()
+
+	checks := func(eval *PolicyEvaluation) map[string]*PolicyCheck {
+		m := map[string]*PolicyCheck{}
+		for _, c := range eval.Checks {
+			m[c.Name] = c
+		}
+		return m
+	}
+
+	eval, err := service.EvaluatePolicy(peer, "btc", SWAPTYPE_OUT, 1000000, chanId)
+	require.NoError(t, err)
+	assert.True(t, eval.Accepted)
+	assert.Len(t, eval.Checks, 11)
+	for _, c := range eval.Checks {
+		assert.True(t, c.Passed, c.Name)
+		assert.False(t, c.Skipped, c.Name)
+	}
+
+	// The peer funds a swap-in, no channel skips the channel balance.
+	eval, err = service.EvaluatePolicy(peer, "lbtc", SWAPTYPE_IN, 1000000, "")
+	require.NoError(t, err)
+	assert.True(t, eval.Accepted)
+	assert.True(t, checks(eval)["onchain_balance"].Skipped)
+	assert.True(t, checks(eval)["excluded_channel"].Skipped)
+	assert.True(t, checks(eval)["channel_balance"].Skipped)
+
+	dummy := service.swapServices.policy.(*dummyPolicy)
+	dummy.newSwapsAllowedReturn = false
+	dummy.isPeerSuspiciousReturn = true
+	dummy.getPremiumReturn = 20000000
+	dummy.excludedScids = []string{chanId}
+	eval, err = service.EvaluatePolicy(peer, "btc", SWAPTYPE_OUT, 20000000, chanId)
+	require.NoError(t, err)
+	assert.False(t, eval.Accepted)
+	assert.EqualValues(t, 20000000, eval.PremiumSat)
+	var failed []string
+	for _, c := range eval.Checks {
+		if !c.Passed {
+			failed = append(failed, c.Name)
+		}
+	}
+	assert.Equal(t, []string{"new_swaps_allowed", "suspicious_peers", "onchain_balance", "excluded_channel", "premium"}, failed)
+	assert.Equal(t, PeerIsSuspiciousError(peer).Error(), checks(eval)["suspicious_peers"].Reason)
+	assert.Equal(t, ChannelExcludedError(chanId).Error(), checks(eval)["excluded_channel"].Reason)
+
+	_, err = service.EvaluatePolicy(peer, "btc", 0, 1000000, chanId)
+	assert.Error(t, err)
+	_, err = service.EvaluatePolicy(peer, "eth", SWAPTYPE_IN, 1000000, chanId)
+	assert.Error(t, err)
+}
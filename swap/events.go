@@ -0,0 +1,168 @@
+package swap
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+)
+
+const (
+	// swapEventHistorySize is the number of events that are kept in memory to
+	// be replayed to subscribers that reconnect.
+	swapEventHistorySize = 1000
+
+	// swapEventBufferSize is the number of events that are buffered for a
+	// subscriber before it is dropped as too slow.
+	swapEventBufferSize = 100
+)
+
+// SwapEvent is emitted on every state change of a swap.
+type SwapEvent struct {
+	// Timestamp is the time of the state change as unix nanoseconds. It is
+	// unique and increasing for all events of a hub, so it can be used to
+	// resume a subscription.
+	Timestamp   int64     `json:"timestamp"`
+	SwapId      string    `json:"swap_id"`
+	PeerNodeId  string    `json:"peer_node_id"`
+	Type        string    `json:"type"`
+	Role        string    `json:"role"`
+	Asset       string    `json:"asset"`
+	Amount      uint64    `json:"amount"`
+	OldState    StateType `json:"old_state"`
+	NewState    StateType `json:"new_state"`
+	OpeningTxId string    `json:"opening_tx_id,omitempty"`
+	ClaimTxId   string    `json:"claim_tx_id,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// newSwapEvent returns the event for the transition of the swap from the
+// old state to its current state.
+func newSwapEvent(swap *SwapStateMachine, oldState StateType) *SwapEvent {
+	event := &SwapEvent{
+		Type:     swap.Type.String(),
+		Role:     swap.Role.String(),
+		OldState: oldState,
+		NewState: swap.Current,
+	}
+	if swap.SwapId != nil {
+		event.SwapId = swap.SwapId.String()
+	}
+	if swap.Data != nil {
+		event.PeerNodeId = swap.Data.PeerNodeId
+		event.Amount = swap.Data.GetAmount()
+		event.OpeningTxId = swap.Data.GetOpeningTxId()
+		event.ClaimTxId = swap.Data.ClaimTxId
+		event.Error = swap.Data.GetCancelMessage()
+		event.Asset = swap.Data.GetChain()
+	}
+	return event
+}
+
+// SuspiciousPeerEvent is emitted when a swap makes us add its peer to the
+// suspicious peer list.
+type SuspiciousPeerEvent struct {
+	Timestamp  int64  `json:"timestamp"`
+	PeerNodeId string `json:"peer_node_id"`
+	SwapId     string `json:"swap_id"`
+	Reason     string `json:"reason"`
+}
+
+// SwapEventHub distributes swap events to its subscribers and keeps the most
+// recent events to replay them to new subscribers.
+type SwapEventHub struct {
+	sync.Mutex
+	subscribers   map[uint64]chan *SwapEvent
+	nextId        uint64
+	history       []*SwapEvent
+	lastTimestamp int64
+
+	suspiciousPeerHandlers []func(*SuspiciousPeerEvent)
+}
+
+func NewSwapEventHub() *SwapEventHub {
+	return &SwapEventHub{
+		subscribers: map[uint64]chan *SwapEvent{},
+	}
+}
+
+// Publish sends the event to all subscribers. Subscribers that can not keep
+// up are dropped, their channel is closed.
+func (h *SwapEventHub) Publish(event *SwapEvent) {
+	h.Lock()
+	defer h.Unlock()
+
+	event.Timestamp = time.Now().UnixNano()
+	if event.Timestamp <= h.lastTimestamp {
+		event.Timestamp = h.lastTimestamp + 1
+	}
+	h.lastTimestamp = event.Timestamp
+
+	h.history = append(h.history, event)
+	if len(h.history) > swapEventHistorySize {
+		h.history = h.history[len(h.history)-swapEventHistorySize:]
+	}
+
+	for id, ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Infof("[SwapEvents] dropping slow subscriber %d", id)
+			close(ch)
+			delete(h.subscribers, id)
+		}
+	}
+}
+
+// AddSuspiciousPeerHandler registers a handler that is called with every
+// suspicious peer event. The handler is called synchronously and must not
+// block.
+func (h *SwapEventHub) AddSuspiciousPeerHandler(handler func(*SuspiciousPeerEvent)) {
+	h.Lock()
+	defer h.Unlock()
+	h.suspiciousPeerHandlers = append(h.suspiciousPeerHandlers, handler)
+}
+
+// PublishSuspiciousPeer calls the suspicious peer handlers with the event.
+func (h *SwapEventHub) PublishSuspiciousPeer(event *SuspiciousPeerEvent) {
+	h.Lock()
+	event.Timestamp = time.Now().UnixNano()
+	handlers := append([]func(*SuspiciousPeerEvent){}, h.suspiciousPeerHandlers...)
+	h.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// Subscribe returns the events since the given unix nanosecond timestamp and
+// a channel that receives all further events. A timestamp of 0 skips the
+// replay. The returned function ends the subscription.
+func (h *SwapEventHub) Subscribe(since int64) ([]*SwapEvent, <-chan *SwapEvent, func()) {
+	h.Lock()
+	defer h.Unlock()
+
+	var replay []*SwapEvent
+	if since != 0 {
+		for _, event := range h.history {
+			if event.Timestamp > since {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	id := h.nextId
+	h.nextId++
+	ch := make(chan *SwapEvent, swapEventBufferSize)
+	h.subscribers[id] = ch
+
+	cancel := func() {
+		h.Lock()
+		defer h.Unlock()
+		if ch, ok := h.subscribers[id]; ok {
+			close(ch)
+			delete(h.subscribers, id)
+		}
+	}
+	return replay, ch, cancel
+}
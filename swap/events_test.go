@@ -0,0 +1,70 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SwapEventHub(t *testing.T) {
+	hub := NewSwapEventHub()
+
+	hub.Publish(&SwapEvent{SwapId: "first"})
+	hub.Publish(&SwapEvent{SwapId: "second"})
+
+	replay, events, cancel := hub.Subscribe(0)
+	assert.Empty(t, replay)
+
+	hub.Publish(&SwapEvent{SwapId: "third"})
+	event := <-events
+	assert.Equal(t, "third", event.SwapId)
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+
+	// A subscriber that resumes after the first event gets the later events
+	// replayed.
+	first := hub.history[0]
+	replay, _, cancel = hub.Subscribe(first.Timestamp)
+	defer cancel()
+	require.Len(t, replay, 2)
+	assert.Equal(t, "second", replay[0].SwapId)
+	assert.Equal(t, "third", replay[1].SwapId)
+	assert.Less(t, replay[0].Timestamp, replay[1].Timestamp)
+}
+
+func Test_SwapEventHub_SlowSubscriber(t *testing.T) {
+	hub := NewSwapEventHub()
+	_, events, cancel := hub.Subscribe(0)
+	defer cancel()
+
+	for i := 0; i < swapEventBufferSize+1; i++ {
+		hub.Publish(&SwapEvent{})
+	}
+	for i := 0; i < swapEventBufferSize; i++ {
+		<-events
+	}
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.Empty(t, hub.subscribers)
+}
+
+func Test_SwapEvents_OnStateChange(t *testing.T) {
+	swapService := getTestSetup("alice")
+	_, events, cancel := swapService.SubscribeSwapEvents(0)
+	defer cancel()
+
+	swap := newSwapOutSenderFSM(swapService.swapServices, "alice", "bob")
+	swap.Data.SwapOutRequest = &SwapOutRequestMessage{SwapId: swap.SwapId, Amount: 100000, Network: "mainnet"}
+	swap.Current = State_SwapOutSender_AwaitAgreement
+	swap.setState(State_SwapOutSender_PayFeeInvoice)
+
+	event := <-events
+	assert.Equal(t, swap.SwapId.String(), event.SwapId)
+	assert.Equal(t, "bob", event.PeerNodeId)
+	assert.Equal(t, btc_chain, event.Asset)
+	assert.EqualValues(t, 100000, event.Amount)
+	assert.Equal(t, State_SwapOutSender_AwaitAgreement, event.OldState)
+	assert.Equal(t, State_SwapOutSender_PayFeeInvoice, event.NewState)
+}
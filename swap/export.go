@@ -0,0 +1,217 @@
+package swap
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+)
+
+// ExportFilter selects the swaps of an export. Zero values do not filter.
+type ExportFilter struct {
+	// From and To select the swaps that were created in [From, To).
+	From time.Time
+	To   time.Time
+	// PeerNodeId selects the swaps with this peer.
+	PeerNodeId string
+}
+
+// SwapExport is the accounting record of a swap. All amounts are given in
+// sat and msat. The net amount is the gain (positive) or the cost (negative)
+// of the swap for us, the swap amount itself only changes sides between
+// lightning and on-chain.
+type SwapExport struct {
+	SwapId          string `json:"swap_id"`
+	CreatedAt       int64  `json:"created_at"`
+	FinishedAt      int64  `json:"finished_at"`
+	Type            string `json:"type"`
+	Role            string `json:"role"`
+	Asset           string `json:"asset"`
+	State           string `json:"state"`
+	PeerNodeId      string `json:"peer_node_id"`
+	InitiatorNodeId string `json:"initiator_node_id"`
+	ChannelId       string `json:"channel_id"`
+
+	AmountSat   uint64 `json:"amount_sat"`
+	AmountMsat  uint64 `json:"amount_msat"`
+	PremiumSat  uint64 `json:"premium_sat"`
+	PremiumMsat uint64 `json:"premium_msat"`
+
+	FeeInvoicePaymentHash string `json:"fee_invoice_payment_hash"`
+	FeeInvoiceSat         uint64 `json:"fee_invoice_sat"`
+	FeeInvoiceMsat        uint64 `json:"fee_invoice_msat"`
+	FeeInvoicePaid        bool   `json:"fee_invoice_paid"`
+
+	ClaimInvoicePaymentHash string `json:"claim_invoice_payment_hash"`
+	ClaimInvoiceSat         uint64 `json:"claim_invoice_sat"`
+	ClaimInvoiceMsat        uint64 `json:"claim_invoice_msat"`
+	ClaimInvoicePaid        bool   `json:"claim_invoice_paid"`
+
+	OpeningTxId      string `json:"opening_tx_id"`
+	OpeningTxFeeSat  uint64 `json:"opening_tx_fee_sat"`
+	OpeningTxFeeMsat uint64 `json:"opening_tx_fee_msat"`
+	ClaimTxId        string `json:"claim_tx_id"`
+	ClaimTxFeeSat    uint64 `json:"claim_tx_fee_sat"`
+	ClaimTxFeeMsat   uint64 `json:"claim_tx_fee_msat"`
+
+	NetSat  int64 `json:"net_sat"`
+	NetMsat int64 `json:"net_msat"`
+}
+
+// ExportSwaps returns the accounting records of the finished and the archived
+// swaps that match the filter, ordered by creation time.
+func (s *SwapService) ExportSwaps(filter *ExportFilter) ([]*SwapExport, error) {
+	finished := true
+	swaps, _, err := s.ListSwapsFiltered(&ListSwapsFilter{
+		PeerNodeId: filter.PeerNodeId,
+		Finished:   &finished,
+		From:       filter.From,
+		To:         filter.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var exports []*SwapExport
+	for _, swap := range swaps {
+		exports = append(exports, s.swapServices.exportSwap(swap))
+	}
+
+	if _, ok := s.swapServices.swapStore.(ArchiveStore); ok {
+		archived, err := s.ListArchivedSwaps(filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range archived {
+			e := a.SwapExport
+			exports = append(exports, &e)
+		}
+		sort.SliceStable(exports, func(i, j int) bool {
+			if exports[i].CreatedAt != exports[j].CreatedAt {
+				return exports[i].CreatedAt < exports[j].CreatedAt
+			}
+			return exports[i].SwapId < exports[j].SwapId
+		})
+	}
+	return exports, nil
+}
+
+func (s *SwapServices) exportSwap(swap *SwapStateMachine) *SwapExport {
+	data := swap.Data
+	e := &SwapExport{
+		SwapId:                  swap.SwapId.String(),
+		CreatedAt:               data.CreatedAt,
+		FinishedAt:              data.FinishedAt,
+		Type:                    swap.Type.String(),
+		Role:                    swap.Role.String(),
+		Asset:                   data.GetChain(),
+		State:                   string(swap.Current),
+		PeerNodeId:              data.PeerNodeId,
+		InitiatorNodeId:         data.InitiatorNodeId,
+		ChannelId:               data.GetScid(),
+		AmountMsat:              data.GetAmount() * 1000,
+		PremiumMsat:             data.GetPremium() * 1000,
+		ClaimInvoicePaymentHash: data.GetPaymentHash(),
+		OpeningTxId:             data.GetOpeningTxId(),
+		OpeningTxFeeMsat:        data.OpeningTxFee * 1000,
+		ClaimTxId:               data.ClaimTxId,
+		ClaimTxFeeMsat:          data.ClaimTxFee * 1000,
+	}
+
+	// The fee invoice of a swap-out is paid before the maker broadcasts the
+	// opening transaction. The claim invoice is paid if the taker claimed
+	// the opening transaction with the preimage.
+	if data.SwapOutAgreement != nil && data.SwapOutAgreement.Payreq != "" {
+		paymentHash, amountMsat, _, err := s.lightning.DecodePayreq(data.SwapOutAgreement.Payreq)
+		if err != nil {
+			log.Debugf("[Export] could not decode fee invoice of swap %s: %v", e.SwapId, err)
+		} else {
+			e.FeeInvoicePaymentHash = paymentHash
+			e.FeeInvoiceMsat = amountMsat
+		}
+		e.FeeInvoicePaid = data.OpeningTxBroadcasted != nil
+	}
+	if data.OpeningTxBroadcasted != nil {
+		e.ClaimInvoiceMsat = data.GetClaimInvoiceAmount() * 1000
+	}
+	e.ClaimInvoicePaid = swap.Current == State_ClaimedPreimage
+
+	var net int64
+	if e.FeeInvoicePaid {
+		if swap.isMaker() {
+			net += int64(e.FeeInvoiceMsat)
+		} else {
+			net -= int64(e.FeeInvoiceMsat)
+		}
+	}
+	if swap.isMaker() {
+		net -= int64(e.OpeningTxFeeMsat)
+		if e.ClaimInvoicePaid {
+			// We sold the swap amount on-chain for the claim invoice.
+			net += int64(e.ClaimInvoiceMsat) - int64(e.AmountMsat)
+		}
+	} else if e.ClaimInvoicePaid {
+		// We bought the swap amount on-chain with the claim invoice.
+		net += int64(e.AmountMsat) - int64(e.ClaimInvoiceMsat)
+	}
+	// We only know the fee of the claim transactions that we created.
+	net -= int64(e.ClaimTxFeeMsat)
+	e.NetMsat = net
+
+	e.AmountSat = e.AmountMsat / 1000
+	e.PremiumSat = e.PremiumMsat / 1000
+	e.FeeInvoiceSat = e.FeeInvoiceMsat / 1000
+	e.ClaimInvoiceSat = e.ClaimInvoiceMsat / 1000
+	e.OpeningTxFeeSat = e.OpeningTxFeeMsat / 1000
+	e.ClaimTxFeeSat = e.ClaimTxFeeMsat / 1000
+	e.NetSat = e.NetMsat / 1000
+	return e
+}
+
+var swapExportCsvHeader = []string{
+	"swap_id", "created_at", "finished_at", "type", "role", "asset", "state",
+	"peer_node_id", "initiator_node_id", "channel_id",
+	"amount_sat", "amount_msat", "premium_sat", "premium_msat",
+	"fee_invoice_payment_hash", "fee_invoice_sat", "fee_invoice_msat", "fee_invoice_paid",
+	"claim_invoice_payment_hash", "claim_invoice_sat", "claim_invoice_msat", "claim_invoice_paid",
+	"opening_tx_id", "opening_tx_fee_sat", "opening_tx_fee_msat",
+	"claim_tx_id", "claim_tx_fee_sat", "claim_tx_fee_msat",
+	"net_sat", "net_msat",
+}
+
+// WriteSwapExportsCsv writes the records as csv with a header. Timestamps
+// are written in RFC3339.
+func WriteSwapExportsCsv(w io.Writer, exports []*SwapExport) error {
+	u := func(v uint64) string { return strconv.FormatUint(v, 10) }
+	i := func(v int64) string { return strconv.FormatInt(v, 10) }
+	ts := func(v int64) string {
+		if v == 0 {
+			return ""
+		}
+		return time.Unix(v, 0).UTC().Format(time.RFC3339)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(swapExportCsvHeader); err != nil {
+		return err
+	}
+	for _, e := range exports {
+		err := cw.Write([]string{
+			e.SwapId, ts(e.CreatedAt), ts(e.FinishedAt), e.Type, e.Role, e.Asset, e.State,
+			e.PeerNodeId, e.InitiatorNodeId, e.ChannelId,
+			u(e.AmountSat), u(e.AmountMsat), u(e.PremiumSat), u(e.PremiumMsat),
+			e.FeeInvoicePaymentHash, u(e.FeeInvoiceSat), u(e.FeeInvoiceMsat), strconv.FormatBool(e.FeeInvoicePaid),
+			e.ClaimInvoicePaymentHash, u(e.ClaimInvoiceSat), u(e.ClaimInvoiceMsat), strconv.FormatBool(e.ClaimInvoicePaid),
+			e.OpeningTxId, u(e.OpeningTxFeeSat), u(e.OpeningTxFeeMsat),
+			e.ClaimTxId, u(e.ClaimTxFeeSat), u(e.ClaimTxFeeMsat),
+			i(e.NetSat), i(e.NetMsat),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,110 @@
+package swap
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExportSwaps(t *testing.T) {
+	swapService := getTestSetup("alice")
+
+	// We paid the fee invoice and the claim invoice of a swap-out.
+	taker := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage)
+	taker.Data.CreatedAt = 100
+	taker.Data.PeerNodeId = "bob"
+	taker.Data.SwapOutRequest.Amount = 100000
+	taker.Data.SwapOutAgreement = &SwapOutAgreementMessage{Payreq: "fee", Premium: 50}
+	taker.Data.ClaimTxId = "claimtxid"
+	taker.Data.ClaimTxFee = 200
+
+	// We funded a swap-out and claimed it back by csv.
+	maker := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCsv)
+	maker.Data.CreatedAt = 200
+	maker.Data.PeerNodeId = "carol"
+	maker.Data.SwapOutRequest.Amount = 100000
+	maker.Data.SwapOutAgreement = &SwapOutAgreementMessage{Payreq: "fee"}
+	maker.Data.OpeningTxFee = 300
+	maker.Data.ClaimTxFee = 150
+
+	// Active swaps are not exported.
+	active := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapOutSender_AwaitTxConfirmation)
+	active.Data.CreatedAt = 150
+
+	exports, err := swapService.ExportSwaps(&ExportFilter{})
+	require.NoError(t, err)
+	require.Len(t, exports, 2)
+
+	e := exports[0]
+	assert.Equal(t, taker.SwapId.String(), e.SwapId)
+	assert.Equal(t, "foo", e.FeeInvoicePaymentHash)
+	assert.EqualValues(t, 100, e.FeeInvoiceSat)
+	assert.EqualValues(t, 100000, e.FeeInvoiceMsat)
+	assert.True(t, e.FeeInvoicePaid)
+	assert.EqualValues(t, 100000, e.ClaimInvoiceSat)
+	assert.True(t, e.ClaimInvoicePaid)
+	assert.EqualValues(t, 200, e.ClaimTxFeeSat)
+	assert.EqualValues(t, -300, e.NetSat)
+	assert.EqualValues(t, -300000, e.NetMsat)
+
+	e = exports[1]
+	assert.Equal(t, maker.SwapId.String(), e.SwapId)
+	assert.False(t, e.ClaimInvoicePaid)
+	assert.EqualValues(t, 100-300-150, e.NetSat)
+
+	exports, err = swapService.ExportSwaps(&ExportFilter{PeerNodeId: "carol"})
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.Equal(t, maker.SwapId.String(), exports[0].SwapId)
+
+	exports, err = swapService.ExportSwaps(&ExportFilter{From: time.Unix(100, 0), To: time.Unix(200, 0)})
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.Equal(t, taker.SwapId.String(), exports[0].SwapId)
+}
+
+func Test_ExportSwaps_SwapInPremium(t *testing.T) {
+	swapService := getTestSetup("alice")
+
+	// The premium is deducted from the claim invoice that the receiver pays.
+	swap := getFeeBumpTestSwap(swapService, SWAPTYPE_IN, SWAPROLE_RECEIVER, State_ClaimedPreimage)
+	swap.Data.SwapInRequest.Amount = 100000
+	swap.Data.SwapInAgreement = &SwapInAgreementMessage{Premium: 500}
+
+	exports, err := swapService.ExportSwaps(&ExportFilter{})
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.EqualValues(t, 99500, exports[0].ClaimInvoiceSat)
+	assert.False(t, exports[0].FeeInvoicePaid)
+	assert.EqualValues(t, 500, exports[0].NetSat)
+}
+
+func Test_WriteSwapExportsCsv(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteSwapExportsCsv(&buf, []*SwapExport{{
+		SwapId:    "id",
+		CreatedAt: 1600000000,
+		AmountSat: 100000,
+		NetSat:    -300,
+	}})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, swapExportCsvHeader, records[0])
+
+	row := map[string]string{}
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+	assert.Equal(t, "id", row["swap_id"])
+	assert.Equal(t, "2020-09-13T12:26:40Z", row["created_at"])
+	assert.Equal(t, "", row["finished_at"])
+	assert.Equal(t, "100000", row["amount_sat"])
+	assert.Equal(t, "-300", row["net_sat"])
+}
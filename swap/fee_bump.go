@@ -0,0 +1,400 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elementsproject/peerswap/labels"
+	"github.com/elementsproject/peerswap/log"
+)
+
+const (
+	// DefaultFeeBumpInterval is the default time between two checks for
+	// transactions that need a fee bump.
+	DefaultFeeBumpInterval = 10 * time.Minute
+
+	// defaultFeeBumpTargetConf is the confirmation target that is used if a
+	// fee bump sets neither a target nor a fee rate.
+	defaultFeeBumpTargetConf = 2
+
+	// autoFeeBumpBlocks is the number of blocks that an opening transaction
+	// may stay unconfirmed, and the number of blocks between two automatic
+	// bumps of the same transaction.
+	autoFeeBumpBlocks = 6
+
+	// maxAutoFeeBumpSatPerVbyte is the highest fee rate that an automatic fee
+	// bump pays. Higher fee rates must be set with BumpSwapFee.
+	maxAutoFeeBumpSatPerVbyte = 200
+)
+
+var (
+	ErrFeeBumpNotSupported = errors.New("fee bumping is not supported by the wallet")
+	ErrTxAlreadyConfirmed  = errors.New("transaction is already confirmed")
+)
+
+// FeeRateTooLowError is returned if a fee bump does not raise the fee rate of
+// the last bump of the transaction.
+type FeeRateTooLowError struct {
+	SatPerVbyte     uint64
+	LastSatPerVbyte uint64
+}
+
+func (e FeeRateTooLowError) Error() string {
+	return fmt.Sprintf("fee rate of %d sat/vb does not raise the fee rate of the last bump of %d sat/vb",
+		e.SatPerVbyte, e.LastSatPerVbyte)
+}
+
+// FeeRateEstimator is implemented by wallets that can estimate a fee rate for
+// a confirmation target.
+type FeeRateEstimator interface {
+	// GetFeeRate returns the estimated fee rate in sat/vb to confirm a
+	// transaction within targetConf blocks.
+	GetFeeRate(targetConf uint32) (satPerVbyte uint64, err error)
+}
+
+// TxConfirmationsWallet is implemented by wallets that can tell the number of
+// confirmations of their transactions.
+type TxConfirmationsWallet interface {
+	// GetTxConfirmations returns the number of confirmations of a transaction
+	// of the wallet. It returns 0 if the transaction is unconfirmed.
+	GetTxConfirmations(txId string) (uint32, error)
+}
+
+// FeeBumpWallet is implemented by wallets that can raise the fee of an opening
+// transaction that they funded.
+type FeeBumpWallet interface {
+	FeeRateEstimator
+	TxConfirmationsWallet
+	// BumpOpeningTransaction spends the change of the opening transaction with
+	// a child transaction so that parent and child together pay satPerVbyte
+	// (CPFP). The returned txid of the child is empty if the wallet broadcasts
+	// the child asynchronously.
+	BumpOpeningTransaction(openingTxHex string, satPerVbyte uint64) (txId string, err error)
+}
+
+type FeeBumpTx string
+
+const (
+	FeeBumpTx_Opening FeeBumpTx = "opening"
+	FeeBumpTx_Claim   FeeBumpTx = "claim"
+)
+
+type FeeBumpMethod string
+
+const (
+	// FeeBumpMethod_Cpfp pays for the transaction with a child transaction.
+	FeeBumpMethod_Cpfp FeeBumpMethod = "cpfp"
+	// FeeBumpMethod_Rbf replaces the transaction with one that pays a higher
+	// fee.
+	FeeBumpMethod_Rbf FeeBumpMethod = "rbf"
+)
+
+// FeeBump is a fee bump of a swap transaction.
+type FeeBump struct {
+	Tx     FeeBumpTx     `json:"tx"`
+	Method FeeBumpMethod `json:"method"`
+	// TxId is the txid of the child transaction for a cpfp and the txid of
+	// the replacement for a rbf.
+	TxId        string `json:"txid"`
+	SatPerVbyte uint64 `json:"sat_per_vbyte"`
+	BlockHeight uint32 `json:"block_height"`
+	Auto        bool   `json:"auto"`
+}
+
+// lastFeeBump returns the last fee bump of tx or nil.
+func (s *SwapData) lastFeeBump(tx FeeBumpTx) *FeeBump {
+	for i := len(s.FeeBumps) - 1; i >= 0; i-- {
+		if s.FeeBumps[i].Tx == tx {
+			return s.FeeBumps[i]
+		}
+	}
+	return nil
+}
+
+// isMaker returns true if we funded the opening transaction of the swap.
+func (s *SwapStateMachine) isMaker() bool {
+	return (s.Type == SWAPTYPE_OUT && s.Role == SWAPROLE_RECEIVER) ||
+		(s.Type == SWAPTYPE_IN && s.Role == SWAPROLE_SENDER)
+}
+
+// feeBumpTx returns the transaction of the swap that can be bumped in the
+// current state.
+func (s *SwapStateMachine) feeBumpTx() (FeeBumpTx, string, error) {
+	switch {
+	case s.Current == State_ClaimedPreimage || s.Current == State_ClaimedCsv:
+		if s.Data.ClaimTxId == "" {
+			return "", "", fmt.Errorf("swap %s has no claim transaction", s.SwapId)
+		}
+		return FeeBumpTx_Claim, s.Data.ClaimTxId, nil
+	case s.Current == State_ClaimedCoop:
+		return "", "", errors.New("a cooperative claim needs the signature of the peer and can not be bumped")
+	case s.isMaker() && !s.IsFinished() && s.Data.OpeningTxBroadcasted != nil && s.Data.ClaimTxId == "":
+		return FeeBumpTx_Opening, s.Data.OpeningTxBroadcasted.TxId, nil
+	default:
+		return "", "", fmt.Errorf("swap %s has no transaction of ours to bump in state %s", s.SwapId, s.Current)
+	}
+}
+
+// BumpSwapFee raises the fee of the unconfirmed opening or claim transaction
+// of a swap. A claim transaction is replaced (RBF), an opening transaction
+// that we funded is paid for with a child transaction (CPFP). The fee rate is
+// either set by satPerVbyte or estimated for targetConf. A set fee rate must be
+// higher than the one of the last bump of the transaction, an estimated fee
+// rate is raised above it.
+func (s *SwapService) BumpSwapFee(swapId string, targetConf uint32, satPerVbyte uint64) (*FeeBump, error) {
+	if targetConf != 0 && satPerVbyte != 0 {
+		return nil, errors.New("only one of target_conf and sat_per_vbyte can be set")
+	}
+	swap, err := s.getSwapForUpdate(swapId)
+	if err != nil {
+		return nil, err
+	}
+	_, wallet, _, err := s.swapServices.getOnChainServices(swap.Data.GetChain())
+	if err != nil {
+		return nil, err
+	}
+	estimated := satPerVbyte == 0
+	if estimated {
+		estimator, ok := wallet.(FeeRateEstimator)
+		if !ok {
+			return nil, ErrFeeBumpNotSupported
+		}
+		if targetConf == 0 {
+			targetConf = defaultFeeBumpTargetConf
+		}
+		satPerVbyte, err = estimator.GetFeeRate(targetConf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.bumpSwapFee(swap, satPerVbyte, estimated, false)
+}
+
+// getSwapForUpdate returns the active state machine of the swap or the
+// stored swap if it is finished.
+func (s *SwapService) getSwapForUpdate(swapId string) (*SwapStateMachine, error) {
+	swap, err := s.GetActiveSwap(swapId)
+	if err == ErrSwapDoesNotExist {
+		return s.swapServices.swapStore.GetData(swapId)
+	}
+	return swap, err
+}
+
+// bumpSwapFee bumps the fee of the swap transaction to satPerVbyte. A fee rate
+// that does not raise the fee rate of the last bump is rejected, unless raise
+// is set, in which case it is raised with nextFeeRate.
+func (s *SwapService) bumpSwapFee(swap *SwapStateMachine, satPerVbyte uint64, raise, auto bool) (*FeeBump, error) {
+	swap.mutex.Lock()
+	defer swap.mutex.Unlock()
+
+	txType, _, err := swap.feeBumpTx()
+	if err != nil {
+		return nil, err
+	}
+	if last := swap.Data.lastFeeBump(txType); last != nil && satPerVbyte <= last.SatPerVbyte {
+		if !raise {
+			return nil, FeeRateTooLowError{SatPerVbyte: satPerVbyte, LastSatPerVbyte: last.SatPerVbyte}
+		}
+		satPerVbyte = nextFeeRate(last.SatPerVbyte, satPerVbyte)
+	}
+	txWatcher, wallet, _, err := s.swapServices.getOnChainServices(swap.Data.GetChain())
+	if err != nil {
+		return nil, err
+	}
+	height, err := txWatcher.GetBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	bump := &FeeBump{
+		Tx:          txType,
+		SatPerVbyte: satPerVbyte,
+		BlockHeight: height,
+		Auto:        auto,
+	}
+	switch txType {
+	case FeeBumpTx_Opening:
+		bumpWallet, ok := wallet.(FeeBumpWallet)
+		if !ok {
+			return nil, ErrFeeBumpNotSupported
+		}
+		confs, err := bumpWallet.GetTxConfirmations(swap.Data.OpeningTxBroadcasted.TxId)
+		if err != nil {
+			return nil, err
+		}
+		if confs > 0 {
+			return nil, ErrTxAlreadyConfirmed
+		}
+		bump.Method = FeeBumpMethod_Cpfp
+		bump.TxId, err = bumpWallet.BumpOpeningTransaction(swap.Data.OpeningTxHex, satPerVbyte)
+		if err != nil {
+			return nil, err
+		}
+
+	case FeeBumpTx_Claim:
+		if bumpWallet, ok := wallet.(FeeBumpWallet); ok {
+			confs, err := bumpWallet.GetTxConfirmations(swap.Data.ClaimTxId)
+			if err != nil {
+				return nil, err
+			}
+			if confs > 0 {
+				return nil, ErrTxAlreadyConfirmed
+			}
+		}
+		claimParams := swap.Data.GetClaimParams()
+		claimParams.SatPerVbyte = satPerVbyte
+
+		var txHex, address, label string
+		bump.Method = FeeBumpMethod_Rbf
+		if swap.Current == State_ClaimedPreimage {
+			bump.TxId, txHex, address, err = wallet.CreatePreimageSpendingTransaction(swap.Data.GetOpeningParams(), claimParams)
+			label = labels.ClaimByInvoice(swap.Data.GetId().Short())
+		} else {
+			bump.TxId, txHex, address, err = wallet.CreateCsvSpendingTransaction(swap.Data.GetOpeningParams(), claimParams)
+			label = labels.ClaimByCsv(swap.Data.GetId().Short())
+		}
+		if err != nil {
+			return nil, err
+		}
+		swap.Data.ClaimTxId = bump.TxId
+		setClaimTxFee(wallet, swap.Data, txHex)
+		err = wallet.SetLabel(bump.TxId, address, label)
+		if err != nil {
+			log.Infof("Error labeling transaction. txid: %s, label: %s, error: %v",
+				bump.TxId, label, err)
+		}
+	}
+
+	swap.Data.FeeBumps = append(swap.Data.FeeBumps, bump)
+	err = s.swapServices.swapStore.UpdateData(swap)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("[Swap:%s] bumped fee of %s transaction to %d sat/vb via %s: %s",
+		swap.SwapId, bump.Tx, bump.SatPerVbyte, bump.Method, bump.TxId)
+	return bump, nil
+}
+
+// AutoBumpFees bumps the fees of bitcoin swap transactions that do not confirm
+// in time on every interval until ctx is done. Opening transactions that we
+// funded are bumped if they are unconfirmed after autoFeeBumpBlocks. Claims by
+// preimage are bumped if they are still unconfirmed when the csv safety limit
+// of the swap has passed, as the maker can then claim the opening with the
+// csv soon.
+func (s *SwapService) AutoBumpFees(ctx context.Context, interval time.Duration) {
+	clock := time.NewTicker(interval)
+	defer clock.Stop()
+	for {
+		select {
+		case <-clock.C:
+			err := s.autoBumpFees()
+			if err != nil {
+				log.Infof("[FeeBump] could not check swaps: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *SwapService) autoBumpFees() error {
+	if !s.BitcoinEnabled {
+		return nil
+	}
+	wallet, ok := s.swapServices.bitcoinWallet.(FeeBumpWallet)
+	if !ok {
+		return nil
+	}
+	height, err := s.swapServices.bitcoinTxWatcher.GetBlockHeight()
+	if err != nil {
+		return err
+	}
+	swaps, err := s.swapServices.swapStore.ListAll()
+	if err != nil {
+		return err
+	}
+
+	for _, stored := range swaps {
+		if stored.Data.GetChain() != btc_chain || !isFeeBumpDue(stored, height) {
+			continue
+		}
+		swap, err := s.getSwapForUpdate(stored.SwapId.String())
+		if err != nil {
+			log.Infof("[FeeBump] could not get swap %s: %v", stored.SwapId, err)
+			continue
+		}
+		txType, txId, err := swap.feeBumpTx()
+		if err != nil {
+			continue
+		}
+		confs, err := wallet.GetTxConfirmations(txId)
+		if err != nil {
+			log.Infof("[FeeBump] could not get confirmations of %s: %v", txId, err)
+			continue
+		}
+		if confs > 0 {
+			continue
+		}
+		satPerVbyte, err := wallet.GetFeeRate(defaultFeeBumpTargetConf)
+		if err != nil {
+			log.Infof("[FeeBump] could not estimate fee rate: %v", err)
+			continue
+		}
+		if last := swap.Data.lastFeeBump(txType); last != nil {
+			satPerVbyte = nextFeeRate(last.SatPerVbyte, satPerVbyte)
+		}
+		if satPerVbyte > maxAutoFeeBumpSatPerVbyte {
+			log.Infof("[FeeBump] swap %s: fee rate %d sat/vb exceeds the limit of %d sat/vb for automatic bumps",
+				swap.SwapId, satPerVbyte, maxAutoFeeBumpSatPerVbyte)
+			continue
+		}
+		_, err = s.bumpSwapFee(swap, satPerVbyte, false, true)
+		if err != nil {
+			log.Infof("[FeeBump] could not bump fee of swap %s: %v", swap.SwapId, err)
+		}
+	}
+	return nil
+}
+
+// isFeeBumpDue returns true if a transaction of the swap is behind the
+// deadline for an automatic fee bump at the given height.
+func isFeeBumpDue(swap *SwapStateMachine, height uint32) bool {
+	var since uint32
+	switch {
+	case swap.Current == State_ClaimedPreimage:
+		// The claim is only bumped after the csv safety limit and before the
+		// maker can claim with the csv.
+		deadline := swap.Data.StartingBlockHeight + BitcoinCsv/2
+		if height < deadline || height >= swap.Data.StartingBlockHeight+BitcoinCsv {
+			return false
+		}
+		since = deadline - autoFeeBumpBlocks
+		if last := swap.Data.lastFeeBump(FeeBumpTx_Claim); last != nil {
+			since = last.BlockHeight
+		}
+	case swap.isMaker() && !swap.IsFinished() && swap.Data.OpeningTxBroadcasted != nil:
+		since = swap.Data.StartingBlockHeight
+		if last := swap.Data.lastFeeBump(FeeBumpTx_Opening); last != nil {
+			since = last.BlockHeight
+		}
+	default:
+		return false
+	}
+	return height >= since+autoFeeBumpBlocks
+}
+
+// nextFeeRate returns the fee rate of the next bump after a bump with
+// lastSatPerVbyte. A replacement must pay a higher fee rate than the
+// transaction it replaces, so the fee rate is raised by at least a quarter.
+func nextFeeRate(lastSatPerVbyte, estimatedSatPerVbyte uint64) uint64 {
+	min := lastSatPerVbyte + lastSatPerVbyte/4
+	if min == lastSatPerVbyte {
+		min++
+	}
+	if estimatedSatPerVbyte < min {
+		return min
+	}
+	return estimatedSatPerVbyte
+}
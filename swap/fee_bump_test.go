@@ -0,0 +1,150 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BumpSwapFee_Claim(t *testing.T) {
+	swapService := getTestSetup("alice")
+	chain := swapService.swapServices.bitcoinWallet.(*dummyChain)
+	chain.feeRate = 15
+
+	swap := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage)
+	swap.Data.ClaimTxId = "claimtxid"
+
+	bump, err := swapService.BumpSwapFee(swap.SwapId.String(), 0, 20)
+	require.NoError(t, err)
+	assert.Equal(t, FeeBumpTx_Claim, bump.Tx)
+	assert.Equal(t, FeeBumpMethod_Rbf, bump.Method)
+	assert.EqualValues(t, 20, bump.SatPerVbyte)
+	assert.EqualValues(t, 20, chain.claimSatPerVbyte)
+	assert.Equal(t, bump.TxId, swap.Data.ClaimTxId)
+	assert.Equal(t, []*FeeBump{bump}, swap.Data.FeeBumps)
+
+	// A set fee rate must raise the fee rate of the last bump.
+	_, err = swapService.BumpSwapFee(swap.SwapId.String(), 0, 20)
+	assert.ErrorIs(t, err, FeeRateTooLowError{SatPerVbyte: 20, LastSatPerVbyte: 20})
+	_, err = swapService.BumpSwapFee(swap.SwapId.String(), 0, 10)
+	assert.ErrorIs(t, err, FeeRateTooLowError{SatPerVbyte: 10, LastSatPerVbyte: 20})
+	assert.Len(t, swap.Data.FeeBumps, 1)
+
+	// The fee rate is estimated if it is not set, and raised above the last
+	// bump.
+	bump, err = swapService.BumpSwapFee(swap.SwapId.String(), 3, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 25, bump.SatPerVbyte)
+	assert.EqualValues(t, 25, chain.claimSatPerVbyte)
+	assert.Len(t, swap.Data.FeeBumps, 2)
+
+	_, err = swapService.BumpSwapFee(swap.SwapId.String(), 3, 20)
+	assert.Error(t, err)
+
+	chain.txConfirmations = 1
+	_, err = swapService.BumpSwapFee(swap.SwapId.String(), 0, 30)
+	assert.ErrorIs(t, err, ErrTxAlreadyConfirmed)
+}
+
+func Test_BumpSwapFee_Opening(t *testing.T) {
+	swapService := getTestSetup("alice")
+	chain := swapService.swapServices.bitcoinWallet.(*dummyChain)
+
+	swap := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapOutReceiver_AwaitClaimInvoicePayment)
+
+	bump, err := swapService.BumpSwapFee(swap.SwapId.String(), 0, 20)
+	require.NoError(t, err)
+	assert.Equal(t, FeeBumpTx_Opening, bump.Tx)
+	assert.Equal(t, FeeBumpMethod_Cpfp, bump.Method)
+	assert.EqualValues(t, 1, chain.calledBumpOpeningTransaction)
+
+	// The taker does not own an output of the opening transaction.
+	taker := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapOutSender_AwaitTxConfirmation)
+	_, err = swapService.BumpSwapFee(taker.SwapId.String(), 0, 20)
+	assert.Error(t, err)
+
+	// A cooperative claim can not be bumped.
+	coop := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCoop)
+	coop.Data.ClaimTxId = "claimtxid"
+	_, err = swapService.BumpSwapFee(coop.SwapId.String(), 0, 20)
+	assert.Error(t, err)
+}
+
+func Test_AutoBumpFees(t *testing.T) {
+	swapService := getTestSetup("alice")
+	chain := swapService.swapServices.bitcoinWallet.(*dummyChain)
+	chain.feeRate = 10
+
+	opening := getFeeBumpTestSwap(swapService, SWAPTYPE_IN, SWAPROLE_SENDER, State_SwapInSender_AwaitClaimPayment)
+	opening.Data.StartingBlockHeight = 100
+	claim := getFeeBumpTestSwap(swapService, SWAPTYPE_IN, SWAPROLE_RECEIVER, State_ClaimedPreimage)
+	claim.Data.StartingBlockHeight = 100
+	claim.Data.ClaimTxId = "claimtxid"
+
+	// Nothing is due yet.
+	chain.blockHeight = 100 + autoFeeBumpBlocks - 1
+	require.NoError(t, swapService.autoBumpFees())
+	assert.Empty(t, opening.Data.FeeBumps)
+	assert.Empty(t, claim.Data.FeeBumps)
+
+	// The opening transaction is unconfirmed after autoFeeBumpBlocks.
+	chain.blockHeight = 100 + autoFeeBumpBlocks
+	require.NoError(t, swapService.autoBumpFees())
+	require.Len(t, opening.Data.FeeBumps, 1)
+	assert.True(t, opening.Data.FeeBumps[0].Auto)
+	assert.EqualValues(t, 10, opening.Data.FeeBumps[0].SatPerVbyte)
+	assert.Empty(t, claim.Data.FeeBumps)
+
+	// The claim is bumped after the csv safety limit, the opening is bumped
+	// again with a higher fee rate than before.
+	chain.blockHeight = 100 + BitcoinCsv/2
+	require.NoError(t, swapService.autoBumpFees())
+	require.Len(t, opening.Data.FeeBumps, 2)
+	assert.EqualValues(t, 12, opening.Data.FeeBumps[1].SatPerVbyte)
+	require.Len(t, claim.Data.FeeBumps, 1)
+	assert.Equal(t, FeeBumpMethod_Rbf, claim.Data.FeeBumps[0].Method)
+
+	// Confirmed transactions are not bumped.
+	chain.txConfirmations = 1
+	chain.blockHeight += autoFeeBumpBlocks
+	require.NoError(t, swapService.autoBumpFees())
+	assert.Len(t, opening.Data.FeeBumps, 2)
+	assert.Len(t, claim.Data.FeeBumps, 1)
+
+	// Fee rates above the limit need a manual bump.
+	chain.txConfirmations = 0
+	chain.feeRate = maxAutoFeeBumpSatPerVbyte + 1
+	require.NoError(t, swapService.autoBumpFees())
+	assert.Len(t, opening.Data.FeeBumps, 2)
+}
+
+func Test_NextFeeRate(t *testing.T) {
+	assert.EqualValues(t, 20, nextFeeRate(10, 20))
+	assert.EqualValues(t, 12, nextFeeRate(10, 5))
+	assert.EqualValues(t, 2, nextFeeRate(1, 1))
+}
+
+func getFeeBumpTestSwap(swapService *SwapService, swapType SwapType, role SwapRole, state StateType) *SwapStateMachine {
+	swapId := NewSwapId()
+	swap := &SwapStateMachine{
+		SwapId:  swapId,
+		Type:    swapType,
+		Role:    role,
+		Current: state,
+		Data: &SwapData{
+			OpeningTxHex: "txhex",
+			OpeningTxBroadcasted: &OpeningTxBroadcastedMessage{
+				SwapId: swapId,
+				TxId:   getRandom32ByteHexString(),
+			},
+		},
+	}
+	if swapType == SWAPTYPE_OUT {
+		swap.Data.SwapOutRequest = &SwapOutRequestMessage{SwapId: swapId, Network: "mainnet"}
+	} else {
+		swap.Data.SwapInRequest = &SwapInRequestMessage{SwapId: swapId, Network: "mainnet"}
+	}
+	swapService.swapServices.swapStore.UpdateData(swap)
+	return swap
+}
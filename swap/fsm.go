@@ -111,9 +111,14 @@ type SwapStateMachine struct {
 
 func (s *SwapStateMachine) setState(newState StateType) {
 	s.stateMutex.Lock()
+	oldState := s.Current
 	s.Current = newState
 	s.stateMutex.Unlock()
 	s.stateChange.Broadcast()
+
+	if s.swapServices != nil && s.swapServices.events != nil {
+		s.swapServices.events.Publish(newSwapEvent(s, oldState))
+	}
 }
 
 // WaitForStateChange calls the isDesiredState callback on every state change.
@@ -211,6 +216,7 @@ func (s *SwapStateMachine) SendEvent(event EventType, eventCtx EventContext) (bo
 	if err != nil {
 		return false, err
 	}
+	s.swapServices.reservations.update(s)
 
 	for {
 		// Determine the next state for the event given the machine's current state.
@@ -219,6 +225,13 @@ func (s *SwapStateMachine) SendEvent(event EventType, eventCtx EventContext) (bo
 		if err != nil {
 			return false, ErrEventRejected
 		}
+		if event == Event_OnTimeout {
+			s.Data.TimedOutIn = s.Current
+			s.Data.setCancelCode(CancelCode_Timeout)
+		}
+		if event == Event_OnInvalid_Message {
+			s.Data.setCancelCode(CancelCode_InvalidMessage)
+		}
 
 		// Identify the state definition for the next state.
 		state, ok := s.States[nextState]
@@ -231,6 +244,11 @@ func (s *SwapStateMachine) SendEvent(event EventType, eventCtx EventContext) (bo
 		s.Previous = s.Current
 		s.setState(nextState)
 		s.Data.SetState(nextState)
+		var finished bool
+		if s.IsFinished() && s.Data.FinishedAt == 0 {
+			s.Data.FinishedAt = time.Now().Unix()
+			finished = true
+		}
 
 		// Print Swap information
 		s.logSwapInfo()
@@ -242,6 +260,11 @@ func (s *SwapStateMachine) SendEvent(event EventType, eventCtx EventContext) (bo
 		if err != nil {
 			return false, err
 		}
+		s.swapServices.reservations.update(s)
+		if finished {
+			s.swapServices.recordOutcome(s)
+			s.swapServices.recordStats(s)
+		}
 
 		switch nextEvent {
 		case Event_Done:
@@ -297,6 +320,7 @@ func (s *SwapStateMachine) Recover() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	s.swapServices.reservations.update(s)
 	if nextEvent == NoOp {
 		return false, nil
 	}
@@ -0,0 +1,146 @@
+package swap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elementsproject/peerswap/policy"
+)
+
+// RequestLimitError is returned if a swap request exceeds one of the request
+// limits of the policy.
+type RequestLimitError struct {
+	// Peer is set if the limit applies to the requesting peer only.
+	Peer  string
+	Max   uint64
+	Limit string
+}
+
+func (e RequestLimitError) Error() string {
+	if e.Peer != "" {
+		return fmt.Sprintf("request limit reached: at most %d %s are accepted from peer %s", e.Max, e.Limit, e.Peer)
+	}
+	return fmt.Sprintf("request limit reached: at most %d %s are accepted from all peers", e.Max, e.Limit)
+}
+
+// requestUsage counts the swap requests that were accepted within the last
+// hour and day and the ones that are still active.
+type requestUsage struct {
+	concurrent    uint64
+	perHour       uint64
+	perDay        uint64
+	satPerDayBtc  uint64
+	satPerDayLbtc uint64
+}
+
+func (u *requestUsage) add(swap *SwapStateMachine, now time.Time) {
+	createdAt := time.Unix(swap.Data.CreatedAt, 0)
+	if !IsFinalState(swap.Current) {
+		u.concurrent++
+	}
+	if createdAt.Before(now.Add(-24 * time.Hour)) {
+		return
+	}
+	u.perDay++
+	if !createdAt.Before(now.Add(-time.Hour)) {
+		u.perHour++
+	}
+	switch swap.Data.GetChain() {
+	case btc_chain:
+		u.satPerDayBtc += swap.Data.GetAmount()
+	case l_btc_chain:
+		u.satPerDayLbtc += swap.Data.GetAmount()
+	}
+}
+
+func (u *requestUsage) satPerDay(asset string) uint64 {
+	switch asset {
+	case btc_chain:
+		return u.satPerDayBtc
+	case l_btc_chain:
+		return u.satPerDayLbtc
+	}
+	return 0
+}
+
+// check returns a RequestLimitError if one more swap request over amountSat
+// on the asset exceeds the limits.
+func (u *requestUsage) check(limits policy.Limits, peer, asset string, amountSat uint64) error {
+	if limits.MaxConcurrentSwaps > 0 && u.concurrent+1 > limits.MaxConcurrentSwaps {
+		return RequestLimitError{Peer: peer, Max: limits.MaxConcurrentSwaps, Limit: "concurrent swaps"}
+	}
+	if limits.MaxSwapsPerHour > 0 && u.perHour+1 > limits.MaxSwapsPerHour {
+		return RequestLimitError{Peer: peer, Max: limits.MaxSwapsPerHour, Limit: "swaps per hour"}
+	}
+	if limits.MaxSwapsPerDay > 0 && u.perDay+1 > limits.MaxSwapsPerDay {
+		return RequestLimitError{Peer: peer, Max: limits.MaxSwapsPerDay, Limit: "swaps per day"}
+	}
+	if max := limits.MaxSatPerDay(asset); max > 0 && u.satPerDay(asset)+amountSat > max {
+		return RequestLimitError{Peer: peer, Max: max, Limit: fmt.Sprintf("sat per day on %s", asset)}
+	}
+	return nil
+}
+
+// isAcceptedRequest returns true if we agreed to the swap request of a peer or
+// if the request is still being processed. Rejected requests do not cost us
+// anything and do not count towards the limits.
+func isAcceptedRequest(swap *SwapStateMachine) bool {
+	if swap.Data == nil || swap.Role != SWAPROLE_RECEIVER {
+		return false
+	}
+	if !IsFinalState(swap.Current) {
+		return true
+	}
+	return swap.Data.SwapInAgreement != nil || swap.Data.SwapOutAgreement != nil
+}
+
+// checkRequestLimits returns a RequestLimitError if the swap request exceeds
+// the global limits or the limits of the peer. The usage is counted from the
+// swaps in the store, so it is kept across restarts.
+func checkRequestLimits(services *SwapServices, swap *SwapData) error {
+	global := services.policy.GetLimits()
+	peer := services.policy.GetPeerLimits(swap.PeerNodeId)
+	if reputation, err := services.getPeerReputation(swap.PeerNodeId); err == nil && reputation.Limited {
+		peer = peer.Min(services.policy.GetReputationPolicy().LimitedLimits())
+	}
+	if global.IsZero() && peer.IsZero() {
+		return nil
+	}
+
+	now := time.Now()
+	role := SWAPROLE_RECEIVER
+	active, err := listSwaps(services.swapStore, &ListSwapsFilter{Role: &role, Finished: new(bool)})
+	if err != nil {
+		return err
+	}
+	recent, err := listSwaps(services.swapStore, &ListSwapsFilter{Role: &role, From: now.Add(-24 * time.Hour)})
+	if err != nil {
+		return err
+	}
+
+	var globalUsage, peerUsage requestUsage
+	seen := map[string]bool{swap.GetId().String(): true}
+	for _, s := range append(active, recent...) {
+		if seen[s.SwapId.String()] || !isAcceptedRequest(s) {
+			continue
+		}
+		seen[s.SwapId.String()] = true
+		globalUsage.add(s, now)
+		if s.Data.PeerNodeId == swap.PeerNodeId {
+			peerUsage.add(s, now)
+		}
+	}
+
+	if err := peerUsage.check(peer, swap.PeerNodeId, swap.GetChain(), swap.GetAmount()); err != nil {
+		return err
+	}
+	return globalUsage.check(global, "", swap.GetChain(), swap.GetAmount())
+}
+
+// listSwaps returns all swaps of the store that match the filter.
+func listSwaps(store Store, filter *ListSwapsFilter) ([]*SwapStateMachine, error) {
+	if filtered, ok := store.(FilteredStore); ok {
+		return filtered.ListFiltered(filter)
+	}
+	return listFiltered(store, filter, nil)
+}
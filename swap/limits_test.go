@@ -0,0 +1,97 @@
+package swap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addLimitsTestSwap(t *testing.T, services *SwapServices, peer string, amount uint64, state StateType, createdAt time.Time, agreed bool) {
+	swapId := NewSwapId()
+	swap := &SwapStateMachine{
+		SwapId:  swapId,
+		Type:    SWAPTYPE_OUT,
+		Role:    SWAPROLE_RECEIVER,
+		Current: state,
+		Data: &SwapData{
+			PeerNodeId:     peer,
+			CreatedAt:      createdAt.Unix(),
+			SwapOutRequest: &SwapOutRequestMessage{SwapId: swapId, Network: "mainnet", Amount: amount},
+		},
+	}
+	if agreed {
+		swap.Data.SwapOutAgreement = &SwapOutAgreementMessage{SwapId: swapId}
+	}
+	require.NoError(t, services.swapStore.UpdateData(swap))
+}
+
+func Test_CheckRequestLimits(t *testing.T) {
+	now := time.Now()
+	services := getSwapServices(make(chan PeerMessage))
+	dummy := services.policy.(*dummyPolicy)
+	request := &SwapData{
+		PeerNodeId:     "bob",
+		SwapOutRequest: &SwapOutRequestMessage{SwapId: NewSwapId(), Network: "mainnet", Amount: 100000},
+	}
+
+	// Rejected requests and swaps older than a day do not count.
+	addLimitsTestSwap(t, services, "bob", 100000, State_SwapCanceled, now, false)
+	addLimitsTestSwap(t, services, "bob", 100000, State_ClaimedPreimage, now.Add(-25*time.Hour), true)
+	dummy.peerLimits = policy.Limits{MaxSwapsPerHour: 1, MaxSwapsPerDay: 1}
+	assert.NoError(t, checkRequestLimits(services, request))
+
+	addLimitsTestSwap(t, services, "bob", 100000, State_ClaimedPreimage, now.Add(-2*time.Hour), true)
+	dummy.peerLimits = policy.Limits{MaxSwapsPerHour: 1}
+	assert.NoError(t, checkRequestLimits(services, request))
+	dummy.peerLimits = policy.Limits{MaxSwapsPerDay: 1}
+	assert.Equal(t, RequestLimitError{Peer: "bob", Max: 1, Limit: "swaps per day"}, checkRequestLimits(services, request))
+	dummy.peerLimits = policy.Limits{MaxSwapsPerHour: 1, MaxSwapsPerDay: 2, BtcMaxSatPerDay: 200000}
+	assert.NoError(t, checkRequestLimits(services, request))
+	dummy.peerLimits = policy.Limits{BtcMaxSatPerDay: 199999}
+	assert.Equal(t, RequestLimitError{Peer: "bob", Max: 199999, Limit: "sat per day on btc"}, checkRequestLimits(services, request))
+	dummy.peerLimits = policy.Limits{LbtcMaxSatPerDay: 1}
+	assert.NoError(t, checkRequestLimits(services, request))
+
+	// Active swaps count no matter when they were created.
+	addLimitsTestSwap(t, services, "carol", 100000, State_SwapOutReceiver_AwaitClaimInvoicePayment, now.Add(-48*time.Hour), true)
+	dummy.peerLimits = policy.Limits{MaxConcurrentSwaps: 1}
+	assert.NoError(t, checkRequestLimits(services, request))
+	dummy.limits = policy.Limits{MaxConcurrentSwaps: 1}
+	err := checkRequestLimits(services, request)
+	assert.Equal(t, RequestLimitError{Max: 1, Limit: "concurrent swaps"}, err)
+	assert.EqualError(t, err, "request limit reached: at most 1 concurrent swaps are accepted from all peers")
+
+	dummy.limits = policy.Limits{MaxSwapsPerDay: 1}
+	assert.Equal(t, RequestLimitError{Max: 1, Limit: "swaps per day"}, checkRequestLimits(services, request))
+}
+
+func Test_SwapOutReceiver_RequestLimits(t *testing.T) {
+	swapAmount := uint64(100000)
+	swapId := NewSwapId()
+	_, peer, _, _, chanId := getTestParams()
+
+	msgChan := make(chan PeerMessage)
+	swapServices := getSwapServices(msgChan)
+	swapServices.policy.(*dummyPolicy).peerLimits = policy.Limits{MaxSwapsPerHour: 1}
+	addLimitsTestSwap(t, swapServices, peer, swapAmount, State_ClaimedPreimage, time.Now().Add(-time.Minute), true)
+
+	swapFSM := newSwapOutReceiverFSM(swapId, swapServices, peer)
+	_, err := swapFSM.SendEvent(Event_OnSwapOutRequestReceived, &SwapOutRequestMessage{
+		Amount:          swapAmount,
+		Scid:            chanId,
+		SwapId:          swapId,
+		Pubkey:          peer,
+		Network:         "mainnet",
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+	})
+	require.NoError(t, err)
+
+	msg := <-msgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
+	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
+	assert.Equal(t, RequestLimitError{Peer: peer, Max: 1, Limit: "swaps per hour"}.Error(), swapFSM.Data.CancelMessage)
+}
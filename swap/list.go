@@ -0,0 +1,228 @@
+package swap
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilteredStore is a store that can select the swaps of a filter itself, for
+// example with the help of indexes.
+type FilteredStore interface {
+	// ListFiltered returns the swaps that match the filter and come after its
+	// cursor in the order of the filter, at most filter.Limit swaps if the
+	// limit is set.
+	ListFiltered(filter *ListSwapsFilter) ([]*SwapStateMachine, error)
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ListSwapsFilter selects and orders the swaps of ListSwapsFiltered. Zero
+// values do not filter.
+type ListSwapsFilter struct {
+	PeerNodeId string
+	// ChannelId is the short channel id in the 1x2x3 or 1:2:3 format.
+	ChannelId string
+	Asset     string
+	Type      *SwapType
+	Role      *SwapRole
+	// States selects the swaps that are in one of the states.
+	States []StateType
+	// Finished selects the finished or the active swaps.
+	Finished *bool
+	// From and To select the swaps that were created in [From, To).
+	From time.Time
+	To   time.Time
+
+	// The swaps are ordered by creation time, oldest first unless Descending
+	// is set.
+	Descending bool
+	// Cursor is the next cursor of the previous page.
+	Cursor string
+	// Limit is the size of a page, 0 returns all swaps.
+	Limit int
+}
+
+// Scid returns the channel id of the filter in the format of
+// SwapData.GetScid.
+func (f *ListSwapsFilter) Scid() string {
+	return strings.ReplaceAll(f.ChannelId, ":", "x")
+}
+
+// Matches returns true if the swap matches the filter. It ignores the cursor.
+func (f *ListSwapsFilter) Matches(swap *SwapStateMachine) bool {
+	if swap.Data == nil {
+		return false
+	}
+	if f.PeerNodeId != "" && swap.Data.PeerNodeId != f.PeerNodeId {
+		return false
+	}
+	if f.ChannelId != "" && swap.Data.GetScid() != f.Scid() {
+		return false
+	}
+	if f.Asset != "" && swap.Data.GetChain() != f.Asset {
+		return false
+	}
+	if f.Type != nil && swap.Type != *f.Type {
+		return false
+	}
+	if f.Role != nil && swap.Role != *f.Role {
+		return false
+	}
+	if len(f.States) > 0 {
+		var found bool
+		for _, state := range f.States {
+			if swap.Current == state {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Finished != nil && IsFinalState(swap.Current) != *f.Finished {
+		return false
+	}
+	createdAt := time.Unix(swap.Data.CreatedAt, 0)
+	if !f.From.IsZero() && createdAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !createdAt.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// ListSwapsCursor is the position of a swap in the order of the swaps.
+type ListSwapsCursor struct {
+	CreatedAt int64
+	SwapId    string
+}
+
+func NewListSwapsCursor(swap *SwapStateMachine) *ListSwapsCursor {
+	return &ListSwapsCursor{CreatedAt: swap.Data.CreatedAt, SwapId: swap.SwapId.String()}
+}
+
+func (c *ListSwapsCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", c.CreatedAt, c.SwapId)))
+}
+
+// ParseCursor parses the cursor of the filter. It returns nil if the cursor
+// is not set.
+func (f *ListSwapsFilter) ParseCursor() (*ListSwapsCursor, error) {
+	if f.Cursor == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(f.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+	createdAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &ListSwapsCursor{CreatedAt: createdAt, SwapId: parts[1]}, nil
+}
+
+// less returns true if the swap comes before the cursor in ascending order.
+func (c *ListSwapsCursor) less(other *ListSwapsCursor) bool {
+	if c.CreatedAt != other.CreatedAt {
+		return c.CreatedAt < other.CreatedAt
+	}
+	return c.SwapId < other.SwapId
+}
+
+// After returns true if the swap comes after the cursor in the order of the
+// filter.
+func (f *ListSwapsFilter) After(cursor *ListSwapsCursor, swap *SwapStateMachine) bool {
+	if cursor == nil {
+		return true
+	}
+	if f.Descending {
+		return NewListSwapsCursor(swap).less(cursor)
+	}
+	return cursor.less(NewListSwapsCursor(swap))
+}
+
+// ListSwapsFiltered returns a page of the swaps that match the filter and the
+// cursor of the next page. The next cursor is empty on the last page.
+func (s *SwapService) ListSwapsFiltered(filter *ListSwapsFilter) ([]*SwapStateMachine, string, error) {
+	if filter.Limit < 0 {
+		return nil, "", errors.New("limit must not be negative")
+	}
+	cursor, err := filter.ParseCursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one more swap to know if there is a next page.
+	f := *filter
+	if f.Limit > 0 {
+		f.Limit++
+	}
+
+	var swaps []*SwapStateMachine
+	if store, ok := s.swapServices.swapStore.(FilteredStore); ok {
+		swaps, err = store.ListFiltered(&f)
+		if err != nil {
+			return nil, "", err
+		}
+	} else {
+		swaps, err = listFiltered(s.swapServices.swapStore, &f, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if filter.Limit > 0 && len(swaps) > filter.Limit {
+		swaps = swaps[:filter.Limit]
+		return swaps, NewListSwapsCursor(swaps[len(swaps)-1]).String(), nil
+	}
+	return swaps, "", nil
+}
+
+func listFiltered(store Store, filter *ListSwapsFilter, cursor *ListSwapsCursor) ([]*SwapStateMachine, error) {
+	all, err := store.ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var swaps []*SwapStateMachine
+	for _, swap := range all {
+		if filter.Matches(swap) && filter.After(cursor, swap) {
+			swaps = append(swaps, swap)
+		}
+	}
+	sort.Slice(swaps, func(i, j int) bool {
+		if filter.Descending {
+			return NewListSwapsCursor(swaps[j]).less(NewListSwapsCursor(swaps[i]))
+		}
+		return NewListSwapsCursor(swaps[i]).less(NewListSwapsCursor(swaps[j]))
+	})
+	if filter.Limit > 0 && len(swaps) > filter.Limit {
+		swaps = swaps[:filter.Limit]
+	}
+	return swaps, nil
+}
+
+// FinalStates are the states of finished swaps.
+var FinalStates = []StateType{State_ClaimedPreimage, State_ClaimedCsv, State_ClaimedCoop, State_SwapCanceled}
+
+// IsFinalState returns true if a swap in the state is finished.
+func IsFinalState(state StateType) bool {
+	for _, s := range FinalStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
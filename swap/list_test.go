@@ -0,0 +1,84 @@
+package swap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSwapsFiltered(t *testing.T) {
+	swapService := getTestSetup("alice")
+
+	var ids []string
+	for i, state := range []StateType{State_ClaimedPreimage, State_SwapOutSender_AwaitTxConfirmation, State_SwapCanceled, State_ClaimedCsv} {
+		swap := getFeeBumpTestSwap(swapService, SWAPTYPE_OUT, SWAPROLE_SENDER, state)
+		swap.Data.CreatedAt = int64(100 * (i + 1))
+		swap.Data.PeerNodeId = "bob"
+		ids = append(ids, swap.SwapId.String())
+	}
+	in := getFeeBumpTestSwap(swapService, SWAPTYPE_IN, SWAPROLE_RECEIVER, State_ClaimedPreimage)
+	in.Data.CreatedAt = 500
+	in.Data.PeerNodeId = "carol"
+	ids = append(ids, in.SwapId.String())
+
+	swapIds := func(swaps []*SwapStateMachine) []string {
+		var ids []string
+		for _, swap := range swaps {
+			ids = append(ids, swap.SwapId.String())
+		}
+		return ids
+	}
+
+	// Pages are returned in order until the next cursor is empty.
+	var got []string
+	filter := &ListSwapsFilter{Limit: 2}
+	for {
+		swaps, next, err := swapService.ListSwapsFiltered(filter)
+		require.NoError(t, err)
+		got = append(got, swapIds(swaps)...)
+		if next == "" {
+			break
+		}
+		filter.Cursor = next
+	}
+	assert.Equal(t, ids, got)
+
+	swaps, next, err := swapService.ListSwapsFiltered(&ListSwapsFilter{Descending: true, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[4], ids[3]}, swapIds(swaps))
+	swaps, _, err = swapService.ListSwapsFiltered(&ListSwapsFilter{Descending: true, Limit: 2, Cursor: next})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2], ids[1]}, swapIds(swaps))
+
+	finished := false
+	swaps, _, err = swapService.ListSwapsFiltered(&ListSwapsFilter{Finished: &finished})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[1]}, swapIds(swaps))
+
+	swapType := SWAPTYPE_IN
+	swaps, _, err = swapService.ListSwapsFiltered(&ListSwapsFilter{Type: &swapType})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[4]}, swapIds(swaps))
+
+	swaps, _, err = swapService.ListSwapsFiltered(&ListSwapsFilter{
+		PeerNodeId: "bob",
+		States:     []StateType{State_ClaimedPreimage, State_ClaimedCsv},
+		From:       time.Unix(100, 0),
+		To:         time.Unix(400, 0),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[0]}, swapIds(swaps))
+
+	_, _, err = swapService.ListSwapsFiltered(&ListSwapsFilter{Cursor: "invalid"})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func Test_ListSwapsFilter_Channel(t *testing.T) {
+	swap := &SwapStateMachine{Data: &SwapData{SwapOutRequest: &SwapOutRequestMessage{Scid: "1x2x3"}}}
+
+	assert.True(t, (&ListSwapsFilter{ChannelId: "1:2:3"}).Matches(swap))
+	assert.True(t, (&ListSwapsFilter{ChannelId: "1x2x3"}).Matches(swap))
+	assert.False(t, (&ListSwapsFilter{ChannelId: "1x2x4"}).Matches(swap))
+}
@@ -134,6 +134,19 @@ func validateNetwork(network string) error {
 	}
 	return InvalidNetworkError
 }
+
+// validateAgreedProtocolVersion checks that the peer agreed on the protocol
+// version that we requested. Agreements without a version are accepted.
+func validateAgreedProtocolVersion(version uint8, swap *SwapData) error {
+	if version == 0 || swap == nil || swap.ProtocolVersion == 0 {
+		return nil
+	}
+	if version != swap.ProtocolVersion {
+		return fmt.Errorf("peer agreed on protocol version %d, requested %d", version, swap.ProtocolVersion)
+	}
+	return nil
+}
+
 func validateHexString(paramName, hexString string, expectedLength int) error {
 	data, err := hex.DecodeString(hexString)
 	if err != nil {
@@ -176,6 +189,10 @@ func (s SwapInAgreementMessage) Validate(swap *SwapData) error {
 	if err != nil {
 		return err
 	}
+	err = validateAgreedProtocolVersion(s.ProtocolVersion, swap)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -217,6 +234,12 @@ type SwapOutRequestMessage struct {
 	// Pubkey is a 33 byte compressed public key used for the spending paths in
 	// the opening_transaction.
 	Pubkey string `json:"pubkey"`
+	// BatchId is an optional randomly generated 32 byte string that is shared
+	// by all swap-outs of a batch. The swap-out peer may fund the opening
+	// outputs of all swaps of a batch in a single opening_transaction.
+	BatchId string `json:"batch_id,omitempty"`
+	// BatchSize is the number of swap-outs in the batch.
+	BatchSize uint32 `json:"batch_size,omitempty"`
 }
 
 func (s SwapOutRequestMessage) Validate(swap *SwapData) error {
@@ -224,6 +247,15 @@ func (s SwapOutRequestMessage) Validate(swap *SwapData) error {
 	if err != nil {
 		return err
 	}
+	if s.BatchId != "" {
+		err = validateHexString("batch_id", s.BatchId, 32)
+		if err != nil {
+			return err
+		}
+		if s.BatchSize < 2 || s.BatchSize > maxBatchSize {
+			return fmt.Errorf("invalid batch_size %d", s.BatchSize)
+		}
+	}
 	err = validateAssetAndNetwork(s.Asset, s.Network)
 	if err != nil {
 		return err
@@ -261,8 +293,11 @@ type SwapOutAgreementMessage struct {
 	// the opening_transaction.
 	Pubkey string `json:"pubkey"`
 	// Payreq is a BOLT#11 invoice with an amount that covers the fee expenses
-	// for the on-chain transactions.
+	// for the on-chain transactions and the premium.
 	Payreq string
+	// Premium is a compensation in Sats that the swap partner wants to be payed
+	// in order to participate in the swap. It is part of the Payreq amount.
+	Premium uint64 `json:"premium"`
 }
 
 func (s SwapOutAgreementMessage) Validate(swap *SwapData) error {
@@ -270,6 +305,10 @@ func (s SwapOutAgreementMessage) Validate(swap *SwapData) error {
 	if err != nil {
 		return err
 	}
+	err = validateAgreedProtocolVersion(s.ProtocolVersion, swap)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -339,6 +378,9 @@ type CancelMessage struct {
 	SwapId *SwapId `json:"swap_id"`
 	// Message is a hint to why the swap was canceled.
 	Message string `json:"message"`
+	// Code is the machine readable reason why the swap was canceled. Peers
+	// of older versions do not send it.
+	Code CancelCode `json:"code,omitempty"`
 }
 
 func (e CancelMessage) MessageType() messages.MessageType {
@@ -350,6 +392,11 @@ func (s CancelMessage) Validate(swap *SwapData) error {
 }
 
 func (m CancelMessage) ApplyToSwapData(swap *SwapData) error {
+	// The code is used as a metric label and in the peer statistics, codes
+	// that we do not know are stored as unknown.
+	if m.Code != "" && !m.Code.isKnown() {
+		m.Code = CancelCode_Unknown
+	}
 	swap.Cancel = &m
 	return nil
 }
@@ -0,0 +1,270 @@
+package swap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PremiumRate is the premium that a peer asks for the swaps on an asset.
+type PremiumRate struct {
+	RatePpm  uint64 `json:"rate_ppm"`
+	FixedSat uint64 `json:"fixed_sat"`
+}
+
+// Premium returns the premium in sat for a swap of amountSat.
+func (r PremiumRate) Premium(amountSat uint64) uint64 {
+	return r.FixedSat + amountSat*r.RatePpm/1000000
+}
+
+// PeerTerms are the terms of a peer for our swaps as the peer announced them
+// in its last poll.
+type PeerTerms struct {
+	ProtocolVersion uint64
+	Assets          []string
+	SwapsAllowed    bool
+	// Premiums are the premiums that the peer asks us for by asset. Peers
+	// of older versions do not announce them.
+	Premiums map[string]PremiumRate
+	// MaxProtocolVersion is the highest protocol version that the peer
+	// supports. Peers of older versions do not announce it.
+	MaxProtocolVersion uint64
+	// MinProtocolVersion is the lowest protocol version that the peer
+	// supports. Peers of older versions do not announce it.
+	MinProtocolVersion uint64
+}
+
+// ProtocolVersions returns the range of protocol versions that the peer
+// supports. Peers that do not announce a range only support ProtocolVersion.
+func (t *PeerTerms) ProtocolVersions() (minVersion, maxVersion uint64) {
+	minVersion, maxVersion = t.ProtocolVersion, t.ProtocolVersion
+	if t.MinProtocolVersion != 0 && t.MinProtocolVersion < minVersion {
+		minVersion = t.MinProtocolVersion
+	}
+	if t.MaxProtocolVersion > maxVersion {
+		maxVersion = t.MaxProtocolVersion
+	}
+	return minVersion, maxVersion
+}
+
+// SwapQuote is an estimate of the costs of a swap that we would start and
+// the results of the checks that the swap has to pass.
+type SwapQuote struct {
+	PeerNodeId string   `json:"peer_node_id"`
+	Asset      string   `json:"asset"`
+	SwapType   SwapType `json:"swap_type"`
+	AmountSat  uint64   `json:"amount_sat"`
+	ChannelId  string   `json:"channel_id"`
+
+	// OpeningTxFeeSat is the fee of the opening transaction. We pay it on
+	// a swap-in and with the fee invoice of the peer on a swap-out.
+	OpeningTxFeeSat uint64 `json:"opening_tx_fee_sat"`
+	// ClaimTxFeeSat is the fee of the claim transaction. It is paid by the
+	// claimer, that is us on a swap-out and the peer on a swap-in.
+	ClaimTxFeeSat uint64 `json:"claim_tx_fee_sat"`
+	// PremiumSat is the premium that the peer asks for, it is only known if
+	// the peer announces its premiums.
+	PremiumSat   uint64 `json:"premium_sat"`
+	PremiumKnown bool   `json:"premium_known"`
+	// TotalCostSat is the sum of the fees and the premium that we pay.
+	TotalCostSat uint64 `json:"total_cost_sat"`
+	// MaxSwapAmountSat is the highest amount that the channel balance and,
+	// on a swap-in, the wallet balance allow.
+	MaxSwapAmountSat uint64 `json:"max_swap_amount_sat"`
+
+	LocalAccepted  bool           `json:"local_accepted"`
+	LocalChecks    []*PolicyCheck `json:"local_checks"`
+	RemoteAccepted bool           `json:"remote_accepted"`
+	RemoteChecks   []*PolicyCheck `json:"remote_checks"`
+}
+
+// QuoteSwap estimates the costs of a swap that we would start with the peer
+// without starting it. The local checks are the ones that SwapOut and SwapIn
+// run, the remote checks use the terms of the last poll of the peer, which
+// are nil if the peer did not poll. The fees are estimated with our wallet,
+// the peer estimates the opening fee of a swap-out with its own.
+func (s *SwapService) QuoteSwap(peer, asset string, swapType SwapType, amountSat uint64, channelId string, terms *PeerTerms) (*SwapQuote, error) {
+	if swapType != SWAPTYPE_IN && swapType != SWAPTYPE_OUT {
+		return nil, errors.New("unknown swap type, expected swap-in or swap-out")
+	}
+	if asset != btc_chain && asset != l_btc_chain {
+		return nil, fmt.Errorf("unknown asset %s, expected btc or lbtc", asset)
+	}
+
+	services := s.swapServices
+	quote := &SwapQuote{
+		PeerNodeId: peer,
+		Asset:      asset,
+		SwapType:   swapType,
+		AmountSat:  amountSat,
+		ChannelId:  channelId,
+	}
+
+	var local policyChecks
+	var err error
+	if !services.policy.NewSwapsAllowed() {
+		err = errors.New("swaps are disabled")
+	}
+	local.add("new_swaps_allowed", err, "swaps are enabled")
+
+	// The fees can only be estimated with the wallet of an enabled asset.
+	var wallet Wallet
+	err = nil
+	if (asset == btc_chain && !services.bitcoinEnabled) || (asset == l_btc_chain && !services.liquidEnabled) {
+		err = fmt.Errorf("%s swaps are not supported", asset)
+	} else if _, wallet, _, err = services.getOnChainServices(asset); err != nil {
+		return nil, err
+	}
+	local.add("asset_enabled", err, fmt.Sprintf("%s swaps are supported", asset))
+	if wallet != nil {
+		quote.OpeningTxFeeSat, err = wallet.GetFlatOpeningTXFee()
+		if err != nil {
+			return nil, err
+		}
+		quote.ClaimTxFeeSat, err = wallet.GetRefundFee()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = nil
+	if services.policy.IsPeerSuspicious(peer) {
+		err = PeerIsSuspiciousError(peer)
+	}
+	local.add("suspicious_peers", err, "peer is not suspicious")
+
+	err = nil
+	minMsat := services.policy.GetPeerMinSwapAmountMsat(peer)
+	if amountSat*1000 < minMsat {
+		err = ErrMinimumSwapSize(minMsat)
+	}
+	local.add("min_swap_amount", err, fmt.Sprintf("amount is at least %d msat", minMsat))
+
+	err = nil
+	if services.policy.IsChannelExcluded(channelId) {
+		err = ChannelExcludedError(channelId)
+	}
+	local.add("excluded_channel", err, "swaps are allowed on the channel")
+
+	// We pay for a swap-out and get paid for a swap-in, which is the
+	// balance that a swap request of the peer of the other type needs.
+	peerSwapType := SWAPTYPE_OUT
+	if swapType == SWAPTYPE_OUT {
+		peerSwapType = SWAPTYPE_IN
+	}
+	local.add("channel_balance", checkChannelBalance(services, peerSwapType, amountSat, channelId), "channel balance covers the amount")
+
+	if swapType == SWAPTYPE_IN && wallet != nil {
+		local.add("onchain_balance", services.checkWalletReserve(asset, amountSat), "wallet balance covers the amount, the opening fee and the reserve")
+	} else if swapType == SWAPTYPE_IN {
+		local.skip("onchain_balance", fmt.Sprintf("%s swaps are not supported", asset))
+	} else {
+		local.skip("onchain_balance", "the peer funds the opening transaction")
+	}
+
+	quote.MaxSwapAmountSat, err = maxSwapAmountSat(services, asset, swapType, channelId, quote.OpeningTxFeeSat, wallet)
+	if err != nil {
+		return nil, err
+	}
+	err = nil
+	if amountSat > quote.MaxSwapAmountSat {
+		err = fmt.Errorf("exceeding maximum swap amount: %d", quote.MaxSwapAmountSat)
+	}
+	local.add("max_swap_amount", err, fmt.Sprintf("amount is at most %d sat", quote.MaxSwapAmountSat))
+
+	remote := checkPeerTerms(quote, terms)
+
+	quote.TotalCostSat = quote.OpeningTxFeeSat + quote.PremiumSat
+	if swapType == SWAPTYPE_OUT {
+		quote.TotalCostSat += quote.ClaimTxFeeSat
+	}
+	quote.LocalChecks, quote.LocalAccepted = local, local.passed()
+	quote.RemoteChecks, quote.RemoteAccepted = remote, remote.passed()
+	return quote, nil
+}
+
+// checkPeerTerms runs the checks of the swap of the quote on the side of the
+// peer and sets the premium of the quote if the peer announces it.
+func checkPeerTerms(quote *SwapQuote, terms *PeerTerms) policyChecks {
+	var remote policyChecks
+	if terms == nil {
+		remote.add("poll", errors.New("no poll from peer"), "")
+		return remote
+	}
+	remote.add("poll", nil, "peer polled")
+
+	var err error
+	minVersion, maxVersion := terms.ProtocolVersions()
+	version, ok := HighestCommonProtocolVersion(minVersion, maxVersion)
+	if !ok {
+		err = NoCommonProtocolVersionError{PeerId: quote.PeerNodeId, MinVersion: minVersion, MaxVersion: maxVersion}
+	}
+	remote.add("protocol_version", err, fmt.Sprintf("swap runs protocol version %d", version))
+
+	err = fmt.Errorf("peer does not support %s swaps", quote.Asset)
+	for _, asset := range terms.Assets {
+		if asset == quote.Asset {
+			err = nil
+		}
+	}
+	remote.add("asset_supported", err, fmt.Sprintf("peer supports %s swaps", quote.Asset))
+
+	err = nil
+	if !terms.SwapsAllowed {
+		err = errors.New("peer does not accept our swap requests")
+	}
+	remote.add("swaps_allowed", err, "peer accepts our swap requests")
+
+	if terms.Premiums == nil {
+		remote.skip("premium", "peer does not announce its premiums")
+		return remote
+	}
+	quote.PremiumSat = terms.Premiums[quote.Asset].Premium(quote.AmountSat)
+	quote.PremiumKnown = true
+	err = nil
+	if quote.PremiumSat >= quote.AmountSat {
+		err = fmt.Errorf("premium %d exceeds swap amount %d", quote.PremiumSat, quote.AmountSat)
+	}
+	remote.add("premium", err, fmt.Sprintf("premium of %d sat is asked", quote.PremiumSat))
+	return remote
+}
+
+// maxSwapAmountSat returns the highest amount of a swap on the channel that
+// we start. We pay for a swap-out over the channel, a swap-in is paid to us
+// over the channel and funded by our wallet, which keeps its reserve.
+func maxSwapAmountSat(services *SwapServices, asset string, swapType SwapType, channelId string, openingFeeSat uint64, wallet Wallet) (uint64, error) {
+	// The channel has to hold more than the amount.
+	belowMsat := func(msat uint64) uint64 {
+		if msat == 0 {
+			return 0
+		}
+		return (msat - 1) / 1000
+	}
+
+	// An unknown channel fails the channel balance check, nothing can be
+	// swapped on it.
+	if swapType == SWAPTYPE_OUT {
+		sp, err := services.lightning.SpendableMsat(channelId)
+		if err != nil {
+			return 0, nil
+		}
+		return belowMsat(sp), nil
+	}
+
+	rs, err := services.lightning.ReceivableMsat(channelId)
+	if err != nil || wallet == nil {
+		return 0, nil
+	}
+	max := belowMsat(rs)
+	balance, err := wallet.GetOnchainBalance()
+	if err != nil {
+		return 0, err
+	}
+	spendable := uint64(0)
+	if keep := openingFeeSat + services.onchainReserveSat(asset); balance > keep {
+		spendable = balance - keep
+	}
+	if spendable < max {
+		max = spendable
+	}
+	return max, nil
+}
@@ -0,0 +1,95 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QuoteSwap(t *testing.T) {
+	service := getTestSetup("alice")
+	_, peer, _, _, chanId := getTestParams()
+
+	checks := func(cs []*PolicyCheck) map[string]*PolicyCheck {
+		m := map[string]*PolicyCheck{}
+		for _, c := range cs {
+			m[c.Name] = c
+		}
+		return m
+	}
+	terms := &PeerTerms{
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+		Assets:          []string{"btc", "lbtc"},
+		SwapsAllowed:    true,
+		Premiums:        map[string]PremiumRate{"btc": {RatePpm: 1000, FixedSat: 10}},
+	}
+
+	// We pay the opening fee with the fee invoice and claim on a swap-out.
+	quote, err := service.QuoteSwap(peer, "btc", SWAPTYPE_OUT, 1000000, chanId, terms)
+	require.NoError(t, err)
+	assert.True(t, quote.LocalAccepted)
+	assert.True(t, quote.RemoteAccepted)
+	assert.Len(t, quote.LocalChecks, 8)
+	assert.Len(t, quote.RemoteChecks, 5)
+	assert.True(t, quote.PremiumKnown)
+	assert.EqualValues(t, 1010, quote.PremiumSat)
+	assert.EqualValues(t, 100, quote.OpeningTxFeeSat)
+	assert.EqualValues(t, 100, quote.ClaimTxFeeSat)
+	assert.EqualValues(t, 1210, quote.TotalCostSat)
+	assert.True(t, checks(quote.LocalChecks)["onchain_balance"].Skipped)
+
+	// The wallet of 10000000 sat funds a swap-in and its opening fee.
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_IN, 1000000, chanId, terms)
+	require.NoError(t, err)
+	assert.True(t, quote.LocalAccepted)
+	assert.EqualValues(t, 1110, quote.TotalCostSat)
+	assert.EqualValues(t, 9999900, quote.MaxSwapAmountSat)
+	assert.True(t, checks(quote.LocalChecks)["onchain_balance"].Passed)
+
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_IN, 9999901, chanId, terms)
+	require.NoError(t, err)
+	assert.False(t, quote.LocalAccepted)
+	assert.False(t, checks(quote.LocalChecks)["max_swap_amount"].Passed)
+
+	// Peers of older versions do not announce their premiums.
+	oldTerms := *terms
+	oldTerms.Premiums = nil
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_OUT, 1000000, chanId, &oldTerms)
+	require.NoError(t, err)
+	assert.True(t, quote.RemoteAccepted)
+	assert.False(t, quote.PremiumKnown)
+	assert.True(t, checks(quote.RemoteChecks)["premium"].Skipped)
+
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_OUT, 1000000, chanId, nil)
+	require.NoError(t, err)
+	assert.False(t, quote.RemoteAccepted)
+	assert.Len(t, quote.RemoteChecks, 1)
+
+	oldTerms.ProtocolVersion = PEERSWAP_MAX_PROTOCOL_VERSION + 1
+	oldTerms.Assets = []string{"lbtc"}
+	oldTerms.SwapsAllowed = false
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_OUT, 1000000, chanId, &oldTerms)
+	require.NoError(t, err)
+	var failed []string
+	for _, c := range quote.RemoteChecks {
+		if !c.Passed {
+			failed = append(failed, c.Name)
+		}
+	}
+	assert.Equal(t, []string{"protocol_version", "asset_supported", "swaps_allowed"}, failed)
+
+	dummy := service.swapServices.policy.(*dummyPolicy)
+	dummy.isPeerSuspiciousReturn = true
+	dummy.excludedScids = []string{chanId}
+	quote, err = service.QuoteSwap(peer, "btc", SWAPTYPE_OUT, 1000000, chanId, terms)
+	require.NoError(t, err)
+	assert.False(t, quote.LocalAccepted)
+	assert.Equal(t, ChannelExcludedError(chanId).Error(), checks(quote.LocalChecks)["excluded_channel"].Reason)
+	assert.Equal(t, PeerIsSuspiciousError(peer).Error(), checks(quote.LocalChecks)["suspicious_peers"].Reason)
+
+	_, err = service.QuoteSwap(peer, "btc", 0, 1000000, chanId, terms)
+	assert.Error(t, err)
+	_, err = service.QuoteSwap(peer, "eth", SWAPTYPE_IN, 1000000, chanId, terms)
+	assert.Error(t, err)
+}
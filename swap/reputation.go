@@ -0,0 +1,228 @@
+package swap
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+)
+
+// MaxReputationScore is the reputation score of a peer without any bad
+// outcomes in the reputation window.
+const MaxReputationScore = 100
+
+var ErrReputationNotSupported = errors.New("peer reputation is not supported by the store")
+
+// Outcome is the outcome of a finished swap that tells how the peer behaved.
+type Outcome string
+
+const (
+	// OutcomeSuccess is a swap that was claimed with the preimage.
+	OutcomeSuccess Outcome = "success"
+	// OutcomeCsvClaim is a swap where we funded the opening transaction and
+	// had to claim it back after the csv timeout.
+	OutcomeCsvClaim Outcome = "csv_claim"
+	// OutcomeUnpaidClaimInvoice is a swap where we funded the opening
+	// transaction and the peer did not pay the claim invoice but canceled
+	// the swap cooperatively.
+	OutcomeUnpaidClaimInvoice Outcome = "unpaid_claim_invoice"
+	// OutcomeCanceledAfterFeeInvoice is a swap-out of the peer that was
+	// canceled after we sent the fee invoice and before it was paid.
+	OutcomeCanceledAfterFeeInvoice Outcome = "canceled_after_fee_invoice"
+	// OutcomeTimeout is a swap that timed out waiting for the peer.
+	OutcomeTimeout Outcome = "timeout"
+)
+
+// Penalty returns the number of points that the outcome subtracts from the
+// reputation score.
+func (o Outcome) Penalty() uint64 {
+	switch o {
+	case OutcomeCsvClaim:
+		return 25
+	case OutcomeUnpaidClaimInvoice, OutcomeCanceledAfterFeeInvoice:
+		return 10
+	case OutcomeTimeout:
+		return 5
+	}
+	return 0
+}
+
+// PeerOutcome records the outcome of a finished swap with a peer.
+type PeerOutcome struct {
+	SwapId     string  `json:"swap_id"`
+	PeerNodeId string  `json:"peer_node_id"`
+	Outcome    Outcome `json:"outcome"`
+	// FinishedAt is the unix time at which the swap finished.
+	FinishedAt int64 `json:"finished_at"`
+}
+
+// ReputationStore is implemented by stores that can keep the outcomes of the
+// swaps with a peer. The outcomes are kept when the swaps are archived.
+type ReputationStore interface {
+	// AddPeerOutcome stores the outcome, replacing an outcome of the same
+	// swap.
+	AddPeerOutcome(outcome *PeerOutcome) error
+	// ListPeerOutcomes returns the outcomes of the peer, of all peers if
+	// peer is empty.
+	ListPeerOutcomes(peer string) ([]*PeerOutcome, error)
+}
+
+// PeerReputation is the reputation of a peer. The counts and the score cover
+// the outcomes of the reputation window, the history holds all outcomes,
+// latest first.
+type PeerReputation struct {
+	PeerNodeId string `json:"peer_node_id"`
+	// Score starts at MaxReputationScore and is reduced by the penalty of
+	// every bad outcome, down to 0.
+	Score uint64 `json:"score"`
+	// Limited is true if the score is below the limit score of the policy.
+	Limited bool `json:"limited"`
+
+	Successes               uint64 `json:"successes"`
+	CsvClaims               uint64 `json:"csv_claims"`
+	UnpaidClaimInvoices     uint64 `json:"unpaid_claim_invoices"`
+	CanceledAfterFeeInvoice uint64 `json:"canceled_after_fee_invoice"`
+	Timeouts                uint64 `json:"timeouts"`
+
+	History []*PeerOutcome `json:"history"`
+}
+
+// swapOutcome returns the outcome of a finished swap and false if the swap
+// says nothing about the peer, e.g. if the request was rejected.
+func swapOutcome(swap *SwapStateMachine) (Outcome, bool) {
+	if swap.Data == nil {
+		return "", false
+	}
+	switch swap.Data.TimedOutIn {
+	case State_SwapOutSender_AwaitAgreement,
+		State_SwapInSender_AwaitAgreement,
+		State_SwapInReceiver_AwaitTxBroadcastedMessage:
+		return OutcomeTimeout, true
+	}
+
+	switch swap.Current {
+	case State_ClaimedPreimage:
+		return OutcomeSuccess, true
+	case State_ClaimedCsv:
+		if swap.isMaker() {
+			return OutcomeCsvClaim, true
+		}
+	case State_ClaimedCoop:
+		if swap.isMaker() {
+			return OutcomeUnpaidClaimInvoice, true
+		}
+	case State_SwapCanceled:
+		// Only the peer walking away from a fee invoice that we sent counts,
+		// not our own failures or a peer rejecting our fee invoice.
+		if swap.Type == SWAPTYPE_OUT && swap.Role == SWAPROLE_RECEIVER &&
+			swap.Previous == State_SwapOutReceiver_AwaitFeeInvoicePayment &&
+			swap.Data.Cancel != nil && !isCausedByUs(swap.Data.GetCancelCode()) {
+			return OutcomeCanceledAfterFeeInvoice, true
+		}
+	}
+	return "", false
+}
+
+// isCausedByUs returns true if a peer that cancels a swap with the code
+// rejects something that we sent.
+func isCausedByUs(code CancelCode) bool {
+	switch code {
+	case CancelCode_PremiumTooHigh, CancelCode_InvalidMessage, CancelCode_IncompatibleVersion:
+		return true
+	}
+	return false
+}
+
+// recordOutcome stores the outcome of a swap that just finished and adds the
+// peer to the suspicious peers if its score drops below the threshold of the
+// policy.
+func (s *SwapServices) recordOutcome(swap *SwapStateMachine) {
+	store, ok := s.swapStore.(ReputationStore)
+	if !ok {
+		return
+	}
+	outcome, ok := swapOutcome(swap)
+	if !ok {
+		return
+	}
+	err := store.AddPeerOutcome(&PeerOutcome{
+		SwapId:     swap.SwapId.String(),
+		PeerNodeId: swap.Data.PeerNodeId,
+		Outcome:    outcome,
+		FinishedAt: swap.Data.FinishedAt,
+	})
+	if err != nil {
+		log.Infof("[Reputation] could not record outcome of swap %s: %v", swap.SwapId, err)
+		return
+	}
+	if outcome.Penalty() == 0 {
+		return
+	}
+
+	reputation, err := s.getPeerReputation(swap.Data.PeerNodeId)
+	if err != nil {
+		log.Infof("[Reputation] could not get reputation of peer %s: %v", swap.Data.PeerNodeId, err)
+		return
+	}
+	threshold := s.policy.GetReputationPolicy().SuspiciousScore
+	if reputation.Score >= threshold || s.policy.IsPeerSuspicious(swap.Data.PeerNodeId) {
+		return
+	}
+	reason := fmt.Sprintf("reputation score %d is below %d", reputation.Score, threshold)
+	if err := s.addSuspiciousPeer(swap.Data.PeerNodeId, swap.SwapId.String(), reason); err != nil {
+		log.Infof("[Reputation] error adding peer %s to suspicious peer list: %v", swap.Data.PeerNodeId, err)
+		return
+	}
+	log.Infof("[Reputation] added peer %s with a score of %d to suspicious peer list", swap.Data.PeerNodeId, reputation.Score)
+}
+
+// getPeerReputation computes the reputation of the peer from the stored
+// outcomes.
+func (s *SwapServices) getPeerReputation(peer string) (*PeerReputation, error) {
+	store, ok := s.swapStore.(ReputationStore)
+	if !ok {
+		return nil, ErrReputationNotSupported
+	}
+	outcomes, err := store.ListPeerOutcomes(peer)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(outcomes, func(i, j int) bool {
+		return outcomes[i].FinishedAt > outcomes[j].FinishedAt
+	})
+
+	rp := s.policy.GetReputationPolicy()
+	windowStart := time.Now().Add(-time.Duration(rp.WindowDays) * 24 * time.Hour).Unix()
+	reputation := &PeerReputation{PeerNodeId: peer, History: outcomes}
+	var penalty uint64
+	for _, o := range outcomes {
+		if o.FinishedAt < windowStart {
+			continue
+		}
+		switch o.Outcome {
+		case OutcomeSuccess:
+			reputation.Successes++
+		case OutcomeCsvClaim:
+			reputation.CsvClaims++
+		case OutcomeUnpaidClaimInvoice:
+			reputation.UnpaidClaimInvoices++
+		case OutcomeCanceledAfterFeeInvoice:
+			reputation.CanceledAfterFeeInvoice++
+		case OutcomeTimeout:
+			reputation.Timeouts++
+		}
+		penalty += o.Outcome.Penalty()
+	}
+	if penalty < MaxReputationScore {
+		reputation.Score = MaxReputationScore - penalty
+	}
+	reputation.Limited = reputation.Score < rp.LimitScore
+	return reputation, nil
+}
+
+// GetPeerReputation returns the reputation of the peer.
+func (s *SwapService) GetPeerReputation(peer string) (*PeerReputation, error) {
+	return s.swapServices.getPeerReputation(peer)
+}
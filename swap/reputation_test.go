@@ -0,0 +1,145 @@
+package swap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_SwapOutcome(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		swapType SwapType
+		role     SwapRole
+		state    StateType
+		setup    func(*SwapStateMachine)
+		outcome  Outcome
+		ok       bool
+	}{
+		{"claimed", SWAPTYPE_IN, SWAPROLE_RECEIVER, State_ClaimedPreimage, nil, OutcomeSuccess, true},
+		{"csv as maker", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCsv, nil, OutcomeCsvClaim, true},
+		{"coop as maker", SWAPTYPE_IN, SWAPROLE_SENDER, State_ClaimedCoop, nil, OutcomeUnpaidClaimInvoice, true},
+		{"coop as taker", SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedCoop, nil, "", false},
+		{"unpaid fee invoice", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapCanceled, func(s *SwapStateMachine) {
+			s.Previous = State_SwapOutReceiver_AwaitFeeInvoicePayment
+			s.Data.SwapOutAgreement = &SwapOutAgreementMessage{}
+			s.Data.OpeningTxBroadcasted = nil
+			s.Data.Cancel = &CancelMessage{Message: "gone", Code: CancelCode_InternalError}
+		}, OutcomeCanceledAfterFeeInvoice, true},
+		{"fee invoice rejected", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapCanceled, func(s *SwapStateMachine) {
+			s.Previous = State_SwapOutReceiver_AwaitFeeInvoicePayment
+			s.Data.SwapOutAgreement = &SwapOutAgreementMessage{}
+			s.Data.OpeningTxBroadcasted = nil
+			s.Data.Cancel = &CancelMessage{Message: "premium", Code: CancelCode_PremiumTooHigh}
+		}, "", false},
+		{"opening tx failed", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapCanceled, func(s *SwapStateMachine) {
+			s.Previous = State_SendCancel
+			s.Data.SwapOutAgreement = &SwapOutAgreementMessage{}
+			s.Data.OpeningTxBroadcasted = nil
+			s.Data.CancelCode = CancelCode_InsufficientBalance
+		}, "", false},
+		{"rejected request", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_SwapCanceled, func(s *SwapStateMachine) {
+			s.Data.OpeningTxBroadcasted = nil
+		}, "", false},
+		{"timeout", SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapCanceled, func(s *SwapStateMachine) {
+			s.Data.TimedOutIn = State_SwapOutSender_AwaitAgreement
+		}, OutcomeTimeout, true},
+	} {
+		swap := getFeeBumpTestSwap(getTestSetup("alice"), tc.swapType, tc.role, tc.state)
+		if tc.setup != nil {
+			tc.setup(swap)
+		}
+		outcome, ok := swapOutcome(swap)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		assert.Equal(t, tc.outcome, outcome, tc.name)
+	}
+}
+
+func Test_PeerReputation(t *testing.T) {
+	services := getSwapServices(make(chan PeerMessage))
+	dummy := services.policy.(*dummyPolicy)
+	dummy.reputationPolicy = policy.ReputationPolicy{WindowDays: 30, LimitScore: 80, LimitedMaxSwapsPerDay: 1, SuspiciousScore: 50}
+
+	_, err := services.getPeerReputation("bob")
+	assert.ErrorIs(t, err, ErrReputationNotSupported)
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "swaps"), 0700, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	store, err := NewBboltStore(db)
+	require.NoError(t, err)
+	services.swapStore = store
+	var suspicious []*SuspiciousPeerEvent
+	services.events.AddSuspiciousPeerHandler(func(e *SuspiciousPeerEvent) {
+		suspicious = append(suspicious, e)
+	})
+
+	finish := func(swapType SwapType, role SwapRole, state StateType, finishedAt time.Time) *SwapStateMachine {
+		swapId := NewSwapId()
+		swap := &SwapStateMachine{
+			SwapId:  swapId,
+			Type:    swapType,
+			Role:    role,
+			Current: state,
+			Data: &SwapData{
+				PeerNodeId:     "bob",
+				FinishedAt:     finishedAt.Unix(),
+				SwapOutRequest: &SwapOutRequestMessage{SwapId: swapId, Network: "mainnet", Amount: 100000},
+			},
+		}
+		services.recordOutcome(swap)
+		return swap
+	}
+
+	now := time.Now()
+	reputation, err := services.getPeerReputation("bob")
+	require.NoError(t, err)
+	assert.EqualValues(t, MaxReputationScore, reputation.Score)
+	assert.Empty(t, reputation.History)
+
+	// Outcomes outside of the window are only part of the history.
+	finish(SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCsv, now.Add(-31*24*time.Hour))
+	finish(SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedPreimage, now.Add(-time.Hour))
+	last := finish(SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCsv, now)
+	reputation, err = services.getPeerReputation("bob")
+	require.NoError(t, err)
+	assert.EqualValues(t, 75, reputation.Score)
+	assert.True(t, reputation.Limited)
+	assert.EqualValues(t, 1, reputation.Successes)
+	assert.EqualValues(t, 1, reputation.CsvClaims)
+	require.Len(t, reputation.History, 3)
+	assert.Equal(t, last.SwapId.String(), reputation.History[0].SwapId)
+	assert.Empty(t, dummy.suspiciousPeers)
+
+	// Limited peers get the limits of the reputation policy.
+	request := &SwapData{
+		PeerNodeId:     "bob",
+		SwapOutRequest: &SwapOutRequestMessage{SwapId: NewSwapId(), Network: "mainnet", Amount: 100000},
+	}
+	addLimitsTestSwap(t, services, "bob", 100000, State_ClaimedPreimage, now.Add(-time.Hour), true)
+	assert.Equal(t, RequestLimitError{Peer: "bob", Max: 1, Limit: "swaps per day"}, checkRequestLimits(services, request))
+
+	// Recording the same swap twice does not count it twice.
+	services.recordOutcome(last)
+	reputation, err = services.getPeerReputation("bob")
+	require.NoError(t, err)
+	assert.EqualValues(t, 75, reputation.Score)
+
+	finish(SWAPTYPE_IN, SWAPROLE_SENDER, State_ClaimedCsv, now)
+	assert.Empty(t, dummy.suspiciousPeers)
+	coop := finish(SWAPTYPE_IN, SWAPROLE_SENDER, State_ClaimedCoop, now)
+	assert.Equal(t, []string{"bob"}, dummy.suspiciousPeers)
+	require.Len(t, suspicious, 1)
+	assert.Equal(t, coop.SwapId.String(), suspicious[0].SwapId)
+	assert.Equal(t, "reputation score 40 is below 50", suspicious[0].Reason)
+
+	reputation, err = services.getPeerReputation("bob")
+	require.NoError(t, err)
+	assert.EqualValues(t, 40, reputation.Score)
+	assert.EqualValues(t, 1, reputation.UnpaidClaimInvoices)
+}
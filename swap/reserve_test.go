@@ -0,0 +1,193 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/elementsproject/peerswap/messages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckOnchainReserve(t *testing.T) {
+	msgChan := make(chan PeerMessage)
+	services := getSwapServices(msgChan)
+	services.policy.(*dummyPolicy).reserveOnchainMsat = map[string]uint64{"btc": 100000500}
+
+	// The reserve is rounded up to full sats.
+	assert.NoError(t, services.checkOnchainReserve("btc", 100100000, 99999999))
+	err := services.checkOnchainReserve("btc", 100100000, 100000000)
+	assert.Equal(t, ReserveOnchainError{Asset: "btc", BalanceSat: 100100000, AmountSat: 100000000, ReserveSat: 100001}, err)
+	assert.NoError(t, services.checkOnchainReserve("lbtc", 200000000, 200000000))
+}
+
+func Test_SwapIn_OnchainReserve(t *testing.T) {
+	const node = "alice"
+	_, peer, _, _, chanId := getTestParams()
+
+	swapService := getTestSetup(node)
+	swapService.swapServices.policy.(*dummyPolicy).reserveOnchainMsat = map[string]uint64{"btc": 5000000000}
+
+	// The dummy wallet holds 10000000 sat and asks 100 sat opening fee.
+	_, err := swapService.SwapIn(peer, "btc", chanId, node, 5000000, 0)
+	var reserveErr ReserveOnchainError
+	require.ErrorAs(t, err, &reserveErr)
+	assert.Equal(t, ReserveOnchainError{Asset: "btc", BalanceSat: 10000000, AmountSat: 5000100, ReserveSat: 5000000}, reserveErr)
+}
+
+func Test_ExcludedChannel(t *testing.T) {
+	const node = "alice"
+	_, peer, _, _, chanId := getTestParams()
+
+	swapService := getTestSetup(node)
+	swapService.swapServices.policy.(*dummyPolicy).excludedScids = []string{chanId}
+
+	_, err := swapService.SwapOut(peer, "btc", chanId, node, 100000, 0)
+	assert.ErrorIs(t, err, ChannelExcludedError(chanId))
+	_, err = swapService.SwapIn(peer, "btc", chanId, node, 100000, 0)
+	assert.ErrorIs(t, err, ChannelExcludedError(chanId))
+}
+
+func Test_SwapOutReceiver_ExcludedChannel(t *testing.T) {
+	swapAmount := uint64(100000)
+	swapId := NewSwapId()
+	_, peer, _, _, chanId := getTestParams()
+
+	msgChan := make(chan PeerMessage)
+	swapServices := getSwapServices(msgChan)
+	swapServices.policy.(*dummyPolicy).excludedScids = []string{chanId}
+
+	swapFSM := newSwapOutReceiverFSM(swapId, swapServices, peer)
+	_, err := swapFSM.SendEvent(Event_OnSwapOutRequestReceived, &SwapOutRequestMessage{
+		Amount:          swapAmount,
+		Scid:            chanId,
+		SwapId:          swapId,
+		Pubkey:          peer,
+		Network:         "mainnet",
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+	})
+	require.NoError(t, err)
+
+	msg := <-msgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
+	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
+	assert.Equal(t, ChannelExcludedError(chanId).Error(), swapFSM.Data.CancelMessage)
+}
+
+func Test_SwapOutReceiver_OnchainReserve(t *testing.T) {
+	swapAmount := uint64(100000)
+	swapId := NewSwapId()
+	_, peer, _, _, chanId := getTestParams()
+
+	msgChan := make(chan PeerMessage)
+	swapServices := getSwapServices(msgChan)
+	swapServices.policy.(*dummyPolicy).reserveOnchainMsat = map[string]uint64{"btc": 100000000000}
+
+	swapFSM := newSwapOutReceiverFSM(swapId, swapServices, peer)
+	_, err := swapFSM.SendEvent(Event_OnSwapOutRequestReceived, &SwapOutRequestMessage{
+		Amount:          swapAmount,
+		Scid:            chanId,
+		SwapId:          swapId,
+		Pubkey:          peer,
+		Network:         "mainnet",
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+	})
+	require.NoError(t, err)
+
+	msg := <-msgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
+	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
+	assert.Contains(t, swapFSM.Data.CancelMessage, "on-chain reserve of 100000000 sat")
+}
+
+func Test_ReservedOnchainSat(t *testing.T) {
+	swapService := getTestSetup("alice")
+	add := func(swapType SwapType, role SwapRole, request interface{}, openingTxHex string) {
+		swap := &SwapStateMachine{SwapId: NewSwapId(), Type: swapType, Role: role, Data: &SwapData{OpeningTxHex: openingTxHex}}
+		switch r := request.(type) {
+		case *SwapOutRequestMessage:
+			swap.Data.SwapOutRequest = r
+		case *SwapInRequestMessage:
+			swap.Data.SwapInRequest = r
+		}
+		require.NoError(t, swapService.lockSwap(swap.SwapId.String(), swap.SwapId.String(), swap))
+	}
+
+	// We fund the opening transaction of swap-outs that we receive and of
+	// swap-ins that we send.
+	add(SWAPTYPE_OUT, SWAPROLE_RECEIVER, &SwapOutRequestMessage{Network: "mainnet", Amount: 100000}, "")
+	add(SWAPTYPE_IN, SWAPROLE_SENDER, &SwapInRequestMessage{Network: "mainnet", Amount: 200000}, "")
+	add(SWAPTYPE_IN, SWAPROLE_SENDER, &SwapInRequestMessage{Asset: "lbtc", Amount: 400000}, "")
+	add(SWAPTYPE_OUT, SWAPROLE_SENDER, &SwapOutRequestMessage{Network: "mainnet", Amount: 800000}, "")
+	add(SWAPTYPE_OUT, SWAPROLE_RECEIVER, &SwapOutRequestMessage{Network: "mainnet", Amount: 1600000}, "txhex")
+
+	assert.EqualValues(t, 300000, swapService.ReservedOnchainSat("btc"))
+	assert.EqualValues(t, 400000, swapService.ReservedOnchainSat("lbtc"))
+
+	// The send has to pay its fee out of the unreserved balance as well.
+	assert.NoError(t, swapService.CheckSpendable("btc", 1000000, 699900))
+	err := swapService.CheckSpendable("btc", 1000000, 700000)
+	assert.Equal(t, ReservedFundsError{Asset: "btc", BalanceSat: 1000000, AmountSat: 700000, FeeSat: 100, ReservedSat: 300000}, err)
+	assert.Error(t, swapService.CheckSpendable("btc", 200000, 1))
+}
+
+func Test_ReservedOnchainSat_Concurrent(t *testing.T) {
+	swapService := getTestSetup("alice")
+	swap := &SwapStateMachine{SwapId: NewSwapId(), Type: SWAPTYPE_IN, Role: SWAPROLE_SENDER, Data: &SwapData{
+		SwapInRequest: &SwapInRequestMessage{Network: "mainnet", Amount: 100000},
+	}}
+	require.NoError(t, swapService.lockSwap(swap.SwapId.String(), swap.SwapId.String(), swap))
+
+	// The opening transaction is created while the reserved funds are read,
+	// the state machine updates the reservation with the swap data.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		swap.mutex.Lock()
+		defer swap.mutex.Unlock()
+		swap.Data.OpeningTxHex = "txhex"
+		swapService.swapServices.reservations.update(swap)
+	}()
+	reserved := swapService.ReservedOnchainSat("btc")
+	assert.Contains(t, []uint64{0, 100000}, reserved)
+	<-done
+	assert.EqualValues(t, 0, swapService.ReservedOnchainSat("btc"))
+}
+
+// Test_SwapOutReceiver_ReservedFunds checks that a swap-out request is
+// canceled if the funds that other swaps reserved leave too little for the
+// reserve.
+func Test_SwapOutReceiver_ReservedFunds(t *testing.T) {
+	_, peer, _, _, chanId := getTestParams()
+
+	msgChan := make(chan PeerMessage)
+	swapServices := getSwapServices(msgChan)
+	// The dummy wallet holds 1000000 sat.
+	swapServices.policy.(*dummyPolicy).reserveOnchainMsat = map[string]uint64{"btc": 400000000}
+
+	other := &SwapStateMachine{SwapId: NewSwapId(), Type: SWAPTYPE_IN, Role: SWAPROLE_SENDER, Data: &SwapData{
+		SwapInRequest: &SwapInRequestMessage{Network: "mainnet", Amount: 500000},
+	}}
+	swapServices.reservations.update(other)
+
+	swapId := NewSwapId()
+	swapFSM := newSwapOutReceiverFSM(swapId, swapServices, peer)
+	_, err := swapFSM.SendEvent(Event_OnSwapOutRequestReceived, &SwapOutRequestMessage{
+		Amount:          200000,
+		Scid:            chanId,
+		SwapId:          swapId,
+		Pubkey:          peer,
+		Network:         "mainnet",
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+	})
+	require.NoError(t, err)
+
+	msg := <-msgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
+	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
+	assert.Equal(t, CancelCode_InsufficientBalance, swapFSM.Data.GetCancelCode())
+	assert.EqualValues(t, 500000, swapServices.reservations.reservedSat("btc"))
+
+	// The swap that is funded does not reserve funds from itself.
+	assert.NoError(t, swapServices.checkWalletReserve("btc", 150000, other.SwapId.String()))
+	assert.Error(t, swapServices.checkWalletReserve("btc", 150000))
+}
@@ -15,6 +15,12 @@ import (
 
 const (
 	PEERSWAP_PROTOCOL_VERSION = 3
+
+	// PEERSWAP_PROTOCOL_VERSION_TAPROOT is the protocol version of bitcoin
+	// swaps with a taproot opening output. The cooperative close is a
+	// MuSig2 key path spend, the preimage and the csv claim are script path
+	// spends.
+	PEERSWAP_PROTOCOL_VERSION_TAPROOT = 4
 )
 
 var (
@@ -46,6 +52,55 @@ func (s PeerIsSuspiciousError) Error() string {
 	return fmt.Sprintf("peer %s is on suspicious peer list", string(s))
 }
 
+// PremiumLimitExceededError is returned if the premium that the swap peer asks
+// for is higher than the premium we are willing to pay.
+type PremiumLimitExceededError struct {
+	Premium    uint64
+	MaxPremium uint64
+}
+
+func (e PremiumLimitExceededError) Error() string {
+	return fmt.Sprintf("premium of %d sat exceeds the maximum premium of %d sat", e.Premium, e.MaxPremium)
+}
+
+// ChannelExcludedError is returned if the policy does not allow swaps on the
+// channel.
+type ChannelExcludedError string
+
+func (e ChannelExcludedError) Error() string {
+	return fmt.Sprintf("swaps are not allowed on channel %s", string(e))
+}
+
+// ReserveOnchainError is returned if the opening transaction of a swap would
+// spend the on-chain reserve of the wallet.
+type ReserveOnchainError struct {
+	Asset      string
+	BalanceSat uint64
+	// AmountSat is the swap amount plus the opening fee.
+	AmountSat  uint64
+	ReserveSat uint64
+}
+
+func (e ReserveOnchainError) Error() string {
+	return fmt.Sprintf("%s wallet balance of %d sat does not cover %d sat for the swap and the on-chain reserve of %d sat",
+		e.Asset, e.BalanceSat, e.AmountSat, e.ReserveSat)
+}
+
+// ReservedFundsError is returned if a payment from the wallet would spend
+// the funds that pending swaps need for their opening transactions.
+type ReservedFundsError struct {
+	Asset       string
+	BalanceSat  uint64
+	AmountSat   uint64
+	FeeSat      uint64
+	ReservedSat uint64
+}
+
+func (e ReservedFundsError) Error() string {
+	return fmt.Sprintf("confirmed %s wallet balance of %d sat does not cover %d sat, the estimated fee of %d sat and the %d sat reserved by pending swaps",
+		e.Asset, e.BalanceSat, e.AmountSat, e.FeeSat, e.ReservedSat)
+}
+
 func ErrReceivedMessageFromUnexpectedPeer(peerId string, swapId *SwapId) error {
 	return fmt.Errorf("received a message from an unexpected peer, peerId: %s, swapId: %s", peerId, swapId.String())
 }
@@ -75,6 +130,7 @@ func NewSwapService(services *SwapServices) *SwapService {
 // Start adds callback to the messenger, txwatcher services and lightning client
 func (s *SwapService) Start() error {
 	s.swapServices.toService = newTimeOutService(s.createTimeoutCallback)
+	s.swapServices.batchService = newOpeningTxBatchService(openingTxBatchTimeout, s.fundOpeningTxBatch)
 	s.swapServices.messenger.AddMessageHandler(s.OnMessageReceived)
 
 	if s.LiquidEnabled {
@@ -364,8 +420,15 @@ func (s *SwapService) OnCsvPassed(swapId string) error {
 }
 
 // todo move wallet and chain / channel validation logic here
-// SwapOut starts a new swap out process
-func (s *SwapService) SwapOut(peer string, chain string, channelId string, initiator string, amtSat uint64) (*SwapStateMachine, error) {
+// SwapOut starts a new swap out process. The swap is canceled if the peer asks
+// for a premium higher than maxPremium.
+func (s *SwapService) SwapOut(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*SwapStateMachine, error) {
+	return s.swapOut(peer, chain, channelId, initiator, amtSat, maxPremium, "", 0)
+}
+
+// swapOut starts a new swap out process that is part of the batch with the
+// given batchId. An empty batchId starts a swap out without a batch.
+func (s *SwapService) swapOut(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64, batchId string, batchSize uint32) (*SwapStateMachine, error) {
 	if !s.swapServices.policy.NewSwapsAllowed() {
 		return nil, fmt.Errorf("swaps are disabled")
 	}
@@ -374,8 +437,12 @@ func (s *SwapService) SwapOut(peer string, chain string, channelId string, initi
 		return nil, PeerIsSuspiciousError(peer)
 	}
 
-	if amtSat*1000 < s.swapServices.policy.GetMinSwapAmountMsat() {
-		return nil, ErrMinimumSwapSize(s.swapServices.policy.GetMinSwapAmountMsat())
+	if minMsat := s.swapServices.policy.GetPeerMinSwapAmountMsat(peer); amtSat*1000 < minMsat {
+		return nil, ErrMinimumSwapSize(minMsat)
+	}
+
+	if s.swapServices.policy.IsChannelExcluded(channelId) {
+		return nil, ChannelExcludedError(channelId)
 	}
 
 	err := s.swapServices.lightning.CanSpend(amtSat * 1000)
@@ -391,7 +458,14 @@ func (s *SwapService) SwapOut(peer string, chain string, channelId string, initi
 		return nil, fmt.Errorf("exceeding spendable amount_msat: %d", sp)
 	}
 
+	version, err := s.swapServices.protocolVersion(peer)
+	if err != nil {
+		return nil, err
+	}
+
 	swap := newSwapOutSenderFSM(s.swapServices, initiator, peer)
+	swap.Data.MaxPremium = maxPremium
+	swap.Data.ProtocolVersion = version
 	err = s.lockSwap(swap.SwapId.String(), channelId, swap)
 	if err != nil {
 		return nil, err
@@ -408,7 +482,7 @@ func (s *SwapService) SwapOut(peer string, chain string, channelId string, initi
 	}
 
 	request := &SwapOutRequestMessage{
-		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+		ProtocolVersion: version,
 		SwapId:          swap.SwapId,
 		Asset:           elementsAsset,
 		Network:         bitcoinNetwork,
@@ -416,6 +490,10 @@ func (s *SwapService) SwapOut(peer string, chain string, channelId string, initi
 		Amount:          amtSat,
 		Pubkey:          hex.EncodeToString(swap.Data.GetPrivkey().PubKey().SerializeCompressed()),
 	}
+	if batchId != "" {
+		request.BatchId = batchId
+		request.BatchSize = batchSize
+	}
 
 	done, err := swap.SendEvent(Event_OnSwapOutStarted, request)
 	if err != nil {
@@ -429,8 +507,9 @@ func (s *SwapService) SwapOut(peer string, chain string, channelId string, initi
 }
 
 // todo check prerequisites
-// SwapIn starts a new swap in process
-func (s *SwapService) SwapIn(peer string, chain string, channelId string, initiator string, amtSat uint64) (*SwapStateMachine, error) {
+// SwapIn starts a new swap in process. The swap is canceled if the peer asks
+// for a premium higher than maxPremium.
+func (s *SwapService) SwapIn(peer string, chain string, channelId string, initiator string, amtSat uint64, maxPremium uint64) (*SwapStateMachine, error) {
 	if !s.swapServices.policy.NewSwapsAllowed() {
 		return nil, fmt.Errorf("swaps are disabled")
 	}
@@ -439,8 +518,12 @@ func (s *SwapService) SwapIn(peer string, chain string, channelId string, initia
 		return nil, PeerIsSuspiciousError(peer)
 	}
 
-	if amtSat*1000 < s.swapServices.policy.GetMinSwapAmountMsat() {
-		return nil, ErrMinimumSwapSize(s.swapServices.policy.GetMinSwapAmountMsat())
+	if minMsat := s.swapServices.policy.GetPeerMinSwapAmountMsat(peer); amtSat*1000 < minMsat {
+		return nil, ErrMinimumSwapSize(minMsat)
+	}
+
+	if s.swapServices.policy.IsChannelExcluded(channelId) {
+		return nil, ChannelExcludedError(channelId)
 	}
 
 	err := s.swapServices.lightning.CanSpend(amtSat * 1000)
@@ -461,6 +544,10 @@ func (s *SwapService) SwapIn(peer string, chain string, channelId string, initia
 	if amtSat > maximumSwapAmountSat {
 		return nil, fmt.Errorf("exceeding maximum swap amount: %d", maximumSwapAmountSat)
 	}
+	err = s.swapServices.checkWalletReserve(chain, amtSat)
+	if err != nil {
+		return nil, err
+	}
 	var bitcoinNetwork string
 	var elementsAsset string
 	if chain == l_btc_chain {
@@ -470,14 +557,21 @@ func (s *SwapService) SwapIn(peer string, chain string, channelId string, initia
 	} else {
 		return nil, errors.New("invalid chain")
 	}
+	version, err := s.swapServices.protocolVersion(peer)
+	if err != nil {
+		return nil, err
+	}
+
 	swap := newSwapInSenderFSM(s.swapServices, initiator, peer)
+	swap.Data.MaxPremium = maxPremium
+	swap.Data.ProtocolVersion = version
 	err = s.lockSwap(swap.SwapId.String(), channelId, swap)
 	if err != nil {
 		return nil, err
 	}
 
 	request := &SwapInRequestMessage{
-		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+		ProtocolVersion: version,
 		SwapId:          swap.SwapId,
 		Asset:           elementsAsset,
 		Network:         bitcoinNetwork,
@@ -539,6 +633,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_InternalError,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -551,6 +646,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_InternalError,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -563,6 +659,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_InsufficientBalance,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -575,6 +672,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_ProbeFailed,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -584,6 +682,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: "The prepayment probe was unsuccessful." + failureReason,
+			Code:    CancelCode_ProbeFailed,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -598,6 +697,7 @@ func (s *SwapService) OnSwapInRequestReceived(swapId *SwapId, peerId string, mes
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: err.Error(),
+			Code:    CancelCodeFromError(err),
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -619,6 +719,7 @@ func (s *SwapService) OnSwapOutRequestReceived(swapId *SwapId, peerId string, me
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_InternalError,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -631,6 +732,7 @@ func (s *SwapService) OnSwapOutRequestReceived(swapId *SwapId, peerId string, me
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: msg,
+			Code:    CancelCode_InsufficientBalance,
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -644,6 +746,7 @@ func (s *SwapService) OnSwapOutRequestReceived(swapId *SwapId, peerId string, me
 		msgBytes, msgType, err := MarshalPeerswapMessage(&CancelMessage{
 			SwapId:  swapId,
 			Message: err.Error(),
+			Code:    CancelCodeFromError(err),
 		})
 		s.swapServices.messenger.SendMessage(peerId, msgBytes, msgType)
 		return err
@@ -846,6 +949,18 @@ func (s *SwapService) GetSwap(swapId string) (*SwapStateMachine, error) {
 	return s.swapServices.swapStore.GetData(swapId)
 }
 
+// OnSuspiciousPeer registers a handler that is called when a swap makes us
+// add its peer to the suspicious peer list. The handler must not block.
+func (s *SwapService) OnSuspiciousPeer(handler func(*SuspiciousPeerEvent)) {
+	s.swapServices.events.AddSuspiciousPeerHandler(handler)
+}
+
+// SubscribeSwapEvents subscribes to the state changes of all swaps. The events
+// after the since timestamp are replayed, see SwapEventHub.Subscribe.
+func (s *SwapService) SubscribeSwapEvents(since int64) ([]*SwapEvent, <-chan *SwapEvent, func()) {
+	return s.swapServices.events.Subscribe(since)
+}
+
 func (s *SwapService) ResendLastMessage(swapId string) error {
 	swap, err := s.GetActiveSwap(swapId)
 	if err != nil {
@@ -876,6 +991,56 @@ func (s *SwapService) ListActiveSwaps() ([]*SwapStateMachine, error) {
 	return activeSwaps, nil
 }
 
+// ReservedOnchainSat returns the amount in sat of the opening transactions
+// that the active swaps on the asset still fund from our wallet. The funds
+// leave the wallet once the opening transaction is created.
+func (s *SwapService) ReservedOnchainSat(asset string) uint64 {
+	return s.swapServices.reservations.reservedSat(asset)
+}
+
+// CheckSpendable returns a ReservedFundsError if sending amountSat from the
+// wallet of the asset would spend the funds that the active swaps reserved.
+// The fee of the send is estimated as the flat opening transaction fee, as
+// both spend wallet inputs to a single output and change.
+func (s *SwapService) CheckSpendable(asset string, confirmedSat, amountSat uint64) error {
+	_, wallet, _, err := s.swapServices.getOnChainServices(asset)
+	if err != nil {
+		return err
+	}
+	feeSat, err := wallet.GetFlatOpeningTXFee()
+	if err != nil {
+		return err
+	}
+	reserved := s.ReservedOnchainSat(asset)
+	if confirmedSat < reserved || amountSat+feeSat > confirmedSat-reserved {
+		return ReservedFundsError{Asset: asset, BalanceSat: confirmedSat, AmountSat: amountSat, FeeSat: feeSat, ReservedSat: reserved}
+	}
+	return nil
+}
+
+// EstimatedOnchainFeeSat returns the estimated on-chain fees that we pay as
+// the initiator of a swap of the type on the asset. That is the opening fee on
+// a swap-in, and the opening fee of the fee invoice and the claim fee on a
+// swap-out.
+func (s *SwapService) EstimatedOnchainFeeSat(asset string, swapType SwapType) (uint64, error) {
+	_, wallet, _, err := s.swapServices.getOnChainServices(asset)
+	if err != nil {
+		return 0, err
+	}
+	feeSat, err := wallet.GetFlatOpeningTXFee()
+	if err != nil {
+		return 0, err
+	}
+	if swapType == SWAPTYPE_OUT {
+		claimFeeSat, err := wallet.GetRefundFee()
+		if err != nil {
+			return 0, err
+		}
+		feeSat += claimFeeSat
+	}
+	return feeSat, nil
+}
+
 // GetActiveSwap returns the active swap, or an error if it does not exist
 func (s *SwapService) GetActiveSwap(swapId string) (*SwapStateMachine, error) {
 	s.RLock()
@@ -892,6 +1057,7 @@ func (s *SwapService) RemoveActiveSwap(swapId string) {
 	defer s.Unlock()
 	delete(s.lastMsgLog, swapId)
 	delete(s.activeSwaps, swapId)
+	s.swapServices.reservations.remove(swapId)
 }
 
 // lockSwap locks in a swap. This function ensures that we only have one active
@@ -910,6 +1076,7 @@ func (s *SwapService) lockSwap(swapId, channelId string, fsm *SwapStateMachine)
 
 	// Add active swap
 	s.activeSwaps[swapId] = fsm
+	s.swapServices.reservations.update(fsm)
 	return nil
 }
 
@@ -40,7 +40,7 @@ func Test_GoodCase(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	aliceSwap, err := aliceSwapService.SwapOut(peer, btc_chain, channelId, initiator, amount)
+	aliceSwap, err := aliceSwapService.SwapOut(peer, btc_chain, channelId, initiator, amount, 0)
 	if err != nil {
 		t.Fatalf(" error swapping oput %v: ", err)
 	}
@@ -97,7 +97,7 @@ func Test_FeePaymentFailed(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount)
+	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount, 0)
 	if err != nil {
 		t.Fatalf(" error swapping oput %v: ", err)
 	}
@@ -115,6 +115,267 @@ func Test_FeePaymentFailed(t *testing.T) {
 	assert.Equal(t, messages.MESSAGETYPE_CANCELED, bobReceivedMsg)
 	assert.Equal(t, State_SwapCanceled, bobSwap.Current)
 }
+
+// Test_SwapOut_PremiumExceedsMaxPremium checks that the swap-out sender cancels
+// the swap if the premium in the agreement exceeds its maximum premium.
+func Test_SwapOut_PremiumExceedsMaxPremium(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, channelId := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+
+	// Bob asks for a premium that is higher than what alice wants to pay.
+	bobSwapService.swapServices.policy.(*dummyPolicy).getPremiumReturn = 50
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount, 10)
+	require.NoError(t, err)
+
+	bobReceivedMsg := <-bobMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTREQUEST, bobReceivedMsg)
+	bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+
+	aliceReceivedMsg := <-aliceMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTAGREEMENT, aliceReceivedMsg)
+	assert.Equal(t, State_SwapCanceled, aliceSwap.Current)
+	assert.ErrorIs(t, aliceSwap.Data.LastErr, PremiumLimitExceededError{Premium: 50, MaxPremium: 10})
+
+	bobReceivedMsg = <-bobMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, bobReceivedMsg)
+	assert.Equal(t, State_SwapCanceled, bobSwap.Current)
+}
+
+// Test_SwapOut_PremiumAccepted checks that the swap-out receiver funds the
+// swap if the swap-out sender accepts its premium.
+func Test_SwapOut_PremiumAccepted(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, channelId := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+
+	bobSwapService.swapServices.policy.(*dummyPolicy).getPremiumReturn = 50
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount, 50)
+	require.NoError(t, err)
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTREQUEST, <-bobMsgChan)
+	bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTAGREEMENT, <-aliceMsgChan)
+	assert.Equal(t, State_SwapOutSender_AwaitTxBroadcastedMessage, aliceSwap.Current)
+	assert.EqualValues(t, 50, bobSwap.Data.GetPremium())
+
+	bobSwapService.swapServices.lightning.(*dummyLightningClient).TriggerPayment(bobSwap.SwapId.String(), INVOICE_FEE)
+	assert.Equal(t, State_SwapOutReceiver_AwaitClaimInvoicePayment, bobSwap.Current)
+	assert.Nil(t, bobSwap.Data.LastErr)
+
+	assert.Equal(t, messages.MESSAGETYPE_OPENINGTXBROADCASTED, <-aliceMsgChan)
+	assert.Equal(t, State_SwapOutSender_AwaitTxConfirmation, aliceSwap.Current)
+}
+
+// Test_BatchSwapOut checks that the swap-out receiver funds all swap-outs of a
+// batch in a single opening transaction.
+func Test_BatchSwapOut(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, _ := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwaps, err := aliceSwapService.BatchSwapOut(btc_chain, initiator, []*BatchSwapOutRequest{
+		{PeerId: peer, ChannelId: "100x2x3", AmtSat: amount},
+		{PeerId: peer, ChannelId: "100x2x4", AmtSat: amount},
+	}, 0)
+	require.NoError(t, err)
+	require.Len(t, aliceSwaps, 2)
+	assert.NotEmpty(t, aliceSwaps[0].Data.SwapOutRequest.BatchId)
+	assert.Equal(t, aliceSwaps[0].Data.SwapOutRequest.BatchId, aliceSwaps[1].Data.SwapOutRequest.BatchId)
+
+	for range aliceSwaps {
+		assert.Equal(t, messages.MESSAGETYPE_SWAPOUTREQUEST, <-bobMsgChan)
+		assert.Equal(t, messages.MESSAGETYPE_SWAPOUTAGREEMENT, <-aliceMsgChan)
+	}
+
+	var bobSwaps []*SwapStateMachine
+	for _, aliceSwap := range aliceSwaps {
+		bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+		require.NoError(t, err)
+		bobSwaps = append(bobSwaps, bobSwap)
+	}
+
+	// The first swap waits for the rest of the batch.
+	bobSwapService.swapServices.lightning.(*dummyLightningClient).TriggerPayment(bobSwaps[0].SwapId.String(), INVOICE_FEE)
+	assert.Equal(t, State_SwapOutReceiver_BroadcastOpeningTx, bobSwaps[0].Current)
+	bobSwapService.swapServices.lightning.(*dummyLightningClient).TriggerPayment(bobSwaps[1].SwapId.String(), INVOICE_FEE)
+
+	for range aliceSwaps {
+		assert.Equal(t, messages.MESSAGETYPE_OPENINGTXBROADCASTED, <-aliceMsgChan)
+	}
+
+	var vouts []uint32
+	for _, bobSwap := range bobSwaps {
+		assert.True(t, bobSwap.WaitForStateChange(func(st StateType) bool {
+			return st == State_SwapOutReceiver_AwaitClaimInvoicePayment
+		}, time.Second))
+		assert.Equal(t, bobSwaps[0].Data.GetOpeningTxId(), bobSwap.Data.GetOpeningTxId())
+		assert.Nil(t, bobSwap.Data.BatchOpening)
+		vouts = append(vouts, bobSwap.Data.OpeningTxBroadcasted.ScriptOut)
+	}
+	assert.ElementsMatch(t, []uint32{0, 1}, vouts)
+
+	chain := bobSwapService.swapServices.bitcoinWallet.(*dummyChain)
+	assert.EqualValues(t, 1, chain.calledPrepareBatchOpeningTransaction)
+	assert.EqualValues(t, 1, chain.calledPublishBatchOpeningTransaction)
+	assert.EqualValues(t, 0, chain.calledCreateOpeningTransaction)
+	require.Len(t, chain.openingParams, 2)
+	for i, bobSwap := range bobSwaps {
+		assert.Equal(t, bobSwap.Data.GetOpeningParams().Taproot, chain.openingParams[i].Taproot)
+	}
+
+	// Both swaps paid the flat opening tx fee of 100 sat but only have to pay
+	// their share of the 150 sat batch opening tx fee.
+	assert.Equal(t, []uint64{99975 * 1000, 99975 * 1000}, bobSwapService.swapServices.lightning.(*dummyLightningClient).claimMsatAmounts)
+}
+
+// Test_BatchSwapOut_RecoverBatchOpening checks that a swap that recorded its
+// batch opening tx but was interrupted before it was published publishes the
+// recorded tx again instead of funding a new opening output.
+func Test_BatchSwapOut_RecoverBatchOpening(t *testing.T) {
+	_, peer, _, _, _ := getTestParams()
+	swapService := getTestSetup(peer)
+	chain := swapService.swapServices.bitcoinWallet.(*dummyChain)
+
+	tx := &BatchOpeningTx{
+		TxId:      getRandom32ByteHexString(),
+		TxHex:     "txhex",
+		Fee:       150,
+		Addresses: []string{"address", "address"},
+		Vouts:     []uint32{0, 1},
+	}
+	swapId := NewSwapId()
+	data := &SwapData{
+		SwapOutRequest: &SwapOutRequestMessage{
+			SwapId:    swapId,
+			Network:   btc_chain,
+			Amount:    100000,
+			BatchId:   getRandom32ByteHexString(),
+			BatchSize: 2,
+		},
+		BatchOpening: &BatchOpening{
+			Tx:             tx,
+			Index:          1,
+			ClaimPreimage:  "preimage",
+			Payreq:         "claim",
+			StartingHeight: 10,
+		},
+	}
+
+	action := &JoinOpeningTxBatchWrapperAction{next: &CreateAndBroadcastOpeningTransaction{}}
+	assert.Equal(t, Event_ActionSucceeded, action.Execute(swapService.swapServices, data))
+
+	assert.EqualValues(t, 1, chain.calledPublishBatchOpeningTransaction)
+	assert.EqualValues(t, 0, chain.calledPrepareBatchOpeningTransaction)
+	assert.EqualValues(t, 0, chain.calledCreateOpeningTransaction)
+	assert.Nil(t, data.BatchOpening)
+	require.NotNil(t, data.OpeningTxBroadcasted)
+	assert.Equal(t, tx.TxId, data.OpeningTxBroadcasted.TxId)
+	assert.EqualValues(t, 1, data.OpeningTxBroadcasted.ScriptOut)
+	assert.Equal(t, "claim", data.OpeningTxBroadcasted.Payreq)
+	assert.Equal(t, "preimage", data.ClaimPreimage)
+	assert.Equal(t, "txhex", data.OpeningTxHex)
+	assert.EqualValues(t, 10, data.StartingBlockHeight)
+}
+
+// Test_SwapIn_PremiumExceedsMaxPremium checks that the swap-in sender cancels
+// the swap before broadcasting the opening transaction if the premium in the
+// agreement exceeds its maximum premium.
+func Test_SwapIn_PremiumExceedsMaxPremium(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, channelId := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+
+	// Bob asks for a premium that is higher than what alice wants to pay.
+	bobSwapService.swapServices.policy.(*dummyPolicy).getPremiumReturn = 50
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwap, err := aliceSwapService.SwapIn(peer, "btc", channelId, initiator, amount, 10)
+	require.NoError(t, err)
+
+	bobReceivedMsg := <-bobMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_SWAPINREQUEST, bobReceivedMsg)
+	bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+
+	aliceReceivedMsg := <-aliceMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_SWAPINAGREEMENT, aliceReceivedMsg)
+	assert.Equal(t, State_SwapCanceled, aliceSwap.Current)
+	assert.Nil(t, aliceSwap.Data.OpeningTxBroadcasted)
+	assert.ErrorIs(t, aliceSwap.Data.LastErr, PremiumLimitExceededError{Premium: 50, MaxPremium: 10})
+
+	bobReceivedMsg = <-bobMsgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, bobReceivedMsg)
+	assert.Equal(t, State_SwapCanceled, bobSwap.Current)
+}
+
 func Test_ClaimPaymentFailedCoopClose(t *testing.T) {
 	amount := uint64(100000)
 	initiator, peer, _, _, channelId := getTestParams()
@@ -138,7 +399,7 @@ func Test_ClaimPaymentFailedCoopClose(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount)
+	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount, 0)
 	if err != nil {
 		t.Fatalf(" error swapping oput %v: ", err)
 	}
@@ -176,6 +437,120 @@ func Test_ClaimPaymentFailedCoopClose(t *testing.T) {
 	assert.Equal(t, State_ClaimedCoop, bobSwap.Current)
 }
 
+// Test_Taproot_SwapOut checks that a bitcoin swap out of
+// PEERSWAP_PROTOCOL_VERSION_TAPROOT locks the funds in a taproot output that
+// both peers agree on.
+func Test_Taproot_SwapOut(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, channelId := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+	aliceSwapService.SetPeerTermsGetter(peerTermsMock{
+		peer: {ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, MaxProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT},
+	})
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwap, err := aliceSwapService.SwapOut(peer, btc_chain, channelId, initiator, amount, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION_TAPROOT, aliceSwap.Data.GetProtocolVersion())
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTREQUEST, <-bobMsgChan)
+	bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION_TAPROOT, bobSwap.Data.GetProtocolVersion())
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPOUTAGREEMENT, <-aliceMsgChan)
+	bobSwapService.swapServices.lightning.(*dummyLightningClient).TriggerPayment(bobSwap.SwapId.String(), INVOICE_FEE)
+	assert.Equal(t, State_SwapOutReceiver_AwaitClaimInvoicePayment, bobSwap.Current)
+	assert.Equal(t, messages.MESSAGETYPE_OPENINGTXBROADCASTED, <-aliceMsgChan)
+
+	bobChain := bobSwapService.swapServices.bitcoinWallet.(*dummyChain)
+	require.Len(t, bobChain.openingParams, 1)
+	assert.True(t, bobChain.openingParams[0].Taproot)
+	assert.Equal(t, bobSwap.Data.GetPaymentHash(), bobChain.openingParams[0].ClaimPaymentHash)
+
+	// trigger openingtx confirmed
+	err = aliceSwapService.swapServices.bitcoinTxWatcher.(*dummyChain).txConfirmedFunc(aliceSwap.SwapId.String(), aliceSwap.Data.OpeningTxHex, nil)
+	require.NoError(t, err)
+	assert.Equal(t, State_ClaimedPreimage, aliceSwap.Current)
+
+	aliceChain := aliceSwapService.swapServices.bitcoinValidator.(*dummyChain)
+	require.NotNil(t, aliceChain.validatedParams)
+	assert.True(t, aliceChain.validatedParams.Taproot)
+	assert.Equal(t, bobChain.openingParams[0].MakerPubkey, aliceChain.validatedParams.MakerPubkey)
+	assert.Equal(t, bobChain.openingParams[0].TakerPubkey, aliceChain.validatedParams.TakerPubkey)
+
+	// trigger bob payment received
+	bobSwapService.swapServices.lightning.(*dummyLightningClient).TriggerPayment(bobSwap.SwapId.String(), INVOICE_CLAIM)
+	assert.Equal(t, State_ClaimedPreimage, bobSwap.Current)
+}
+
+// Test_Taproot_SwapIn_CsvRefund checks that the maker of a bitcoin swap in of
+// PEERSWAP_PROTOCOL_VERSION_TAPROOT refunds its taproot output after the csv
+// passed.
+func Test_Taproot_SwapIn_CsvRefund(t *testing.T) {
+	amount := uint64(100000)
+	initiator, peer, _, _, channelId := getTestParams()
+
+	aliceSwapService := getTestSetup(initiator)
+	bobSwapService := getTestSetup(peer)
+	aliceSwapService.SetPeerTermsGetter(peerTermsMock{
+		peer: {ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, MaxProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT},
+	})
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).other = bobSwapService.swapServices.messenger.(*ConnectedMessenger)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).other = aliceSwapService.swapServices.messenger.(*ConnectedMessenger)
+
+	aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+	bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan = make(chan messages.MessageType)
+
+	aliceMsgChan := aliceSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+	bobMsgChan := bobSwapService.swapServices.messenger.(*ConnectedMessenger).msgReceivedChan
+
+	err := aliceSwapService.Start()
+	require.NoError(t, err)
+	err = bobSwapService.Start()
+	require.NoError(t, err)
+
+	aliceSwap, err := aliceSwapService.SwapIn(peer, btc_chain, channelId, initiator, amount, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION_TAPROOT, aliceSwap.Data.GetProtocolVersion())
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPINREQUEST, <-bobMsgChan)
+	bobSwap, err := bobSwapService.GetActiveSwap(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+
+	assert.Equal(t, messages.MESSAGETYPE_SWAPINAGREEMENT, <-aliceMsgChan)
+	assert.Equal(t, messages.MESSAGETYPE_OPENINGTXBROADCASTED, <-bobMsgChan)
+	assert.Equal(t, State_SwapInSender_AwaitClaimPayment, aliceSwap.Current)
+	assert.Equal(t, State_SwapInReceiver_AwaitTxConfirmation, bobSwap.Current)
+
+	aliceChain := aliceSwapService.swapServices.bitcoinWallet.(*dummyChain)
+	require.Len(t, aliceChain.openingParams, 1)
+	assert.True(t, aliceChain.openingParams[0].Taproot)
+	assert.True(t, bobSwap.Data.GetOpeningParams().Taproot)
+
+	// The taker never pays the claim invoice.
+	err = aliceChain.csvPassedFunc(aliceSwap.SwapId.String())
+	require.NoError(t, err)
+	assert.Equal(t, State_ClaimedCsv, aliceSwap.Current)
+	require.NotNil(t, aliceChain.csvParams)
+	assert.True(t, aliceChain.csvParams.Taproot)
+	assert.Equal(t, aliceChain.openingParams[0].ClaimPaymentHash, aliceChain.csvParams.ClaimPaymentHash)
+}
+
 func Test_OnlyOneActiveSwapPerChannel(t *testing.T) {
 	service := getTestSetup("alice")
 	swapId := NewSwapId()
@@ -223,12 +598,12 @@ func Test_OnlyOneActiveSwapPerChannel(t *testing.T) {
 		failures: 0,
 	})
 
-	_, err := service.SwapOut("peer", "lbtc", "channelID", "alice", uint64(100000))
+	_, err := service.SwapOut("peer", "lbtc", "channelID", "alice", uint64(100000), 0)
 	assert.Error(t, err, "expected error")
 	assert.ErrorIs(t, err, ActiveSwapError{channelId: "channelID", swapId: swapId.String()})
 	t.Logf("Got Error: %s", err.Error())
 
-	_, err = service.SwapIn("peer", "lbtc", "channelID", "alice", uint64(100000))
+	_, err = service.SwapIn("peer", "lbtc", "channelID", "alice", uint64(100000), 0)
 	assert.Error(t, err, "expected error")
 	assert.ErrorIs(t, err, ActiveSwapError{channelId: "channelID", swapId: swapId.String()})
 	t.Logf("Got Error: %s", err.Error())
@@ -257,7 +632,7 @@ func TestMessageFromUnexpectedPeer(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount)
+	aliceSwap, err := aliceSwapService.SwapOut(peer, "btc", channelId, initiator, amount, 0)
 	if err != nil {
 		t.Fatalf(" error swapping oput %v: ", err)
 	}
@@ -368,7 +743,7 @@ func Test_SwapIn_PeerIsSuspicious(t *testing.T) {
 		newSwapsAllowedReturn:  policy.DefaultPolicy().AllowNewSwaps,
 	}
 
-	_, err := swapService.SwapOut(peer, "regtest", "", node, 100000)
+	_, err := swapService.SwapOut(peer, "regtest", "", node, 100000, 0)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, PeerIsSuspiciousError(peer))
 }
@@ -387,7 +762,7 @@ func Test_SwapOut_PeerIsSuspicious(t *testing.T) {
 		newSwapsAllowedReturn:      policy.DefaultPolicy().AllowNewSwaps,
 	}
 
-	_, err := swapService.SwapOut(peer, "regtest", "", node, 100000)
+	_, err := swapService.SwapOut(peer, "regtest", "", node, 100000, 0)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, PeerIsSuspiciousError(peer))
 }
@@ -488,3 +863,17 @@ func getRandom32ByteHexString() string {
 	privkey, _ := btcec.NewPrivateKey()
 	return hex.EncodeToString(privkey.Serialize())
 }
+
+func Test_EstimatedOnchainFeeSat(t *testing.T) {
+	service := getTestSetup("alice")
+
+	// The initiator of a swap-out pays the opening fee with the fee invoice
+	// and claims the opening output.
+	fee, err := service.EstimatedOnchainFeeSat(btc_chain, SWAPTYPE_OUT)
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, fee)
+
+	fee, err = service.EstimatedOnchainFeeSat(btc_chain, SWAPTYPE_IN)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, fee)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/policy"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	btecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 )
 
 const (
@@ -35,8 +39,21 @@ type Policy interface {
 	IsPeerSuspicious(peer string) bool
 	AddToSuspiciousPeerList(pubkey string) error
 	GetReserveOnchainMsat() uint64
-	GetMinSwapAmountMsat() uint64
+	// GetAssetReserveOnchainMsat returns the reserve of the wallet of the
+	// asset.
+	GetAssetReserveOnchainMsat(asset string) uint64
+	IsChannelExcluded(scid string) bool
+	GetPeerMinSwapAmountMsat(peer string) uint64
 	NewSwapsAllowed() bool
+	GetPeerPremium(peer, asset string, amountSat uint64) uint64
+	// CheckPeerSwapRequest returns an error if the overrides of the peer do
+	// not allow a swap request of the swap type on the asset.
+	CheckPeerSwapRequest(peer, asset, swapType string, amountSat uint64) error
+	// GetLimits returns the limits of the swap requests of all peers
+	// together and GetPeerLimits the ones of a single peer.
+	GetLimits() policy.Limits
+	GetPeerLimits(peer string) policy.Limits
+	GetReputationPolicy() policy.ReputationPolicy
 }
 
 type LightningClient interface {
@@ -84,6 +101,12 @@ type Wallet interface {
 	GetOnchainBalance() (uint64, error)
 }
 
+// SpendingTxFeeWallet is implemented by wallets that can tell the fee of a
+// transaction that spends the opening output.
+type SpendingTxFeeWallet interface {
+	GetSpendingTxFee(swapParams *OpeningParams, txHex string) (uint64, error)
+}
+
 type OpeningParams struct {
 	TakerPubkey      string
 	MakerPubkey      string
@@ -91,6 +114,9 @@ type OpeningParams struct {
 	Amount           uint64
 	BlindingKey      *btcec.PrivateKey
 	OpeningAddress   string
+	// Taproot is set for bitcoin swaps of protocol version 4 and above,
+	// their opening output is a P2TR output instead of a P2WSH output.
+	Taproot bool
 }
 
 func (o *OpeningParams) String() string {
@@ -106,6 +132,10 @@ type ClaimParams struct {
 	Signer       Signer
 	OpeningTxHex string
 
+	// SatPerVbyte overrides the estimated fee rate of the spending
+	// transaction. It is set to replace a claim with a higher fee.
+	SatPerVbyte uint64
+
 	// blinded tx stuff
 	BlindingSeed              []byte
 	OutputAssetBlindingFactor []byte
@@ -120,6 +150,17 @@ type Signer interface {
 	Sign(hash []byte) (*btecdsa.Signature, error)
 }
 
+// TaprootSigner is a Signer that can also sign the spends of a taproot
+// opening output, with a schnorr signature on the script paths and with a
+// MuSig2 partial signature on the cooperative key path.
+type TaprootSigner interface {
+	Signer
+	PubKey() *btcec.PublicKey
+	SignSchnorr(hash []byte) (*schnorr.Signature, error)
+	MuSig2Nonces() (*musig2.Nonces, error)
+	MuSig2Sign(secNonce [musig2.SecNonceSize]byte, combinedNonce [musig2.PubNonceSize]byte, keys []*btcec.PublicKey, msg [32]byte, opts ...musig2.SignOption) (*musig2.PartialSignature, error)
+}
+
 type TimeOutService interface {
 	addNewTimeOut(ctx context.Context, d time.Duration, id string)
 }
@@ -140,6 +181,11 @@ type SwapServices struct {
 	liquidWallet        Wallet
 	liquidEnabled       bool
 	toService           TimeOutService
+	batchService        *openingTxBatchService
+	events              *SwapEventHub
+	peerStats           *peerStatsIndex
+	peerTerms           PeerTermsGetter
+	reservations        onchainReservations
 }
 
 func NewSwapServices(
@@ -172,9 +218,23 @@ func NewSwapServices(
 		liquidWallet:        liquidWallet,
 		liquidValidator:     liquidValidator,
 		liquidTxWatcher:     liquidTxWatcher,
+		events:              NewSwapEventHub(),
+		peerStats:           newPeerStatsIndex(),
 	}
 }
 
+// addSuspiciousPeer adds the peer of a swap to the suspicious peer list and
+// publishes the event.
+func (s *SwapServices) addSuspiciousPeer(peerId, swapId, reason string) error {
+	if err := s.policy.AddToSuspiciousPeerList(peerId); err != nil {
+		return err
+	}
+	if s.events != nil {
+		s.events.PublishSuspiciousPeer(&SuspiciousPeerEvent{PeerNodeId: peerId, SwapId: swapId, Reason: reason})
+	}
+	return nil
+}
+
 func (s *SwapServices) getOnChainServices(asset string) (TxWatcher, Wallet, Validator, error) {
 	if asset == "" {
 		return nil, nil, nil, fmt.Errorf("missing asset")
@@ -187,3 +247,123 @@ func (s *SwapServices) getOnChainServices(asset string) (TxWatcher, Wallet, Vali
 	}
 	return nil, nil, nil, WrongAssetError(asset)
 }
+
+// onchainReserveSat returns the on-chain reserve of the wallet of the asset,
+// rounded up to full sats.
+func (s *SwapServices) onchainReserveSat(asset string) uint64 {
+	return (s.policy.GetAssetReserveOnchainMsat(asset) + 999) / 1000
+}
+
+// checkOnchainReserve returns a ReserveOnchainError if spending amountSat from
+// the wallet of the asset with a balance of balanceSat would spend the
+// on-chain reserve of the policy.
+func (s *SwapServices) checkOnchainReserve(asset string, balanceSat, amountSat uint64) error {
+	reserveSat := s.onchainReserveSat(asset)
+	if balanceSat < amountSat+reserveSat {
+		return ReserveOnchainError{
+			Asset:      asset,
+			BalanceSat: balanceSat,
+			AmountSat:  amountSat,
+			ReserveSat: reserveSat,
+		}
+	}
+	return nil
+}
+
+// checkWalletReserve returns an error if the wallet of the asset can not fund
+// the opening transaction of a swap over amountSat and keep the reserve. The
+// funds that other active swaps still need for their opening transactions are
+// not available, the swaps in exceptSwapIds are the ones that are checked.
+func (s *SwapServices) checkWalletReserve(asset string, amountSat uint64, exceptSwapIds ...string) error {
+	_, wallet, _, err := s.getOnChainServices(asset)
+	if err != nil {
+		return err
+	}
+	openingFee, err := wallet.GetFlatOpeningTXFee()
+	if err != nil {
+		return err
+	}
+	balance, err := s.availableOnchainSat(wallet, asset, exceptSwapIds...)
+	if err != nil {
+		return err
+	}
+	return s.checkOnchainReserve(asset, balance, amountSat+openingFee)
+}
+
+// availableOnchainSat returns the wallet balance of the asset without the
+// funds that the active swaps, except the swaps in exceptSwapIds, reserved.
+func (s *SwapServices) availableOnchainSat(wallet Wallet, asset string, exceptSwapIds ...string) (uint64, error) {
+	balance, err := wallet.GetOnchainBalance()
+	if err != nil {
+		return 0, err
+	}
+	reserved := s.reservations.reservedSat(asset, exceptSwapIds...)
+	if reserved > balance {
+		return 0, nil
+	}
+	return balance - reserved, nil
+}
+
+// onchainReservations holds the amounts of the opening transactions that the
+// active swaps still fund from our wallet. The reservation of a swap is
+// updated with the swap data, so that it can be read without the lock of the
+// swap.
+type onchainReservations struct {
+	sync.Mutex
+	reservations map[string]onchainReservation
+}
+
+type onchainReservation struct {
+	asset     string
+	amountSat uint64
+}
+
+// update stores the reservation of the swap. The funds are reserved by the
+// swaps that we fund the opening transaction for until the opening
+// transaction is created. Must be called with the lock of the swap held.
+func (r *onchainReservations) update(swap *SwapStateMachine) {
+	r.Lock()
+	defer r.Unlock()
+
+	swapId := swap.SwapId.String()
+	if swap.IsFinished() || !swap.isMaker() || swap.Data == nil || swap.Data.OpeningTxHex != "" {
+		delete(r.reservations, swapId)
+		return
+	}
+	if r.reservations == nil {
+		r.reservations = make(map[string]onchainReservation)
+	}
+	r.reservations[swapId] = onchainReservation{asset: swap.Data.GetChain(), amountSat: swap.Data.GetAmount()}
+}
+
+// remove removes the reservation of the swap.
+func (r *onchainReservations) remove(swapId string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.reservations, swapId)
+}
+
+// reservedSat returns the reserved amount on the asset without the
+// reservations of the swaps in exceptSwapIds.
+func (r *onchainReservations) reservedSat(asset string, exceptSwapIds ...string) uint64 {
+	r.Lock()
+	defer r.Unlock()
+
+	var reserved uint64
+	for swapId, reservation := range r.reservations {
+		if reservation.asset != asset || containsString(exceptSwapIds, swapId) {
+			continue
+		}
+		reserved += reservation.amountSat
+	}
+	return reserved
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,37 @@ package swap
 import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 )
 
 type Secp256k1Signer struct {
 	key *btcec.PrivateKey
 }
 
+func NewSecp256k1Signer(key *btcec.PrivateKey) *Secp256k1Signer {
+	return &Secp256k1Signer{key: key}
+}
+
 func (s *Secp256k1Signer) Sign(hash []byte) (*ecdsa.Signature, error) {
 	return ecdsa.Sign(s.key, hash), nil
 }
+
+func (s *Secp256k1Signer) PubKey() *btcec.PublicKey {
+	return s.key.PubKey()
+}
+
+func (s *Secp256k1Signer) SignSchnorr(hash []byte) (*schnorr.Signature, error) {
+	// schnorr.Sign negates the key in place if its pubkey has an odd y
+	// coordinate, so we sign with a copy.
+	key, _ := btcec.PrivKeyFromBytes(s.key.Serialize())
+	return schnorr.Sign(key, hash)
+}
+
+func (s *Secp256k1Signer) MuSig2Nonces() (*musig2.Nonces, error) {
+	return musig2.GenNonces(musig2.WithNonceSecretKeyAux(s.key))
+}
+
+func (s *Secp256k1Signer) MuSig2Sign(secNonce [musig2.SecNonceSize]byte, combinedNonce [musig2.PubNonceSize]byte, keys []*btcec.PublicKey, msg [32]byte, opts ...musig2.SignOption) (*musig2.PartialSignature, error) {
+	return musig2.Sign(secNonce, s.key, combinedNonce, keys, msg, opts...)
+}
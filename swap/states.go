@@ -74,11 +74,13 @@ const (
 
 	Event_OnSwapOutRequestReceived EventType = "Event_OnSwapOutRequestReceived"
 
-	Event_OnFeeInvoicePaid    EventType = "Event_OnFeeInvoicePaid"
-	Event_OnClaimInvoicePaid  EventType = "Event_OnClaimInvoicePaid"
-	Event_OnCsvPassed         EventType = "Event_OnCsvPassed"
-	Event_OnCancelReceived    EventType = "Event_OnCancelReceived"
-	Event_OnCoopCloseReceived EventType = "Event_OnCoopCloseReceived"
+	Event_OnFeeInvoicePaid            EventType = "Event_OnFeeInvoicePaid"
+	Event_OnBatchOpeningTxBroadcasted EventType = "Event_OnBatchOpeningTxBroadcasted"
+	Event_OnClaimInvoicePaid          EventType = "Event_OnClaimInvoicePaid"
+	Event_OnCsvPassed                 EventType = "Event_OnCsvPassed"
+	Event_OnCancelReceived            EventType = "Event_OnCancelReceived"
+	Event_OnCoopCloseReceived         EventType = "Event_OnCoopCloseReceived"
+	Event_OnCancelRequested           EventType = "Event_OnCancelRequested"
 
 	Event_OnTimeout = "Event_OnTimeout"
 
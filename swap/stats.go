@@ -0,0 +1,358 @@
+package swap
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	// DefaultPeerStatsSwaps is the default number of the last swaps that
+	// are returned with the stats of a peer.
+	DefaultPeerStatsSwaps = 10
+
+	// maxPeerStatsSwaps is the number of the last swaps that are kept per
+	// peer.
+	maxPeerStatsSwaps = 100
+
+	// maxPeerCancelReasons is the number of the last distinct cancel
+	// messages that are counted per peer. The messages are free text of the
+	// peer, the cancel codes are counted completely.
+	maxPeerCancelReasons = 10
+)
+
+// PeerStats are the statistics of the finished swaps with a peer.
+type PeerStats struct {
+	PeerNodeId string `json:"peer_node_id"`
+
+	Swaps     uint64 `json:"swaps"`
+	Successes uint64 `json:"successes"`
+	// SuccessRate is the share of the swaps that were claimed with the
+	// preimage, from 0 to 1.
+	SuccessRate float64 `json:"success_rate"`
+	// MedianCompletionSeconds is the median time from the creation of a
+	// successful swap until it finished.
+	MedianCompletionSeconds int64  `json:"median_completion_seconds"`
+	CsvClaims               uint64 `json:"csv_claims"`
+
+	// CancelReasons counts the last distinct cancel messages of the
+	// canceled swaps.
+	CancelReasons map[string]uint64 `json:"cancel_reasons"`
+	// CancelCodes counts the cancel codes of the canceled swaps.
+	CancelCodes map[CancelCode]uint64 `json:"cancel_codes"`
+	// Volumes holds the volume of the successful swaps by asset, type and
+	// role.
+	Volumes []*PeerVolume `json:"volumes"`
+
+	// FeesPaidSat are the on-chain fees, the fee invoices and the premiums
+	// that we paid, FeesEarnedSat the fee invoices and premiums that we
+	// received.
+	FeesPaidSat   uint64 `json:"fees_paid_sat"`
+	FeesEarnedSat uint64 `json:"fees_earned_sat"`
+
+	// LastSwaps holds the last finished swaps, latest first.
+	LastSwaps []*SwapExport `json:"last_swaps"`
+}
+
+// PeerVolume is the volume of the successful swaps of one asset, type and
+// role.
+type PeerVolume struct {
+	Asset     string `json:"asset"`
+	Type      string `json:"type"`
+	Role      string `json:"role"`
+	Swaps     uint64 `json:"swaps"`
+	AmountSat uint64 `json:"amount_sat"`
+}
+
+type peerVolumeKey struct {
+	asset, swapType, role string
+}
+
+// peerStats are the running totals of a peer.
+type peerStats struct {
+	seen          map[string]struct{}
+	swaps         uint64
+	successes     uint64
+	csvClaims     uint64
+	durations     []int64
+	cancelReasons map[string]uint64
+	reasonOrder   []string
+	cancelCodes   map[CancelCode]uint64
+	volumes       map[peerVolumeKey]*PeerVolume
+	paidMsat      uint64
+	earnedMsat    uint64
+	last          []*SwapExport
+}
+
+func newPeerStats() *peerStats {
+	return &peerStats{
+		seen:          map[string]struct{}{},
+		cancelReasons: map[string]uint64{},
+		cancelCodes:   map[CancelCode]uint64{},
+		volumes:       map[peerVolumeKey]*PeerVolume{},
+	}
+}
+
+// add adds a finished swap to the totals, the swap is only counted once.
+func (p *peerStats) add(e *SwapExport, cancelMessage string, cancelCode CancelCode) {
+	if _, ok := p.seen[e.SwapId]; ok {
+		return
+	}
+	p.seen[e.SwapId] = struct{}{}
+	p.swaps++
+
+	switch StateType(e.State) {
+	case State_ClaimedPreimage:
+		p.successes++
+		if e.FinishedAt >= e.CreatedAt && e.FinishedAt != 0 {
+			d := e.FinishedAt - e.CreatedAt
+			i := sort.Search(len(p.durations), func(i int) bool { return p.durations[i] >= d })
+			p.durations = append(p.durations, 0)
+			copy(p.durations[i+1:], p.durations[i:])
+			p.durations[i] = d
+		}
+		key := peerVolumeKey{e.Asset, e.Type, e.Role}
+		v, ok := p.volumes[key]
+		if !ok {
+			v = &PeerVolume{Asset: e.Asset, Type: e.Type, Role: e.Role}
+			p.volumes[key] = v
+		}
+		v.Swaps++
+		v.AmountSat += e.AmountSat
+	case State_ClaimedCsv:
+		p.csvClaims++
+	case State_SwapCanceled, State_ClaimedCoop:
+		if cancelMessage == "" {
+			cancelMessage = "unknown"
+		}
+		p.addCancelReason(cancelMessage)
+		if cancelCode == "" {
+			cancelCode = CancelCode_Unknown
+		}
+		p.cancelCodes[cancelCode]++
+	}
+
+	maker := (e.Type == SWAPTYPE_OUT.String() && e.Role == SWAPROLE_RECEIVER.String()) ||
+		(e.Type == SWAPTYPE_IN.String() && e.Role == SWAPROLE_SENDER.String())
+	paid, earned := swapFeesMsat(e, maker)
+	p.paidMsat += paid
+	p.earnedMsat += earned
+
+	i := sort.Search(len(p.last), func(i int) bool { return p.last[i].FinishedAt < e.FinishedAt })
+	p.last = append(p.last, nil)
+	copy(p.last[i+1:], p.last[i:])
+	p.last[i] = e
+	if len(p.last) > maxPeerStatsSwaps {
+		p.last = p.last[:maxPeerStatsSwaps]
+	}
+}
+
+// addCancelReason counts the cancel message and drops the message that was
+// seen least recently if there are too many distinct messages. reasonOrder
+// holds the counted messages, the last seen last.
+func (p *peerStats) addCancelReason(reason string) {
+	for i, r := range p.reasonOrder {
+		if r == reason {
+			p.reasonOrder = append(p.reasonOrder[:i], p.reasonOrder[i+1:]...)
+			break
+		}
+	}
+	p.reasonOrder = append(p.reasonOrder, reason)
+	p.cancelReasons[reason]++
+	if len(p.reasonOrder) > maxPeerCancelReasons {
+		delete(p.cancelReasons, p.reasonOrder[0])
+		p.reasonOrder = p.reasonOrder[1:]
+	}
+}
+
+// swapFeesMsat splits the accounting record of a swap into what we paid and
+// what we earned.
+func swapFeesMsat(e *SwapExport, maker bool) (paid, earned uint64) {
+	paid += e.ClaimTxFeeMsat
+	if maker {
+		paid += e.OpeningTxFeeMsat
+	}
+	if e.FeeInvoicePaid {
+		if maker {
+			earned += e.FeeInvoiceMsat
+		} else {
+			paid += e.FeeInvoiceMsat
+		}
+	}
+	if e.ClaimInvoicePaid {
+		// The difference of the claim invoice and the amount is the
+		// premium, it is paid by the taker.
+		if e.ClaimInvoiceMsat >= e.AmountMsat {
+			premium := e.ClaimInvoiceMsat - e.AmountMsat
+			if maker {
+				earned += premium
+			} else {
+				paid += premium
+			}
+		} else {
+			premium := e.AmountMsat - e.ClaimInvoiceMsat
+			if maker {
+				paid += premium
+			} else {
+				earned += premium
+			}
+		}
+	}
+	return paid, earned
+}
+
+func (p *peerStats) stats(peer string, swaps int) *PeerStats {
+	res := &PeerStats{
+		PeerNodeId:    peer,
+		Swaps:         p.swaps,
+		Successes:     p.successes,
+		CsvClaims:     p.csvClaims,
+		CancelReasons: map[string]uint64{},
+		CancelCodes:   map[CancelCode]uint64{},
+		FeesPaidSat:   p.paidMsat / 1000,
+		FeesEarnedSat: p.earnedMsat / 1000,
+		Volumes:       []*PeerVolume{},
+		LastSwaps:     []*SwapExport{},
+	}
+	if p.swaps > 0 {
+		res.SuccessRate = float64(p.successes) / float64(p.swaps)
+	}
+	if n := len(p.durations); n > 0 {
+		if n%2 == 1 {
+			res.MedianCompletionSeconds = p.durations[n/2]
+		} else {
+			res.MedianCompletionSeconds = (p.durations[n/2-1] + p.durations[n/2]) / 2
+		}
+	}
+	for reason, count := range p.cancelReasons {
+		res.CancelReasons[reason] = count
+	}
+	for code, count := range p.cancelCodes {
+		res.CancelCodes[code] = count
+	}
+	for _, v := range p.volumes {
+		c := *v
+		res.Volumes = append(res.Volumes, &c)
+	}
+	sort.Slice(res.Volumes, func(i, j int) bool {
+		a, b := res.Volumes[i], res.Volumes[j]
+		if a.Asset != b.Asset {
+			return a.Asset < b.Asset
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Role < b.Role
+	})
+	if swaps > len(p.last) {
+		swaps = len(p.last)
+	}
+	res.LastSwaps = append(res.LastSwaps, p.last[:swaps]...)
+	return res
+}
+
+// cancelReason returns the message that a swap was canceled with, from the
+// fields that survive a restart.
+func cancelReason(data *SwapData) string {
+	if data.Cancel != nil && data.Cancel.Message != "" {
+		return data.Cancel.Message
+	}
+	if data.CancelMessage != "" {
+		return data.CancelMessage
+	}
+	return data.LastErrString
+}
+
+// cancelCode returns the code that a swap was canceled with, from the fields
+// that survive a restart.
+func cancelCode(data *SwapData) CancelCode {
+	code := data.GetCancelCode()
+	if code == "" && data.LastErrString != "" {
+		return CancelCode_InternalError
+	}
+	return code
+}
+
+// peerStatsIndex keeps the running totals of all peers. It is loaded from the
+// stored and the archived swaps on first use and updated when a swap
+// finishes, so that the stats of a peer do not need a scan of its swaps.
+type peerStatsIndex struct {
+	sync.Mutex
+	loaded bool
+	peers  map[string]*peerStats
+}
+
+func newPeerStatsIndex() *peerStatsIndex {
+	return &peerStatsIndex{peers: map[string]*peerStats{}}
+}
+
+func (x *peerStatsIndex) peer(peer string) *peerStats {
+	p, ok := x.peers[peer]
+	if !ok {
+		p = newPeerStats()
+		x.peers[peer] = p
+	}
+	return p
+}
+
+// load adds all finished swaps of the store. Must be called with the lock
+// held.
+func (x *peerStatsIndex) load(s *SwapServices) error {
+	if x.loaded {
+		return nil
+	}
+	swaps, err := s.swapStore.ListAll()
+	if err != nil {
+		return err
+	}
+	for _, swap := range swaps {
+		if !swap.IsFinished() || swap.Data == nil {
+			continue
+		}
+		x.peer(swap.Data.PeerNodeId).add(s.exportSwap(swap), cancelReason(swap.Data), cancelCode(swap.Data))
+	}
+	if store, ok := s.swapStore.(ArchiveStore); ok {
+		archived, err := store.ListArchived()
+		if err != nil {
+			return err
+		}
+		for _, a := range archived {
+			e := a.SwapExport
+			x.peer(a.PeerNodeId).add(&e, a.CancelMessage, a.CancelCode)
+		}
+	}
+	x.loaded = true
+	return nil
+}
+
+// recordStats adds a swap that just finished to the stats of its peer.
+func (s *SwapServices) recordStats(swap *SwapStateMachine) {
+	if s.peerStats == nil {
+		return
+	}
+	s.peerStats.Lock()
+	defer s.peerStats.Unlock()
+	if !s.peerStats.loaded {
+		// The swap is added when the stats are loaded.
+		return
+	}
+	s.peerStats.peer(swap.Data.PeerNodeId).add(s.exportSwap(swap), cancelReason(swap.Data), cancelCode(swap.Data))
+}
+
+// GetPeerStats returns the stats of the finished swaps with the peer with the
+// given number of the last swaps, DefaultPeerStatsSwaps if swaps is 0.
+func (s *SwapService) GetPeerStats(peer string, swaps int) (*PeerStats, error) {
+	index := s.swapServices.peerStats
+	index.Lock()
+	defer index.Unlock()
+	if err := index.load(s.swapServices); err != nil {
+		return nil, err
+	}
+	if swaps <= 0 {
+		swaps = DefaultPeerStatsSwaps
+	}
+	p, ok := index.peers[peer]
+	if !ok {
+		p = newPeerStats()
+	}
+	return p.stats(peer, swaps), nil
+}
@@ -0,0 +1,129 @@
+package swap
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_GetPeerStats(t *testing.T) {
+	services := getSwapServices(make(chan PeerMessage))
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "swaps"), 0700, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	store, err := NewBboltStore(db)
+	require.NoError(t, err)
+	services.swapStore = store
+	service := NewSwapService(services)
+
+	now := time.Now()
+	newSwap := func(peer string, swapType SwapType, role SwapRole, state StateType, duration time.Duration, finishedAt time.Time) *SwapStateMachine {
+		swapId := NewSwapId()
+		swap := &SwapStateMachine{
+			SwapId:  swapId,
+			Type:    swapType,
+			Role:    role,
+			Current: state,
+			Data: &SwapData{
+				PeerNodeId:     peer,
+				CreatedAt:      finishedAt.Add(-duration).Unix(),
+				FinishedAt:     finishedAt.Unix(),
+				SwapOutRequest: &SwapOutRequestMessage{SwapId: swapId, Network: "mainnet", Amount: 100000},
+			},
+		}
+		require.NoError(t, store.UpdateData(swap))
+		return swap
+	}
+
+	// Swaps that are stored before the first call are loaded.
+	newSwap("bob", SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage, 10*time.Minute, now.Add(-3*time.Hour))
+	newSwap("bob", SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage, 30*time.Minute, now.Add(-2*time.Hour))
+	canceled := newSwap("bob", SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapCanceled, time.Minute, now.Add(-time.Hour))
+	canceled.Data.CancelMessage = "swaps are disabled"
+	canceled.Data.CancelCode = CancelCode_SwapsDisabled
+	require.NoError(t, store.UpdateData(canceled))
+	newSwap("bob", SWAPTYPE_OUT, SWAPROLE_SENDER, State_SwapOutSender_AwaitAgreement, 0, now)
+	newSwap("carol", SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage, time.Minute, now)
+
+	stats, err := service.GetPeerStats("bob", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", stats.PeerNodeId)
+	assert.EqualValues(t, 3, stats.Swaps)
+	assert.EqualValues(t, 2, stats.Successes)
+	assert.InDelta(t, 2.0/3.0, stats.SuccessRate, 0.001)
+	assert.EqualValues(t, 20*60, stats.MedianCompletionSeconds)
+	assert.Equal(t, map[string]uint64{"swaps are disabled": 1}, stats.CancelReasons)
+	assert.Equal(t, map[CancelCode]uint64{CancelCode_SwapsDisabled: 1}, stats.CancelCodes)
+	require.Len(t, stats.Volumes, 1)
+	assert.Equal(t, &PeerVolume{Asset: "btc", Type: "swap-out", Role: "sender", Swaps: 2, AmountSat: 200000}, stats.Volumes[0])
+	require.Len(t, stats.LastSwaps, 3)
+	assert.Equal(t, canceled.SwapId.String(), stats.LastSwaps[0].SwapId)
+
+	// Swaps that finish later are added without a scan of the store.
+	csv := newSwap("bob", SWAPTYPE_OUT, SWAPROLE_RECEIVER, State_ClaimedCsv, time.Hour, now)
+	services.recordStats(csv)
+	services.recordStats(csv)
+	newSwap("bob", SWAPTYPE_OUT, SWAPROLE_SENDER, State_ClaimedPreimage, time.Minute, now)
+
+	stats, err = service.GetPeerStats("bob", 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, stats.Swaps)
+	assert.EqualValues(t, 1, stats.CsvClaims)
+	assert.EqualValues(t, 0.5, stats.SuccessRate)
+	require.Len(t, stats.LastSwaps, 2)
+	assert.Equal(t, csv.SwapId.String(), stats.LastSwaps[0].SwapId)
+
+	stats, err = service.GetPeerStats("dave", 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, stats.Swaps)
+	assert.Empty(t, stats.LastSwaps)
+}
+
+// Test_PeerStats_CancelReasons checks that only the last distinct cancel
+// messages of a peer are counted, while all cancel codes are.
+func Test_PeerStats_CancelReasons(t *testing.T) {
+	p := newPeerStats()
+	add := func(i int, message string) {
+		p.add(&SwapExport{SwapId: fmt.Sprint(i), State: string(State_SwapCanceled)}, message, CancelCode_InternalError)
+	}
+
+	add(0, "first")
+	for i := 1; i <= maxPeerCancelReasons; i++ {
+		add(i, fmt.Sprintf("made up %d", i))
+		if i == 1 {
+			// A message that is seen again is kept.
+			add(100, "first")
+		}
+	}
+
+	stats := p.stats("peer", 0)
+	assert.Len(t, stats.CancelReasons, maxPeerCancelReasons)
+	assert.EqualValues(t, 2, stats.CancelReasons["first"])
+	assert.NotContains(t, stats.CancelReasons, "made up 1")
+	assert.EqualValues(t, maxPeerCancelReasons+2, stats.CancelCodes[CancelCode_InternalError])
+}
+
+func Test_SwapFeesMsat(t *testing.T) {
+	e := &SwapExport{
+		AmountMsat:       100000000,
+		FeeInvoiceMsat:   1000000,
+		FeeInvoicePaid:   true,
+		ClaimInvoiceMsat: 100500000,
+		ClaimInvoicePaid: true,
+		OpeningTxFeeMsat: 300000,
+		ClaimTxFeeMsat:   200000,
+	}
+
+	paid, earned := swapFeesMsat(e, true)
+	assert.EqualValues(t, 500000, paid)
+	assert.EqualValues(t, 1500000, earned)
+
+	paid, earned = swapFeesMsat(e, false)
+	assert.EqualValues(t, 1700000, paid)
+	assert.EqualValues(t, 0, earned)
+}
@@ -10,6 +10,8 @@ import (
 
 var (
 	swapBuckets          = []byte("swaps")
+	archivedSwapsBucket  = []byte("archived-swaps")
+	peerOutcomesBucket   = []byte("peer-outcomes")
 	versionBucket        = []byte("version")
 	requestedSwapsBucket = []byte("requested-swaps")
 
@@ -31,6 +33,14 @@ func NewBboltStore(db *bbolt.DB) (*bboltStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	_, err = tx.CreateBucketIfNotExists(archivedSwapsBucket)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.CreateBucketIfNotExists(peerOutcomesBucket)
+	if err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -224,6 +234,73 @@ func (p *bboltStore) ListAllByPeer(peer string) ([]*SwapStateMachine, error) {
 	return swaps, nil
 }
 
+// ArchiveSwap implements ArchiveStore. bbolt never overwrites pages in place,
+// the deleted swap stays in a free page of the database file until the page
+// is reused. Migrate to the sqlite backend to overwrite deleted swaps.
+func (p *bboltStore) ArchiveSwap(archived *ArchivedSwap) error {
+	return p.db.Update(func(tx *bbolt.Tx) error {
+		jData, err := json.Marshal(archived)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(archivedSwapsBucket).Put(h2b(archived.SwapId), jData); err != nil {
+			return err
+		}
+		return tx.Bucket(swapBuckets).Delete(h2b(archived.SwapId))
+	})
+}
+
+// ListArchived implements ArchiveStore.
+func (p *bboltStore) ListArchived() ([]*ArchivedSwap, error) {
+	var archived []*ArchivedSwap
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(archivedSwapsBucket).ForEach(func(k, v []byte) error {
+			a := &ArchivedSwap{}
+			if err := json.Unmarshal(v, a); err != nil {
+				return err
+			}
+			archived = append(archived, a)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+// AddPeerOutcome implements ReputationStore.
+func (p *bboltStore) AddPeerOutcome(outcome *PeerOutcome) error {
+	jData, err := json.Marshal(outcome)
+	if err != nil {
+		return err
+	}
+	return p.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(peerOutcomesBucket).Put(h2b(outcome.SwapId), jData)
+	})
+}
+
+// ListPeerOutcomes implements ReputationStore.
+func (p *bboltStore) ListPeerOutcomes(peer string) ([]*PeerOutcome, error) {
+	var outcomes []*PeerOutcome
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(peerOutcomesBucket).ForEach(func(k, v []byte) error {
+			o := &PeerOutcome{}
+			if err := json.Unmarshal(v, o); err != nil {
+				return err
+			}
+			if peer == "" || o.PeerNodeId == peer {
+				outcomes = append(outcomes, o)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcomes, nil
+}
+
 func (p *bboltStore) idExists(id string) (bool, error) {
 	_, err := p.GetById(id)
 	if err != nil {
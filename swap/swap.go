@@ -25,6 +25,17 @@ func (s SwapType) String() string {
 	return ""
 }
 
+// ParseSwapType returns the swap type of swap-out or swap-in.
+func ParseSwapType(s string) (SwapType, error) {
+	switch s {
+	case SWAPTYPE_OUT.String():
+		return SWAPTYPE_OUT, nil
+	case SWAPTYPE_IN.String():
+		return SWAPTYPE_IN, nil
+	}
+	return 0, fmt.Errorf("unknown type %s, expected swap-out or swap-in", s)
+}
+
 func (s SwapType) JsonFieldValue() string {
 	switch s {
 	case SWAPTYPE_OUT:
@@ -103,10 +114,13 @@ type SwapData struct {
 
 	// cancel message
 	CancelMessage string `json:"cancel_message"`
+	// CancelCode is the code that we canceled the swap with.
+	CancelCode CancelCode `json:"cancel_code,omitempty"`
 
 	PeerNodeId          string    `json:"peer_node_id"`
 	InitiatorNodeId     string    `json:"initiator_node_id"`
 	CreatedAt           int64     `json:"created_at"`
+	FinishedAt          int64     `json:"finished_at,omitempty"`
 	Role                SwapRole  `json:"role"`
 	FSMState            StateType `json:"fsm_state"`
 	PrivkeyBytes        []byte    `json:"private_key"`
@@ -115,9 +129,29 @@ type SwapData struct {
 	OpeningTxHex        string    `json:"opening_tx_hex"`
 	StartingBlockHeight uint32    `json:"opening_block_height"`
 	ClaimTxId           string    `json:"claim_tx_id"`
+	ClaimTxFee          uint64    `json:"claim_tx_fee,omitempty"`
 	ClaimPaymentHash    string    `json:"claim_payment_hash"`
 	ClaimPreimage       string    `json:"claim_preimage"`
 
+	// ProtocolVersion is the protocol version that we negotiated with the
+	// peer for the swap.
+	ProtocolVersion uint8 `json:"protocol_version,omitempty"`
+
+	// MaxPremium is the highest premium in sat that we accept to pay to our
+	// peer when we initiate a swap.
+	MaxPremium uint64 `json:"max_premium"`
+
+	// TimedOutIn is the state in which the swap timed out, empty if it did
+	// not time out.
+	TimedOutIn StateType `json:"timed_out_in,omitempty"`
+
+	// FeeBumps are the fee bumps of the opening and claim transaction.
+	FeeBumps []*FeeBump `json:"fee_bumps,omitempty"`
+
+	// BatchOpening is the batch opening transaction that funds the swap
+	// while it is being published.
+	BatchOpening *BatchOpening `json:"batch_opening,omitempty"`
+
 	BlindingKeyHex string `json:"blinding_key"`
 
 	LastMessage EventContext `json:"last_message"`
@@ -149,7 +183,12 @@ func (s *SwapData) GetId() *SwapId {
 	return nil
 }
 
+// GetProtocolVersion returns the negotiated protocol version of the swap.
+// Swaps from before the negotiation take it from their messages.
 func (s *SwapData) GetProtocolVersion() uint8 {
+	if s.ProtocolVersion != 0 {
+		return s.ProtocolVersion
+	}
 	if s.SwapInRequest != nil {
 		return s.SwapInRequest.ProtocolVersion
 	}
@@ -205,6 +244,28 @@ func (s *SwapData) GetAmount() uint64 {
 	return 0
 }
 
+// GetPremium returns the premium in sat that the swap receiver asked for in its
+// agreement.
+func (s *SwapData) GetPremium() uint64 {
+	if s.SwapInAgreement != nil {
+		return s.SwapInAgreement.Premium
+	}
+	if s.SwapOutAgreement != nil {
+		return s.SwapOutAgreement.Premium
+	}
+	return 0
+}
+
+// GetClaimInvoiceAmount returns the amount in sat of the claim invoice. On a
+// swap-in the premium is deducted from the amount that the receiver pays. On a
+// swap-out the premium is part of the fee invoice.
+func (s *SwapData) GetClaimInvoiceAmount() uint64 {
+	if s.SwapInRequest != nil {
+		return s.SwapInRequest.Amount - s.GetPremium()
+	}
+	return s.GetAmount()
+}
+
 func (s *SwapData) GetAsset() string {
 	if s.SwapInRequest != nil {
 		return s.SwapInRequest.Asset
@@ -328,6 +389,7 @@ func (s *SwapData) GetOpeningParams() *OpeningParams {
 		ClaimPaymentHash: s.GetPaymentHash(),
 		Amount:           s.GetAmount(),
 		BlindingKey:      blindingKey,
+		Taproot:          s.GetChain() == btc_chain && s.features().taprootOpening,
 	}
 }
 
@@ -484,6 +546,7 @@ func (s SwapErrorContext) ApplyToSwapData(data *SwapData) error {
 		data.LastErrString = s.Err.Error()
 		if s.SendPeer {
 			data.CancelMessage = s.Err.Error()
+			data.CancelCode = CancelCodeFromError(s.Err)
 		}
 	}
 	return nil
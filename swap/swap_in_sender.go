@@ -51,6 +51,7 @@ func getSwapInSenderStates() States {
 			Action: &NoOpAction{},
 			Events: Events{
 				Event_OnCancelReceived:                 State_SwapCanceled,
+				Event_OnCancelRequested:                State_SendCancel,
 				Event_OnTimeout:                        State_SendCancel,
 				Event_SwapInSender_OnAgreementReceived: State_SwapInSender_BroadcastOpeningTx,
 				Event_OnInvalid_Message:                State_SendCancel,
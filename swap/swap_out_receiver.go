@@ -61,10 +61,11 @@ func getSwapOutReceiverStates() States {
 			FailOnrecover: true,
 		},
 		State_SwapOutReceiver_BroadcastOpeningTx: {
-			Action: &CreateAndBroadcastOpeningTransaction{},
+			Action: &JoinOpeningTxBatchWrapperAction{next: &CreateAndBroadcastOpeningTransaction{}},
 			Events: Events{
-				Event_ActionSucceeded: State_SwapOutReceiver_SendTxBroadcastedMessage,
-				Event_ActionFailed:    State_SendCancel,
+				Event_ActionSucceeded:             State_SwapOutReceiver_SendTxBroadcastedMessage,
+				Event_OnBatchOpeningTxBroadcasted: State_SwapOutReceiver_SendTxBroadcastedMessage,
+				Event_ActionFailed:                State_SendCancel,
 			},
 		},
 		State_SwapOutReceiver_SendTxBroadcastedMessage: {
@@ -180,7 +180,7 @@ func Test_SwapOutReceiverInsufficientBalance(t *testing.T) {
 	msg := <-msgChan
 	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
 	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
-
+	assert.Equal(t, CancelCode_InsufficientBalance, swapFSM.Data.CancelCode)
 }
 
 // Test_SwapOutReceiver_PeerIsSuspicious checks that a swap request is rejected
@@ -221,4 +221,46 @@ func Test_SwapOutReceiver_PeerIsSuspicious(t *testing.T) {
 	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
 	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
 	assert.Equal(t, fmt.Sprintf("peer %s not allowed to request swaps", peer), swapFSM.Data.CancelMessage)
+	assert.Equal(t, CancelCode_PeerNotAllowed, swapFSM.Data.CancelCode)
+}
+
+// Test_SwapOutReceiver_PeerPolicy checks that a swap request is rejected if
+// the policy overrides of the peer do not allow it.
+func Test_SwapOutReceiver_PeerPolicy(t *testing.T) {
+	swapAmount := uint64(100000)
+	swapId := NewSwapId()
+	_, peer, _, _, chanId := getTestParams()
+
+	msgChan := make(chan PeerMessage)
+
+	swapServices := getSwapServices(msgChan)
+	rejection := policy.ErrPeerPolicy(fmt.Sprintf("asset btc is not allowed for peer %s", peer))
+	swapServices.policy = &dummyPolicy{
+		getMinSwapAmountMsatReturn: policy.DefaultPolicy().MinSwapAmountMsat,
+		newSwapsAllowedReturn:      policy.DefaultPolicy().AllowNewSwaps,
+		checkPeerSwapRequestReturn: rejection,
+	}
+
+	swapFSM := newSwapOutReceiverFSM(swapId, swapServices, peer)
+	_, err := swapFSM.SendEvent(Event_OnSwapOutRequestReceived, &SwapOutRequestMessage{
+		Amount:          swapAmount,
+		Scid:            chanId,
+		SwapId:          swapId,
+		Pubkey:          peer,
+		Network:         "mainnet",
+		ProtocolVersion: PEERSWAP_PROTOCOL_VERSION,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := <-msgChan
+	assert.Equal(t, messages.MESSAGETYPE_CANCELED, msg.MessageType())
+	assert.Equal(t, State_SwapCanceled, swapFSM.Data.GetCurrentState())
+	assert.Equal(t, rejection.Error(), swapFSM.Data.CancelMessage)
+	assert.Equal(t, CancelCode_PolicyRejected, swapFSM.Data.CancelCode)
+
+	reqswaps, err := swapServices.requestedSwapsStore.Get(peer)
+	assert.NoError(t, err)
+	assert.Equal(t, []RequestedSwap{{Asset: "btc", AmountSat: swapAmount, Type: SWAPTYPE_OUT, RejectionReason: rejection.Error()}}, reqswaps)
 }
@@ -52,6 +52,7 @@ func getSwapOutSenderStates() States {
 			Action: &NoOpAction{},
 			Events: Events{
 				Event_OnCancelReceived:     State_SwapCanceled,
+				Event_OnCancelRequested:    State_SendCancel,
 				Event_OnTimeout:            State_SendCancel,
 				Event_OnFeeInvoiceReceived: State_SwapOutSender_PayFeeInvoice,
 				Event_OnInvalid_Message:    State_SendCancel,
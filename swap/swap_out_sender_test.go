@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"math"
+	"sync"
 	"testing"
 
 	"github.com/elementsproject/peerswap/lightning"
 	"github.com/elementsproject/peerswap/messages"
+	"github.com/elementsproject/peerswap/policy"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -205,11 +207,18 @@ func Test_AbortCsvClaim(t *testing.T) {
 }
 
 type dummyStore struct {
+	sync.Mutex
 	dataMap map[string]*SwapStateMachine
 }
 
 func (d *dummyStore) ListAll() ([]*SwapStateMachine, error) {
-	panic("implement me")
+	d.Lock()
+	defer d.Unlock()
+	var swaps []*SwapStateMachine
+	for _, swap := range d.dataMap {
+		swaps = append(swaps, swap)
+	}
+	return swaps, nil
 }
 
 func (d *dummyStore) ListAllByPeer(peer string) ([]*SwapStateMachine, error) {
@@ -217,11 +226,15 @@ func (d *dummyStore) ListAllByPeer(peer string) ([]*SwapStateMachine, error) {
 }
 
 func (d *dummyStore) UpdateData(data *SwapStateMachine) error {
+	d.Lock()
+	defer d.Unlock()
 	d.dataMap[data.SwapId.String()] = data
 	return nil
 }
 
 func (d *dummyStore) GetData(id string) (*SwapStateMachine, error) {
+	d.Lock()
+	defer d.Unlock()
 	if _, ok := d.dataMap[id]; !ok {
 		return nil, ErrDataNotAvailable
 	}
@@ -258,6 +271,8 @@ type dummyLightningClient struct {
 
 	spendableMsatCalled  int
 	receivableMsatCalled int
+
+	claimMsatAmounts []uint64
 }
 
 func (d *dummyLightningClient) Implementation() string {
@@ -311,6 +326,7 @@ func (d *dummyLightningClient) GetPayreq(msatAmount uint64, preimage string, swa
 	if invoiceType == INVOICE_FEE {
 		return "fee", nil
 	}
+	d.claimMsatAmounts = append(d.claimMsatAmounts, msatAmount)
 	return "claim", nil
 }
 
@@ -365,6 +381,19 @@ type dummyPolicy struct {
 
 	newSwapsAllowedCalled int
 	newSwapsAllowedReturn bool
+
+	getPremiumReturn uint64
+
+	checkPeerSwapRequestReturn error
+
+	limits     policy.Limits
+	peerLimits policy.Limits
+
+	reputationPolicy policy.ReputationPolicy
+	suspiciousPeers  []string
+
+	reserveOnchainMsat map[string]uint64
+	excludedScids      []string
 }
 
 func (d *dummyPolicy) NewSwapsAllowed() bool {
@@ -376,7 +405,20 @@ func (d *dummyPolicy) GetReserveOnchainMsat() uint64 {
 	return 1
 }
 
-func (d *dummyPolicy) GetMinSwapAmountMsat() uint64 {
+func (d *dummyPolicy) GetAssetReserveOnchainMsat(asset string) uint64 {
+	return d.reserveOnchainMsat[asset]
+}
+
+func (d *dummyPolicy) IsChannelExcluded(scid string) bool {
+	for _, excluded := range d.excludedScids {
+		if excluded == scid {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dummyPolicy) GetPeerMinSwapAmountMsat(peer string) uint64 {
 	d.getMinSwapAmountMsatCalled++
 	return d.getMinSwapAmountMsatReturn
 }
@@ -386,6 +428,7 @@ func (d *dummyPolicy) IsPeerAllowed(peer string) bool {
 }
 
 func (d *dummyPolicy) AddToSuspiciousPeerList(pubkey string) error {
+	d.suspiciousPeers = append(d.suspiciousPeers, pubkey)
 	return nil
 }
 
@@ -394,6 +437,26 @@ func (d *dummyPolicy) IsPeerSuspicious(peer string) bool {
 	return d.isPeerSuspiciousReturn
 }
 
+func (d *dummyPolicy) GetPeerPremium(peer, asset string, amountSat uint64) uint64 {
+	return d.getPremiumReturn
+}
+
+func (d *dummyPolicy) CheckPeerSwapRequest(peer, asset, swapType string, amountSat uint64) error {
+	return d.checkPeerSwapRequestReturn
+}
+
+func (d *dummyPolicy) GetLimits() policy.Limits {
+	return d.limits
+}
+
+func (d *dummyPolicy) GetPeerLimits(peer string) policy.Limits {
+	return d.peerLimits
+}
+
+func (d *dummyPolicy) GetReputationPolicy() policy.ReputationPolicy {
+	return d.reputationPolicy
+}
+
 func (d *dummyPolicy) GetMakerFee(swapValue uint64, swapFee uint64) (uint64, error) {
 	return 1, nil
 }
@@ -409,6 +472,22 @@ type dummyChain struct {
 
 	calledGetCSVHeight int64
 	returnGetCSVHeight uint32
+
+	calledCreateOpeningTransaction       int64
+	calledPrepareBatchOpeningTransaction int64
+	calledPublishBatchOpeningTransaction int64
+
+	blockHeight                  uint32
+	feeRate                      uint64
+	txConfirmations              uint32
+	claimSatPerVbyte             uint64
+	calledBumpOpeningTransaction int64
+
+	// The opening params that the opening output was created, validated
+	// and spent with.
+	openingParams   []*OpeningParams
+	validatedParams *OpeningParams
+	csvParams       *OpeningParams
 }
 
 func (d *dummyChain) StartWatchingTxs() error {
@@ -447,10 +526,12 @@ func (d *dummyChain) TxIdFromHex(txHex string) (string, error) {
 }
 
 func (d *dummyChain) CreatePreimageSpendingTransaction(swapParams *OpeningParams, claimParams *ClaimParams) (string, string, string, error) {
+	d.claimSatPerVbyte = claimParams.SatPerVbyte
 	return getRandom32ByteHexString(), "txhex", "addr", nil
 }
 
 func (d *dummyChain) CreateCsvSpendingTransaction(swapParams *OpeningParams, claimParams *ClaimParams) (txId, txHex, address string, error error) {
+	d.csvParams = swapParams
 	return getRandom32ByteHexString(), "txhex", "addr", nil
 }
 
@@ -471,9 +552,25 @@ func (d *dummyChain) AddWaitForCsvTx(swapId, txId string, vout uint32, startingH
 }
 
 func (d *dummyChain) GetBlockHeight() (uint32, error) {
+	if d.blockHeight != 0 {
+		return d.blockHeight, nil
+	}
 	return 1, nil
 }
 
+func (d *dummyChain) GetFeeRate(targetConf uint32) (uint64, error) {
+	return d.feeRate, nil
+}
+
+func (d *dummyChain) GetTxConfirmations(txId string) (uint32, error) {
+	return d.txConfirmations, nil
+}
+
+func (d *dummyChain) BumpOpeningTransaction(openingTxHex string, satPerVbyte uint64) (string, error) {
+	d.calledBumpOpeningTransaction++
+	return getRandom32ByteHexString(), nil
+}
+
 func (d *dummyChain) GetRefundFee() (uint64, error) {
 	return 100, nil
 }
@@ -483,9 +580,31 @@ func (d *dummyChain) GetFlatOpeningTXFee() (uint64, error) {
 }
 
 func (d *dummyChain) CreateOpeningTransaction(swapParams *OpeningParams) (unpreparedTxHex, address, txid string, fee uint64, vout uint32, err error) {
+	d.calledCreateOpeningTransaction++
+	d.openingParams = append(d.openingParams, swapParams)
 	return "txhex", "address", getRandom32ByteHexString(), 0, 0, nil
 }
 
+func (d *dummyChain) PrepareBatchOpeningTransaction(swapParams []*OpeningParams) (*BatchOpeningTx, error) {
+	d.calledPrepareBatchOpeningTransaction++
+	d.openingParams = append(d.openingParams, swapParams...)
+	tx := &BatchOpeningTx{
+		TxId:  getRandom32ByteHexString(),
+		TxHex: "txhex",
+		Fee:   150,
+	}
+	for i := range swapParams {
+		tx.Addresses = append(tx.Addresses, "address")
+		tx.Vouts = append(tx.Vouts, uint32(i))
+	}
+	return tx, nil
+}
+
+func (d *dummyChain) PublishBatchOpeningTransaction(tx *BatchOpeningTx) (string, error) {
+	d.calledPublishBatchOpeningTransaction++
+	return tx.TxHex, nil
+}
+
 func (d *dummyChain) AddCsvCallback(f func(swapId string) error) {
 	d.csvPassedFunc = f
 }
@@ -499,5 +618,6 @@ func (d *dummyChain) AddConfirmationCallback(f func(swapId string, txHex string,
 }
 
 func (d *dummyChain) ValidateTx(swapParams *OpeningParams, openingTxId string) (bool, error) {
+	d.validatedParams = swapParams
 	return true, nil
 }
@@ -0,0 +1,113 @@
+package swap
+
+import (
+	"fmt"
+
+	"github.com/elementsproject/peerswap/log"
+)
+
+const (
+	// PEERSWAP_MIN_PROTOCOL_VERSION is the lowest protocol version that we
+	// take part in swaps of.
+	PEERSWAP_MIN_PROTOCOL_VERSION = PEERSWAP_PROTOCOL_VERSION
+	// PEERSWAP_MAX_PROTOCOL_VERSION is the highest protocol version that we
+	// take part in swaps of.
+	PEERSWAP_MAX_PROTOCOL_VERSION = PEERSWAP_PROTOCOL_VERSION_TAPROOT
+)
+
+// protocolFeatures are the parts of a swap that differ between the protocol
+// versions.
+type protocolFeatures struct {
+	// taprootOpening is set if bitcoin swaps lock the funds in a taproot
+	// output.
+	taprootOpening bool
+}
+
+// protocolVersions are the features of the protocol versions that we
+// support.
+var protocolVersions = map[uint8]protocolFeatures{
+	PEERSWAP_PROTOCOL_VERSION:         {},
+	PEERSWAP_PROTOCOL_VERSION_TAPROOT: {taprootOpening: true},
+}
+
+// NoCommonProtocolVersionError is returned if we do not support any of the
+// protocol versions that a peer supports.
+type NoCommonProtocolVersionError struct {
+	PeerId     string
+	MinVersion uint64
+	MaxVersion uint64
+}
+
+func (e NoCommonProtocolVersionError) Error() string {
+	return fmt.Sprintf("peer %s supports protocol versions %d to %d, we support %d to %d",
+		e.PeerId, e.MinVersion, e.MaxVersion, PEERSWAP_MIN_PROTOCOL_VERSION, PEERSWAP_MAX_PROTOCOL_VERSION)
+}
+
+// PeerTermsGetter returns the terms of a peer from its last poll, nil if the
+// peer did not poll.
+type PeerTermsGetter interface {
+	GetPeerTerms(peerId string) (*PeerTerms, error)
+}
+
+// SetPeerTermsGetter sets the source of the peer terms that the protocol
+// version of our swaps is negotiated by. Without it we only start swaps of
+// PEERSWAP_PROTOCOL_VERSION.
+func (s *SwapService) SetPeerTermsGetter(terms PeerTermsGetter) {
+	s.swapServices.peerTerms = terms
+}
+
+// IsSupportedProtocolVersion returns true if we can take part in a swap of
+// the given protocol version.
+func IsSupportedProtocolVersion(version uint64) bool {
+	if version > PEERSWAP_MAX_PROTOCOL_VERSION {
+		return false
+	}
+	_, ok := protocolVersions[uint8(version)]
+	return ok
+}
+
+// HighestCommonProtocolVersion returns the highest protocol version that we
+// support out of the range from minVersion to maxVersion. It returns false if
+// we do not support any version of the range.
+func HighestCommonProtocolVersion(minVersion, maxVersion uint64) (uint8, bool) {
+	if maxVersion > PEERSWAP_MAX_PROTOCOL_VERSION {
+		maxVersion = PEERSWAP_MAX_PROTOCOL_VERSION
+	}
+	if minVersion < PEERSWAP_MIN_PROTOCOL_VERSION {
+		minVersion = PEERSWAP_MIN_PROTOCOL_VERSION
+	}
+	for v := maxVersion; v >= minVersion; v-- {
+		if IsSupportedProtocolVersion(v) {
+			return uint8(v), true
+		}
+	}
+	return 0, false
+}
+
+// features returns the features of the protocol version of the swap.
+func (s *SwapData) features() protocolFeatures {
+	return protocolVersions[s.GetProtocolVersion()]
+}
+
+// protocolVersion negotiates the protocol version of a new swap with the
+// peer. It is the highest version that both of us support. Without a poll of
+// the peer we fall back to PEERSWAP_PROTOCOL_VERSION.
+func (s *SwapServices) protocolVersion(peer string) (uint8, error) {
+	if s.peerTerms == nil {
+		return PEERSWAP_PROTOCOL_VERSION, nil
+	}
+	terms, err := s.peerTerms.GetPeerTerms(peer)
+	if err != nil {
+		log.Debugf("could not get terms of peer %s: %v", peer, err)
+		return PEERSWAP_PROTOCOL_VERSION, nil
+	}
+	if terms == nil {
+		return PEERSWAP_PROTOCOL_VERSION, nil
+	}
+	minVersion, maxVersion := terms.ProtocolVersions()
+	version, ok := HighestCommonProtocolVersion(minVersion, maxVersion)
+	if !ok {
+		return 0, NoCommonProtocolVersionError{PeerId: peer, MinVersion: minVersion, MaxVersion: maxVersion}
+	}
+	return version, nil
+}
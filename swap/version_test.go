@@ -0,0 +1,101 @@
+package swap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type peerTermsMock map[string]*PeerTerms
+
+func (m peerTermsMock) GetPeerTerms(peerId string) (*PeerTerms, error) {
+	if peerId == "broken" {
+		return nil, errors.New("store error")
+	}
+	return m[peerId], nil
+}
+
+func Test_ProtocolVersion(t *testing.T) {
+	services := getSwapServices(make(chan PeerMessage))
+	version, err := services.protocolVersion("bob")
+	assert.NoError(t, err)
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION, version)
+
+	services.peerTerms = peerTermsMock{
+		"bob":   {ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, MinProtocolVersion: PEERSWAP_PROTOCOL_VERSION, MaxProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT},
+		"carol": {ProtocolVersion: PEERSWAP_PROTOCOL_VERSION},
+		"erin":  {ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, MaxProtocolVersion: PEERSWAP_MAX_PROTOCOL_VERSION + 5},
+		"frank": {ProtocolVersion: PEERSWAP_MAX_PROTOCOL_VERSION + 1, MinProtocolVersion: PEERSWAP_MAX_PROTOCOL_VERSION + 1, MaxProtocolVersion: PEERSWAP_MAX_PROTOCOL_VERSION + 2},
+	}
+	for peer, expected := range map[string]uint8{
+		"bob":    PEERSWAP_PROTOCOL_VERSION_TAPROOT,
+		"carol":  PEERSWAP_PROTOCOL_VERSION,
+		"dave":   PEERSWAP_PROTOCOL_VERSION,
+		"erin":   PEERSWAP_MAX_PROTOCOL_VERSION,
+		"broken": PEERSWAP_PROTOCOL_VERSION,
+	} {
+		version, err := services.protocolVersion(peer)
+		assert.NoError(t, err, peer)
+		assert.Equal(t, expected, version, peer)
+	}
+
+	_, err = services.protocolVersion("frank")
+	assert.ErrorAs(t, err, &NoCommonProtocolVersionError{})
+
+	assert.True(t, IsSupportedProtocolVersion(PEERSWAP_PROTOCOL_VERSION))
+	assert.True(t, IsSupportedProtocolVersion(PEERSWAP_PROTOCOL_VERSION_TAPROOT))
+	assert.False(t, IsSupportedProtocolVersion(2))
+	assert.False(t, IsSupportedProtocolVersion(PEERSWAP_MAX_PROTOCOL_VERSION+1))
+	assert.False(t, IsSupportedProtocolVersion(256+PEERSWAP_PROTOCOL_VERSION))
+}
+
+func Test_HighestCommonProtocolVersion(t *testing.T) {
+	for _, tc := range []struct {
+		min, max uint64
+		version  uint8
+		ok       bool
+	}{
+		{PEERSWAP_PROTOCOL_VERSION, PEERSWAP_PROTOCOL_VERSION, PEERSWAP_PROTOCOL_VERSION, true},
+		{0, 100, PEERSWAP_MAX_PROTOCOL_VERSION, true},
+		{PEERSWAP_PROTOCOL_VERSION_TAPROOT, PEERSWAP_PROTOCOL_VERSION_TAPROOT, PEERSWAP_PROTOCOL_VERSION_TAPROOT, true},
+		{1, 2, 0, false},
+		{PEERSWAP_MAX_PROTOCOL_VERSION + 1, 100, 0, false},
+		{PEERSWAP_PROTOCOL_VERSION_TAPROOT, PEERSWAP_PROTOCOL_VERSION, 0, false},
+	} {
+		version, ok := HighestCommonProtocolVersion(tc.min, tc.max)
+		assert.Equal(t, tc.version, version, "%d-%d", tc.min, tc.max)
+		assert.Equal(t, tc.ok, ok, "%d-%d", tc.min, tc.max)
+	}
+
+	terms := &PeerTerms{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION}
+	minVersion, maxVersion := terms.ProtocolVersions()
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION, minVersion)
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION, maxVersion)
+}
+
+func Test_AgreedProtocolVersion(t *testing.T) {
+	data := &SwapData{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT}
+	pubkey := "02" + strings.Repeat("11", 32)
+	assert.NoError(t, SwapOutAgreementMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT, Pubkey: pubkey}.Validate(data))
+	assert.Error(t, SwapOutAgreementMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, Pubkey: pubkey}.Validate(data))
+	assert.Error(t, SwapInAgreementMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, Pubkey: pubkey}.Validate(data))
+
+	// Swaps from before the negotiation take the version of their request.
+	data = &SwapData{SwapInRequest: &SwapInRequestMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION}}
+	assert.EqualValues(t, PEERSWAP_PROTOCOL_VERSION, data.GetProtocolVersion())
+	assert.NoError(t, SwapInAgreementMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT, Pubkey: pubkey}.Validate(data))
+}
+
+func Test_OpeningParams_Taproot(t *testing.T) {
+	data := &SwapData{SwapOutRequest: &SwapOutRequestMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT, Network: "regtest"}}
+	assert.True(t, data.GetOpeningParams().Taproot)
+
+	data = &SwapData{SwapOutRequest: &SwapOutRequestMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION, Network: "regtest"}}
+	assert.False(t, data.GetOpeningParams().Taproot)
+
+	// Liquid swaps keep their P2WSH opening output.
+	data = &SwapData{SwapOutRequest: &SwapOutRequestMessage{ProtocolVersion: PEERSWAP_PROTOCOL_VERSION_TAPROOT, Asset: "asset"}}
+	assert.False(t, data.GetOpeningParams().Taproot)
+}
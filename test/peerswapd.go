@@ -10,6 +10,7 @@ import (
 	"github.com/elementsproject/peerswap/peerswaprpc"
 	"github.com/elementsproject/peerswap/testframework"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type PeerSwapd struct {
@@ -94,7 +95,7 @@ func (p *PeerSwapd) Run(waitForReady bool) error {
 		}
 	}
 
-	psClient, clientConn, err := getPeerswapClient(p.RpcPort)
+	psClient, clientConn, err := getPeerswapClient(p.RpcPort, p.DataDir)
 	if err != nil {
 		return err
 	}
@@ -120,8 +121,8 @@ func (p *PeerSwapd) Kill() {
 	p.DaemonProcess.Kill()
 }
 
-func getPeerswapClient(rpcPort int) (peerswaprpc.PeerSwapClient, *grpc.ClientConn, error) {
-	conn, err := getClientConn(fmt.Sprintf("localhost:%v", rpcPort))
+func getPeerswapClient(rpcPort int, dataDir string) (peerswaprpc.PeerSwapClient, *grpc.ClientConn, error) {
+	conn, err := getClientConn(fmt.Sprintf("localhost:%v", rpcPort), dataDir)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,12 +132,21 @@ func getPeerswapClient(rpcPort int) (peerswaprpc.PeerSwapClient, *grpc.ClientCon
 	return psClient, conn, nil
 }
 
-func getClientConn(address string) (*grpc.ClientConn, error) {
+func getClientConn(address string, dataDir string) (*grpc.ClientConn, error) {
+	creds, err := credentials.NewClientTLSFromFile(filepath.Join(dataDir, "tls.cert"), "")
+	if err != nil {
+		return nil, err
+	}
+	token, err := peerswaprpc.NewTokenCredentialFromFile(peerswaprpc.TokenFile(dataDir, peerswaprpc.ScopeAdmin))
+	if err != nil {
+		return nil, err
+	}
 
 	maxMsgRecvSize := grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 200)
 	opts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
-		grpc.WithInsecure(),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(token),
 		grpc.WithBlock(),
 	}
 
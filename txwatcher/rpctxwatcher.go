@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/elementsproject/peerswap/log"
@@ -58,6 +59,9 @@ type BlockchainRpcTxWatcher struct {
 	requiredConfs uint32
 	csv           uint32
 
+	// processedHeight is the height of the last block that was handled.
+	processedHeight uint32
+
 	ctx context.Context
 	sync.Mutex
 }
@@ -74,6 +78,13 @@ func (s *BlockchainRpcTxWatcher) GetBlockHeight() (uint32, error) {
 	return uint32(blockheight), nil
 }
 
+// ProcessedBlockHeight returns the height of the last block that the watcher
+// handled. Together with GetBlockHeight it tells how far the watcher lags
+// behind the tip.
+func (s *BlockchainRpcTxWatcher) ProcessedBlockHeight() uint32 {
+	return atomic.LoadUint32(&s.processedHeight)
+}
+
 func NewBlockchainRpcTxWatcher(ctx context.Context, blockchain BlockchainRpc, requiredConfs uint32, csv uint32) *BlockchainRpcTxWatcher {
 	return &BlockchainRpcTxWatcher{
 		ctx:              ctx,
@@ -109,6 +120,7 @@ func (s *BlockchainRpcTxWatcher) StartWatchingTxs() error {
 				if err != nil {
 					return err
 				}
+				atomic.StoreUint32(&s.processedHeight, uint32(nb))
 			default:
 				time.Sleep(100 * time.Millisecond)
 			}
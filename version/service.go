@@ -14,8 +14,15 @@ type ActiveSwapGetter interface {
 	HasActiveSwaps() (bool, error)
 }
 
+// Store holds the peerswap version that the database was last used with.
+// GetVersion returns ErrDoesNotExist if no version was set yet.
+type Store interface {
+	GetVersion() (string, error)
+	SetVersion(version string) error
+}
+
 type VersionService struct {
-	versionStore *versionStore
+	versionStore Store
 }
 
 func NewVersionService(boltdb *bbolt.DB) (*VersionService, error) {
@@ -26,6 +33,12 @@ func NewVersionService(boltdb *bbolt.DB) (*VersionService, error) {
 	return &VersionService{versionStore: versionStore}, nil
 }
 
+// NewVersionServiceWithStore returns a version service that uses a store
+// other than bbolt.
+func NewVersionServiceWithStore(store Store) *VersionService {
+	return &VersionService{versionStore: store}
+}
+
 // SafeUpgrade upgrades the peerswap version, only if no active swaps are running
 func (vs *VersionService) SafeUpgrade(swapService ActiveSwapGetter) error {
 	// first check if we need to upgrade
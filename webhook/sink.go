@@ -0,0 +1,297 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/elementsproject/peerswap/log"
+	"github.com/elementsproject/peerswap/swap"
+)
+
+const (
+	// DefaultRetryInterval is the time between the checks for deliveries
+	// that are due.
+	DefaultRetryInterval = 5 * time.Second
+
+	// minBackoff and maxBackoff bound the time between two attempts of a
+	// delivery, the backoff doubles with every failed attempt.
+	minBackoff = 10 * time.Second
+	maxBackoff = time.Hour
+
+	// maxAttempts is the number of attempts after which a delivery is
+	// dropped, that is after about a day.
+	maxAttempts = 30
+
+	requestTimeout = 10 * time.Second
+)
+
+type SwapService interface {
+	SubscribeSwapEvents(since int64) ([]*swap.SwapEvent, <-chan *swap.SwapEvent, func())
+	GetSwap(swapId string) (*swap.SwapStateMachine, error)
+	OnSuspiciousPeer(handler func(*swap.SuspiciousPeerEvent))
+}
+
+// Sink posts signed payloads of swap events to the configured urls. The
+// deliveries are stored until they succeed, failed attempts are retried with
+// an exponential backoff, also after a restart.
+type Sink struct {
+	sync.Mutex
+	store  Store
+	urls   []string
+	secret []byte
+	client *http.Client
+	now    func() time.Time
+
+	retryInterval time.Duration
+	wake          chan struct{}
+}
+
+// NewSink returns a sink that posts to urls. The urls must be http or https
+// urls.
+func NewSink(store Store, urls []string, secret []byte) (*Sink, error) {
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook url %s: %w", u, err)
+		}
+		if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid webhook url %s: expected http or https url", u)
+		}
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("webhook secret must not be empty")
+	}
+	return &Sink{
+		store:         store,
+		urls:          urls,
+		secret:        secret,
+		client:        &http.Client{Timeout: requestTimeout},
+		now:           time.Now,
+		retryInterval: DefaultRetryInterval,
+		wake:          make(chan struct{}, 1),
+	}, nil
+}
+
+// Start subscribes to the events of the swap service and sends them until
+// the context is done. The subscription is in place when Start returns, so
+// it should be called before the swap service is started.
+func (s *Sink) Start(ctx context.Context, swaps SwapService) {
+	swaps.OnSuspiciousPeer(func(e *swap.SuspiciousPeerEvent) {
+		payload := &Payload{
+			Event:      EventPeerSuspicious,
+			Timestamp:  e.Timestamp,
+			PeerNodeId: e.PeerNodeId,
+			Reason:     e.Reason,
+		}
+		if sw, err := swaps.GetSwap(e.SwapId); err == nil {
+			payload.Swap = newSwapPayload(sw)
+		}
+		s.enqueue(payload)
+	})
+
+	_, events, cancel := swaps.SubscribeSwapEvents(0)
+	go s.deliverLoop(ctx)
+	go s.run(ctx, swaps, events, cancel)
+}
+
+func (s *Sink) run(ctx context.Context, swaps SwapService, events <-chan *swap.SwapEvent, cancel func()) {
+	defer func() { cancel() }()
+
+	var replay []*swap.SwapEvent
+	var last int64
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				// We fell behind, resubscribe and replay the missed events.
+				replay, events, cancel = swaps.SubscribeSwapEvents(last)
+				for _, e := range replay {
+					s.onSwapEvent(e, swaps)
+					last = e.Timestamp
+				}
+				continue
+			}
+			s.onSwapEvent(event, swaps)
+			last = event.Timestamp
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Sink) onSwapEvent(e *swap.SwapEvent, swaps SwapService) {
+	event, ok := eventType(e)
+	if !ok {
+		return
+	}
+	payload := &Payload{
+		Event:      event,
+		Timestamp:  e.Timestamp,
+		PeerNodeId: e.PeerNodeId,
+	}
+	sw, err := swaps.GetSwap(e.SwapId)
+	if err != nil {
+		log.Infof("[Webhook] could not get swap %s: %v", e.SwapId, err)
+		payload.Swap = &SwapPayload{Id: e.SwapId, Type: e.Type, Role: e.Role, Asset: e.Asset, AmountSat: e.Amount}
+	} else {
+		payload.Swap = newSwapPayload(sw)
+	}
+	// The store can lag behind the event.
+	payload.Swap.State = e.NewState
+	s.enqueue(payload)
+}
+
+// enqueue stores a delivery of the payload for every url.
+func (s *Sink) enqueue(payload *Payload) {
+	if err := s.Enqueue(payload); err != nil {
+		log.Infof("[Webhook] could not store %s event: %v", payload.Event, err)
+	}
+}
+
+// Enqueue stores a delivery of the payload for every url and wakes up the
+// delivery loop.
+func (s *Sink) Enqueue(payload *Payload) error {
+	if payload.Id == "" {
+		payload.Id = newId()
+	}
+	if payload.Timestamp == 0 {
+		payload.Timestamp = s.now().UnixNano()
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	now := s.now()
+	for _, u := range s.urls {
+		err = s.store.PutDelivery(&Delivery{
+			Id:          newId(),
+			Url:         u,
+			Event:       payload.Event,
+			PayloadId:   payload.Id,
+			Body:        body,
+			CreatedAt:   now.UnixNano(),
+			NextAttempt: now.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *Sink) deliverLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.retryInterval)
+	defer ticker.Stop()
+	for {
+		s.DeliverDue(ctx)
+		select {
+		case <-ticker.C:
+		case <-s.wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DeliverDue posts all deliveries that are due.
+func (s *Sink) DeliverDue(ctx context.Context) {
+	deliveries, err := s.store.ListDeliveries()
+	if err != nil {
+		log.Infof("[Webhook] could not list deliveries: %v", err)
+		return
+	}
+	for _, d := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		if d.NextAttempt > s.now().Unix() {
+			continue
+		}
+		s.deliver(ctx, d)
+	}
+}
+
+func (s *Sink) deliver(ctx context.Context, d *Delivery) {
+	err := s.post(ctx, d)
+	s.Lock()
+	defer s.Unlock()
+	if err == nil {
+		if err := s.store.DeleteDelivery(d.Id); err != nil {
+			log.Infof("[Webhook] could not delete delivery %s: %v", d.Id, err)
+		}
+		return
+	}
+
+	d.Attempts++
+	d.LastError = err.Error()
+	if d.Attempts >= maxAttempts {
+		log.Infof("[Webhook] dropping %s event %s for %s after %d attempts: %v", d.Event, d.PayloadId, d.Url, d.Attempts, err)
+		if err := s.store.DeleteDelivery(d.Id); err != nil {
+			log.Infof("[Webhook] could not delete delivery %s: %v", d.Id, err)
+		}
+		return
+	}
+	d.NextAttempt = s.now().Add(backoff(d.Attempts)).Unix()
+	log.Debugf("[Webhook] delivery of %s event %s to %s failed, attempt %d: %v", d.Event, d.PayloadId, d.Url, d.Attempts, err)
+	if err := s.store.PutDelivery(d); err != nil {
+		log.Infof("[Webhook] could not update delivery %s: %v", d.Id, err)
+	}
+}
+
+func (s *Sink) post(ctx context.Context, d *Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Url, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(d.Event))
+	req.Header.Set(DeliveryHeader, d.PayloadId)
+	req.Header.Set(SignatureHeader, Sign(s.secret, d.Body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+// backoff returns the time to wait after the given number of failed
+// attempts.
+func backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
+
+func newId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"encoding/json"
+	"sort"
+
+	"go.etcd.io/bbolt"
+)
+
+var deliveryBucket = []byte("webhook-deliveries")
+
+// Delivery is a payload that still has to be posted to a url.
+type Delivery struct {
+	Id        string          `json:"id"`
+	Url       string          `json:"url"`
+	Event     EventType       `json:"event"`
+	PayloadId string          `json:"payload_id"`
+	Body      json.RawMessage `json:"body"`
+	CreatedAt int64           `json:"created_at"`
+	// Attempts is the number of failed attempts.
+	Attempts int `json:"attempts"`
+	// NextAttempt is the unix time of the next attempt.
+	NextAttempt int64  `json:"next_attempt"`
+	LastError   string `json:"last_error,omitempty"`
+}
+
+type Store interface {
+	PutDelivery(d *Delivery) error
+	DeleteDelivery(id string) error
+	// ListDeliveries returns the deliveries ordered by creation.
+	ListDeliveries() ([]*Delivery, error)
+}
+
+// BoltStore keeps the deliveries in a bbolt database so that they survive a
+// restart.
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltStore opens the bbolt database at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(deliveryBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) PutDelivery(d *Delivery) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(deliveryBucket).Put([]byte(d.Id), b)
+	})
+}
+
+func (s *BoltStore) DeleteDelivery(id string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(deliveryBucket).Delete([]byte(id))
+	})
+}
+
+func (s *BoltStore) ListDeliveries() ([]*Delivery, error) {
+	var deliveries []*Delivery
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(deliveryBucket).ForEach(func(k, v []byte) error {
+			var d Delivery
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, &d)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt < deliveries[j].CreatedAt
+	})
+	return deliveries, nil
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
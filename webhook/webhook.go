@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/elementsproject/peerswap/swap"
+)
+
+type EventType string
+
+const (
+	EventSwapCreated    EventType = "swap_created"
+	EventSwapCompleted  EventType = "swap_completed"
+	EventSwapCanceled   EventType = "swap_canceled"
+	EventSwapClaimedCsv EventType = "swap_claimed_csv"
+	EventPeerSuspicious EventType = "peer_suspicious"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the body with the
+	// secret, prefixed with "sha256=".
+	SignatureHeader = "X-Peerswap-Signature"
+	// EventHeader holds the event type of the payload.
+	EventHeader = "X-Peerswap-Event"
+	// DeliveryHeader holds the id of the payload, it is the same for all
+	// attempts so that receivers can drop duplicates.
+	DeliveryHeader = "X-Peerswap-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// Payload is the JSON body that is posted to the webhook urls.
+type Payload struct {
+	Id         string    `json:"id"`
+	Event      EventType `json:"event"`
+	Timestamp  int64     `json:"timestamp"`
+	PeerNodeId string    `json:"peer_node_id"`
+	// Reason is set on peer_suspicious events.
+	Reason string       `json:"reason,omitempty"`
+	Swap   *SwapPayload `json:"swap,omitempty"`
+}
+
+// SwapPayload is the part of the swap data that is sent with an event.
+type SwapPayload struct {
+	Id            string         `json:"id"`
+	Type          string         `json:"type"`
+	Role          string         `json:"role"`
+	State         swap.StateType `json:"state"`
+	Asset         string         `json:"asset"`
+	ChannelId     string         `json:"channel_id"`
+	AmountSat     uint64         `json:"amount_sat"`
+	PremiumSat    uint64         `json:"premium_sat"`
+	OpeningTxId   string         `json:"opening_tx_id,omitempty"`
+	OpeningTxFee  uint64         `json:"opening_tx_fee,omitempty"`
+	ClaimTxId     string         `json:"claim_tx_id,omitempty"`
+	ClaimTxFee    uint64         `json:"claim_tx_fee,omitempty"`
+	CancelMessage string         `json:"cancel_message,omitempty"`
+	LastError     string         `json:"last_error,omitempty"`
+	CreatedAt     int64          `json:"created_at"`
+	FinishedAt    int64          `json:"finished_at,omitempty"`
+}
+
+func newSwapPayload(s *swap.SwapStateMachine) *SwapPayload {
+	p := &SwapPayload{
+		Id:    s.SwapId.String(),
+		Type:  s.Type.String(),
+		Role:  s.Role.String(),
+		State: s.Current,
+	}
+	if s.Data == nil {
+		return p
+	}
+	p.Asset = s.Data.GetChain()
+	p.ChannelId = s.Data.GetScid()
+	p.AmountSat = s.Data.GetAmount()
+	p.PremiumSat = s.Data.GetPremium()
+	p.OpeningTxId = s.Data.GetOpeningTxId()
+	p.OpeningTxFee = s.Data.OpeningTxFee
+	p.ClaimTxId = s.Data.ClaimTxId
+	p.ClaimTxFee = s.Data.ClaimTxFee
+	p.CancelMessage = s.Data.GetCancelMessage()
+	p.LastError = s.Data.LastErrString
+	p.CreatedAt = s.Data.CreatedAt
+	p.FinishedAt = s.Data.FinishedAt
+	return p
+}
+
+// eventType returns the webhook event of a state change of a swap, false if
+// the state change is not sent.
+func eventType(e *swap.SwapEvent) (EventType, bool) {
+	switch {
+	case e.OldState == swap.Default:
+		return EventSwapCreated, true
+	case e.NewState == swap.State_ClaimedPreimage:
+		return EventSwapCompleted, true
+	case e.NewState == swap.State_SwapCanceled || e.NewState == swap.State_ClaimedCoop:
+		return EventSwapCanceled, true
+	case e.NewState == swap.State_ClaimedCsv:
+		return EventSwapClaimedCsv, true
+	}
+	return "", false
+}
+
+// Sign returns the value of the signature header of the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the signature header value matches the
+// body.
+func VerifySignature(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// LoadSecret returns the secret that the payloads are signed with. If secret
+// is empty it is read from the file at path, a new secret is written to the
+// file if it does not exist.
+func LoadSecret(secret, path string) ([]byte, error) {
+	if secret != "" {
+		return []byte(secret), nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err == nil {
+		return []byte(strings.TrimSpace(string(b))), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	generated := hex.EncodeToString(raw)
+	if err := os.WriteFile(path, []byte(generated), 0600); err != nil {
+		return nil, fmt.Errorf("unable to write webhook secret: %w", err)
+	}
+	return []byte(generated), nil
+}
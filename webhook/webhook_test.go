@@ -0,0 +1,277 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/elementsproject/peerswap/swap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EventType(t *testing.T) {
+	for _, tc := range []struct {
+		old, new swap.StateType
+		event    EventType
+		ok       bool
+	}{
+		{swap.Default, swap.State_SwapOutSender_CreateSwap, EventSwapCreated, true},
+		{swap.State_SwapOutSender_ClaimSwap, swap.State_ClaimedPreimage, EventSwapCompleted, true},
+		{swap.State_SendCancel, swap.State_SwapCanceled, EventSwapCanceled, true},
+		{swap.State_SwapOutReceiver_ClaimSwapCoop, swap.State_ClaimedCoop, EventSwapCanceled, true},
+		{swap.State_SwapOutReceiver_ClaimSwapCsv, swap.State_ClaimedCsv, EventSwapClaimedCsv, true},
+		{swap.State_SwapOutSender_CreateSwap, swap.State_SwapOutSender_AwaitAgreement, "", false},
+	} {
+		event, ok := eventType(&swap.SwapEvent{OldState: tc.old, NewState: tc.new})
+		assert.Equal(t, tc.ok, ok, tc.new)
+		assert.Equal(t, tc.event, event, tc.new)
+	}
+}
+
+func Test_LoadSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhook.secret")
+
+	secret, err := LoadSecret("configured", path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("configured"), secret)
+	assert.NoFileExists(t, path)
+
+	generated, err := LoadSecret("", path)
+	require.NoError(t, err)
+	assert.Len(t, generated, 64)
+	assert.FileExists(t, path)
+
+	loaded, err := LoadSecret("", path)
+	require.NoError(t, err)
+	assert.Equal(t, generated, loaded)
+}
+
+func Test_NewSink(t *testing.T) {
+	_, err := NewSink(newMemStore(), []string{"ftp://example.com"}, []byte("secret"))
+	assert.Error(t, err)
+	_, err = NewSink(newMemStore(), []string{"http://example.com"}, nil)
+	assert.Error(t, err)
+	_, err = NewSink(newMemStore(), []string{"http://example.com", "https://example.com/hook"}, []byte("secret"))
+	assert.NoError(t, err)
+}
+
+func Test_Sink(t *testing.T) {
+	secret := []byte("secret")
+	received := make(chan *Payload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if !VerifySignature(secret, body, r.Header.Get(SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var payload Payload
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, string(payload.Event), r.Header.Get(EventHeader))
+		assert.Equal(t, payload.Id, r.Header.Get(DeliveryHeader))
+		received <- &payload
+	}))
+	defer server.Close()
+
+	store := newMemStore()
+	sink, err := NewSink(store, []string{server.URL}, secret)
+	require.NoError(t, err)
+
+	swapId := swap.NewSwapId()
+	swaps := newFakeSwaps()
+	swaps.swaps[swapId.String()] = &swap.SwapStateMachine{
+		SwapId:  swapId,
+		Type:    swap.SWAPTYPE_OUT,
+		Role:    swap.SWAPROLE_SENDER,
+		Current: swap.State_SwapOutSender_AwaitAgreement,
+		Data: &swap.SwapData{
+			PeerNodeId: "peer",
+			CreatedAt:  100,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink.Start(ctx, swaps)
+
+	swaps.events <- &swap.SwapEvent{
+		Timestamp:  1,
+		SwapId:     swapId.String(),
+		PeerNodeId: "peer",
+		OldState:   swap.State_SwapOutSender_AwaitAgreement,
+		NewState:   swap.State_ClaimedPreimage,
+	}
+	payload := receive(t, received)
+	assert.Equal(t, EventSwapCompleted, payload.Event)
+	assert.Equal(t, "peer", payload.PeerNodeId)
+	require.NotNil(t, payload.Swap)
+	assert.Equal(t, swapId.String(), payload.Swap.Id)
+	assert.Equal(t, "swap-out", payload.Swap.Type)
+	assert.Equal(t, swap.State_ClaimedPreimage, payload.Swap.State)
+	assert.EqualValues(t, 100, payload.Swap.CreatedAt)
+
+	swaps.suspicious(&swap.SuspiciousPeerEvent{
+		Timestamp:  2,
+		PeerNodeId: "peer",
+		SwapId:     swapId.String(),
+		Reason:     "swap was claimed by csv",
+	})
+	payload = receive(t, received)
+	assert.Equal(t, EventPeerSuspicious, payload.Event)
+	assert.Equal(t, "swap was claimed by csv", payload.Reason)
+
+	assert.Eventually(t, func() bool {
+		deliveries, err := store.ListDeliveries()
+		return err == nil && len(deliveries) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_Sink_Retry(t *testing.T) {
+	var mu sync.Mutex
+	fail := true
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "webhooks.db")
+	store, err := NewBoltStore(path)
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	sink, err := NewSink(store, []string{server.URL}, []byte("secret"))
+	require.NoError(t, err)
+	sink.now = func() time.Time { return now }
+
+	require.NoError(t, sink.Enqueue(&Payload{Event: EventSwapCreated, PeerNodeId: "peer"}))
+	sink.DeliverDue(context.Background())
+
+	deliveries, err := store.ListDeliveries()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, now.Add(minBackoff).Unix(), deliveries[0].NextAttempt)
+	assert.Contains(t, deliveries[0].LastError, "500")
+
+	// The delivery is not due yet.
+	sink.DeliverDue(context.Background())
+	assert.Equal(t, 1, calls)
+
+	// The delivery survives a restart.
+	require.NoError(t, store.Close())
+	store, err = NewBoltStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+	sink, err = NewSink(store, []string{server.URL}, []byte("secret"))
+	require.NoError(t, err)
+	now = now.Add(minBackoff)
+	sink.now = func() time.Time { return now }
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+	sink.DeliverDue(context.Background())
+	assert.Equal(t, 2, calls)
+
+	deliveries, err = store.ListDeliveries()
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 0)
+}
+
+func Test_Backoff(t *testing.T) {
+	assert.Equal(t, minBackoff, backoff(1))
+	assert.Equal(t, 2*minBackoff, backoff(2))
+	assert.Equal(t, 4*minBackoff, backoff(3))
+	assert.Equal(t, maxBackoff, backoff(maxAttempts))
+}
+
+func receive(t *testing.T, received chan *Payload) *Payload {
+	t.Helper()
+	select {
+	case payload := <-received:
+		return payload
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for webhook")
+	}
+	return nil
+}
+
+type fakeSwaps struct {
+	swaps      map[string]*swap.SwapStateMachine
+	events     chan *swap.SwapEvent
+	suspicious func(*swap.SuspiciousPeerEvent)
+}
+
+func newFakeSwaps() *fakeSwaps {
+	f := &fakeSwaps{
+		swaps:  map[string]*swap.SwapStateMachine{},
+		events: make(chan *swap.SwapEvent),
+	}
+	f.suspicious = func(*swap.SuspiciousPeerEvent) {}
+	return f
+}
+
+func (f *fakeSwaps) SubscribeSwapEvents(since int64) ([]*swap.SwapEvent, <-chan *swap.SwapEvent, func()) {
+	return nil, f.events, func() {}
+}
+
+func (f *fakeSwaps) GetSwap(swapId string) (*swap.SwapStateMachine, error) {
+	s, ok := f.swaps[swapId]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return s, nil
+}
+
+func (f *fakeSwaps) OnSuspiciousPeer(handler func(*swap.SuspiciousPeerEvent)) {
+	f.suspicious = handler
+}
+
+type memStore struct {
+	sync.Mutex
+	deliveries map[string]*Delivery
+}
+
+func newMemStore() *memStore {
+	return &memStore{deliveries: map[string]*Delivery{}}
+}
+
+func (m *memStore) PutDelivery(d *Delivery) error {
+	m.Lock()
+	defer m.Unlock()
+	c := *d
+	m.deliveries[d.Id] = &c
+	return nil
+}
+
+func (m *memStore) DeleteDelivery(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.deliveries, id)
+	return nil
+}
+
+func (m *memStore) ListDeliveries() ([]*Delivery, error) {
+	m.Lock()
+	defer m.Unlock()
+	var deliveries []*Delivery
+	for _, d := range m.deliveries {
+		c := *d
+		deliveries = append(deliveries, &c)
+	}
+	return deliveries, nil
+}